@@ -7,6 +7,8 @@ import (
 
 	"gopkg.in/urfave/cli.v2"
 
+	// register the kernel analyzers such as baud_zh in bleve
+	_ "github.com/tiglabs/baudengine/engine/bleve/analysis/zh"
 	ps "github.com/tiglabs/baudengine/ps/server"
 	"github.com/tiglabs/baudengine/util/config"
 	"github.com/tiglabs/baudengine/util/log"
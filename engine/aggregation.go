@@ -0,0 +1,307 @@
+package engine
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Aggregation types supported in the aggs section of a search request.
+const (
+	AggTerms         = "terms"
+	AggRange         = "range"
+	AggHistogram     = "histogram"
+	AggDateHistogram = "date_histogram"
+	AggStats         = "stats"
+	AggMin           = "min"
+	AggMax           = "max"
+	AggAvg           = "avg"
+	AggSum           = "sum"
+	AggCardinality   = "cardinality"
+)
+
+// Aggregations is the aggregation section of a search result, keyed by aggregation name.
+type Aggregations map[string]*AggregationResult
+
+// AggregationResult is the result of a single aggregation.
+// Metric aggregations fill in the values, bucket aggregations fill in the buckets.
+type AggregationResult struct {
+	// Type is the aggregation type, it decides how partial results of partitions are reduced.
+	Type string `json:"_type,omitempty"`
+
+	Value *float64 `json:"value,omitempty"`
+	Count uint64   `json:"count,omitempty"`
+	Min   *float64 `json:"min,omitempty"`
+	Max   *float64 `json:"max,omitempty"`
+	Avg   *float64 `json:"avg,omitempty"`
+	Sum   *float64 `json:"sum,omitempty"`
+	// Sketch is the serialized HyperLogLog of a cardinality aggregation.
+	Sketch []byte `json:"sketch,omitempty"`
+
+	SumOtherDocCount uint64    `json:"sum_other_doc_count,omitempty"`
+	Buckets          []*Bucket `json:"buckets,omitempty"`
+	// Terms carries the options of a terms aggregation to the reduce of the partial results, see Reduce.
+	Terms *TermsOptions `json:"_terms,omitempty"`
+}
+
+// TermsOptions are the options of a terms aggregation applied once the buckets of all partitions are merged.
+type TermsOptions struct {
+	Size        int    `json:"size"`
+	MinDocCount uint64 `json:"min_doc_count"`
+	// OrderBy is either _count or _key
+	OrderBy string `json:"order_by"`
+	Desc    bool   `json:"desc"`
+}
+
+// Bucket is a single bucket of a bucket aggregation, sub aggregations are rendered inline.
+type Bucket struct {
+	Key          interface{}  `json:"key,omitempty"`
+	KeyAsString  string       `json:"key_as_string,omitempty"`
+	From         *float64     `json:"from,omitempty"`
+	To           *float64     `json:"to,omitempty"`
+	DocCount     uint64       `json:"doc_count"`
+	Aggregations Aggregations `json:"-"`
+}
+
+type bucketAlias Bucket
+
+func (b *Bucket) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal((*bucketAlias)(b))
+	if err != nil || len(b.Aggregations) == 0 {
+		return data, err
+	}
+	tmp := make(map[string]interface{})
+	if err = json.Unmarshal(data, &tmp); err != nil {
+		return nil, err
+	}
+	for name, agg := range b.Aggregations {
+		tmp[name] = agg
+	}
+	return json.Marshal(tmp)
+}
+
+func (b *Bucket) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*bucketAlias)(b)); err != nil {
+		return err
+	}
+	tmp := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	for name, raw := range tmp {
+		switch name {
+		case "key", "key_as_string", "from", "to", "doc_count":
+			continue
+		}
+		agg := new(AggregationResult)
+		if err := json.Unmarshal(raw, agg); err != nil {
+			return err
+		}
+		if b.Aggregations == nil {
+			b.Aggregations = make(Aggregations)
+		}
+		b.Aggregations[name] = agg
+	}
+	return nil
+}
+
+// Merge reduces the partial aggregations of another partition into aggs.
+func (aggs Aggregations) Merge(other Aggregations) Aggregations {
+	if aggs == nil {
+		aggs = make(Aggregations, len(other))
+	}
+	for name, agg := range other {
+		if exist, ok := aggs[name]; ok {
+			exist.merge(agg)
+		} else {
+			aggs[name] = agg
+		}
+	}
+	return aggs
+}
+
+func (r *AggregationResult) merge(o *AggregationResult) {
+	if o == nil {
+		return
+	}
+
+	switch r.Type {
+	case AggStats, AggMin, AggMax, AggAvg, AggSum:
+		r.Count += o.Count
+		r.Sum = addFloat(r.Sum, o.Sum)
+		r.Min = pickFloat(r.Min, o.Min, math.Min)
+		r.Max = pickFloat(r.Max, o.Max, math.Max)
+		r.Avg = nil
+		if r.Count > 0 && r.Sum != nil {
+			avg := *r.Sum / float64(r.Count)
+			r.Avg = &avg
+		}
+		switch r.Type {
+		case AggMin:
+			r.Value = r.Min
+		case AggMax:
+			r.Value = r.Max
+		case AggAvg:
+			r.Value = r.Avg
+		case AggSum:
+			r.Value = r.Sum
+		}
+
+	case AggCardinality:
+		hll, err := HyperLogLogFromBytes(r.Sketch)
+		if err != nil {
+			return
+		}
+		if other, err := HyperLogLogFromBytes(o.Sketch); err == nil {
+			hll.Merge(other)
+		}
+		r.Sketch = hll.Bytes()
+		value := float64(hll.Count())
+		r.Value = &value
+
+	case AggTerms:
+		// the buckets are ordered and truncated by Reduce once all partitions are merged
+		r.Buckets = mergeBuckets(r.Buckets, o.Buckets)
+		r.SumOtherDocCount += o.SumOtherDocCount
+		if r.Terms == nil {
+			r.Terms = o.Terms
+		}
+
+	case AggHistogram, AggDateHistogram:
+		r.Buckets = mergeBuckets(r.Buckets, o.Buckets)
+		sort.SliceStable(r.Buckets, func(i, j int) bool {
+			return keyFloat(r.Buckets[i].Key) < keyFloat(r.Buckets[j].Key)
+		})
+
+	case AggRange:
+		r.Buckets = mergeBuckets(r.Buckets, o.Buckets)
+	}
+}
+
+// Reduce finishes the aggregations merged from all partitions, the buckets of the terms aggregations
+// below min_doc_count are dropped, and the others are ordered and truncated to size.
+func (aggs Aggregations) Reduce() {
+	for _, agg := range aggs {
+		if agg.Type == AggTerms && agg.Terms != nil {
+			agg.reduceTerms()
+		}
+		for _, bucket := range agg.Buckets {
+			bucket.Aggregations.Reduce()
+		}
+	}
+}
+
+func (r *AggregationResult) reduceTerms() {
+	opts := r.Terms
+	buckets := r.Buckets[:0]
+	for _, b := range r.Buckets {
+		if b.DocCount >= opts.MinDocCount {
+			buckets = append(buckets, b)
+		}
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if opts.OrderBy == "_key" || buckets[i].DocCount == buckets[j].DocCount {
+			if opts.OrderBy == "_key" && opts.Desc {
+				return keyLess(buckets[j], buckets[i])
+			}
+			return keyLess(buckets[i], buckets[j])
+		}
+		if opts.Desc {
+			return buckets[i].DocCount > buckets[j].DocCount
+		}
+		return buckets[i].DocCount < buckets[j].DocCount
+	})
+	if len(buckets) > opts.Size {
+		for _, b := range buckets[opts.Size:] {
+			r.SumOtherDocCount += b.DocCount
+		}
+		buckets = buckets[:opts.Size]
+	}
+	r.Buckets = buckets
+	r.Terms = nil
+}
+
+// keyLess orders the buckets by their keys, the string keys by the strings and the others by the numbers.
+func keyLess(a, b *Bucket) bool {
+	x, xok := a.Key.(string)
+	y, yok := b.Key.(string)
+	if xok && yok {
+		return x < y
+	}
+	return keyFloat(a.Key) < keyFloat(b.Key)
+}
+
+// mergeBuckets merges buckets with the same key, keeping the order of first appearance.
+func mergeBuckets(a, b []*Bucket) []*Bucket {
+	index := make(map[string]*Bucket, len(a))
+	for _, bucket := range a {
+		index[keyString(bucket)] = bucket
+	}
+	for _, bucket := range b {
+		if exist, ok := index[keyString(bucket)]; ok {
+			exist.DocCount += bucket.DocCount
+			exist.Aggregations = exist.Aggregations.Merge(bucket.Aggregations)
+		} else {
+			index[keyString(bucket)] = bucket
+			a = append(a, bucket)
+		}
+	}
+	return a
+}
+
+func keyString(b *Bucket) string {
+	switch key := b.Key.(type) {
+	case string:
+		return key
+	case nil:
+		return b.KeyAsString
+	default:
+		return strconv.FormatFloat(keyFloat(key), 'f', -1, 64)
+	}
+}
+
+func keyFloat(key interface{}) float64 {
+	switch k := key.(type) {
+	case float64:
+		return k
+	case float32:
+		return float64(k)
+	case int64:
+		return float64(k)
+	case int:
+		return float64(k)
+	case uint64:
+		return float64(k)
+	case json.Number:
+		f, _ := k.Float64()
+		return f
+	case string:
+		f, _ := strconv.ParseFloat(k, 64)
+		return f
+	default:
+		return 0
+	}
+}
+
+func addFloat(a, b *float64) *float64 {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	sum := *a + *b
+	return &sum
+}
+
+func pickFloat(a, b *float64, pick func(x, y float64) float64) *float64 {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	v := pick(*a, *b)
+	return &v
+}
@@ -0,0 +1,116 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func TestAggregationsMerge(t *testing.T) {
+	a := Aggregations{
+		"avg_price": {Type: AggAvg, Count: 2, Sum: float(30), Min: float(10), Max: float(20)},
+		"genres": {Type: AggTerms, Buckets: []*Bucket{
+			{Key: "rock", DocCount: 3},
+			{Key: "jazz", DocCount: 1},
+		}, Terms: &TermsOptions{Size: 10, MinDocCount: 1, OrderBy: "_count", Desc: true}},
+	}
+	b := Aggregations{
+		"avg_price": {Type: AggAvg, Count: 1, Sum: float(60), Min: float(60), Max: float(60)},
+		"genres": {Type: AggTerms, Buckets: []*Bucket{
+			{Key: "pop", DocCount: 2},
+			{Key: "jazz", DocCount: 2},
+		}, Terms: &TermsOptions{Size: 10, MinDocCount: 1, OrderBy: "_count", Desc: true}},
+	}
+	merged := a.Merge(b)
+	merged.Reduce()
+	if avg := merged["avg_price"]; *avg.Value != 30 || *avg.Min != 10 || *avg.Max != 60 {
+		t.Fatalf("invalid avg %v", avg)
+	}
+	genres := merged["genres"]
+	if keys := bucketKeys(genres); !reflect.DeepEqual(keys, []string{"jazz:3", "rock:3", "pop:2"}) || genres.SumOtherDocCount != 0 {
+		t.Fatalf("invalid terms %v, sum_other_doc_count %d", keys, genres.SumOtherDocCount)
+	}
+	if genres.Terms != nil {
+		t.Fatal("the terms options are left after the reduce")
+	}
+}
+
+func bucketKeys(agg *AggregationResult) []string {
+	keys := make([]string, len(agg.Buckets))
+	for i, b := range agg.Buckets {
+		keys[i] = fmt.Sprintf("%v:%d", b.Key, b.DocCount)
+	}
+	return keys
+}
+
+func TestTermsReduce(t *testing.T) {
+	partitions := func() []Aggregations {
+		return []Aggregations{
+			{"tags": {Type: AggTerms, Buckets: []*Bucket{{Key: "a", DocCount: 2}, {Key: "b", DocCount: 1}, {Key: "c", DocCount: 1}}}},
+			{"tags": {Type: AggTerms, Buckets: []*Bucket{{Key: "c", DocCount: 1}, {Key: "d", DocCount: 1}}}},
+		}
+	}
+	tests := []struct {
+		name  string
+		opts  TermsOptions
+		keys  []string
+		other uint64
+	}{
+		{"truncated to size", TermsOptions{Size: 2, MinDocCount: 1, OrderBy: "_count", Desc: true}, []string{"a:2", "c:2"}, 2},
+		{"ordered by key desc", TermsOptions{Size: 3, MinDocCount: 1, OrderBy: "_key", Desc: true}, []string{"d:1", "c:2", "b:1"}, 2},
+		{"ordered by count asc", TermsOptions{Size: 10, MinDocCount: 1, OrderBy: "_count"}, []string{"b:1", "d:1", "a:2", "c:2"}, 0},
+		// c has one document in every partition, it reaches min_doc_count only when they are summed up
+		{"min_doc_count of the sums", TermsOptions{Size: 10, MinDocCount: 2, OrderBy: "_count", Desc: true}, []string{"a:2", "c:2"}, 0},
+	}
+	for _, test := range tests {
+		var merged Aggregations
+		for _, aggs := range partitions() {
+			opts := test.opts
+			aggs["tags"].Terms = &opts
+			merged = merged.Merge(aggs)
+		}
+		merged.Reduce()
+		tags := merged["tags"]
+		if keys := bucketKeys(tags); !reflect.DeepEqual(keys, test.keys) || tags.SumOtherDocCount != test.other {
+			t.Errorf("%s: the buckets are %v with sum_other_doc_count %d, want %v with %d",
+				test.name, keys, tags.SumOtherDocCount, test.keys, test.other)
+		}
+	}
+}
+
+func TestCardinalityMerge(t *testing.T) {
+	x, y := NewHyperLogLog(), NewHyperLogLog()
+	for _, v := range []string{"a", "b", "c"} {
+		x.Add([]byte(v))
+	}
+	for _, v := range []string{"b", "c", "d"} {
+		y.Add([]byte(v))
+	}
+	a := Aggregations{"n": {Type: AggCardinality, Sketch: x.Bytes()}}
+	b := Aggregations{"n": {Type: AggCardinality, Sketch: y.Bytes()}}
+	if n := a.Merge(b)["n"]; *n.Value != 4 {
+		t.Fatalf("invalid cardinality %v", *n.Value)
+	}
+}
+
+func TestBucketJSON(t *testing.T) {
+	bucket := &Bucket{Key: "rock", DocCount: 2, Aggregations: Aggregations{
+		"max_price": {Type: AggMax, Value: float(150)},
+	}}
+	data, err := json.Marshal(bucket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(Bucket)
+	if err = json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Key != "rock" || decoded.DocCount != 2 || *decoded.Aggregations["max_price"].Value != 150 {
+		t.Fatalf("invalid bucket %s", data)
+	}
+}
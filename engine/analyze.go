@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+/*
+AnalyzeRequest analyzes the text by the analyzer of a field, a named analyzer or an ad-hoc chain, such as
+
+{
+    "tokenizer": "standard",
+    "filter": ["lowercase", { "type": "stop", "stopwords": ["a", "the"] }],
+    "text": "The quick fox"
+}
+
+The default analyzer of the engine is used if none is set.
+*/
+type AnalyzeRequest struct {
+	Text     []string `json:"text"`
+	Analyzer string   `json:"analyzer,omitempty"`
+	Field    string   `json:"field,omitempty"`
+	// Tokenizer, Filters and CharFilters are the names or the definitions of the components
+	Tokenizer   json.RawMessage   `json:"tokenizer,omitempty"`
+	Filters     []json.RawMessage `json:"filter,omitempty"`
+	CharFilters []json.RawMessage `json:"char_filter,omitempty"`
+}
+
+func (r *AnalyzeRequest) UnmarshalJSON(data []byte) error {
+	type request AnalyzeRequest
+	tmp := struct {
+		request
+		Text json.RawMessage `json:"text"`
+	}{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	*r = AnalyzeRequest(tmp.request)
+	r.Text = nil
+	if len(tmp.Text) == 0 {
+		return errors.New("analyze request requires text")
+	}
+	// the text is a string or an array of strings
+	var text string
+	if err := json.Unmarshal(tmp.Text, &text); err == nil {
+		r.Text = []string{text}
+		return nil
+	}
+	return json.Unmarshal(tmp.Text, &r.Text)
+}
+
+// Validate checks that only one way of analysis is set.
+func (r *AnalyzeRequest) Validate() error {
+	n := 0
+	for _, set := range []bool{r.Analyzer != "", r.Field != "", len(r.Tokenizer) > 0} {
+		if set {
+			n++
+		}
+	}
+	if n > 1 {
+		return errors.New("analyze request can only set one of analyzer, field and tokenizer")
+	}
+	if len(r.Tokenizer) == 0 && (len(r.Filters) > 0 || len(r.CharFilters) > 0) {
+		return errors.New("analyze request requires the tokenizer of the filters")
+	}
+	return nil
+}
+
+// AnalyzeToken is a token of the text, the positions start from 0.
+type AnalyzeToken struct {
+	Token       string `json:"token"`
+	StartOffset int    `json:"start_offset"`
+	EndOffset   int    `json:"end_offset"`
+	Type        string `json:"type"`
+	Position    int    `json:"position"`
+}
+
+type AnalyzeResult struct {
+	Tokens []AnalyzeToken `json:"tokens"`
+}
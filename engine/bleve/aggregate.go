@@ -0,0 +1,37 @@
+package bleve
+
+import (
+	"context"
+
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/collector"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/engine/bleve/aggregation"
+)
+
+// aggregate runs the aggregations over all documents matching q, using the doc values of the index.
+// The fields are resolved by the document mapping of docType, see fieldTypeFunc.
+func (r *Bleve) aggregate(ctx context.Context, reader index.IndexReader, q query.Query, docType string, data []byte) (engine.Aggregations, error) {
+	aggs, err := aggregation.ParseAggregations(data)
+	if err != nil {
+		return nil, err
+	}
+	m := r.Mapping()
+	searcher, err := q.Searcher(reader, m, search.SearcherOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer searcher.Close()
+
+	builder := aggregation.NewBuilder(aggs, fieldTypeFunc(m, docType))
+	facets := search.NewFacetsBuilder(reader)
+	builder.Register(facets)
+	coll := collector.NewTopNCollector(0, 0, search.SortOrder{&search.SortScore{Desc: true}})
+	coll.SetFacetsBuilder(facets)
+	if err = coll.Collect(ctx, searcher, reader); err != nil {
+		return nil, err
+	}
+	return builder.Aggregations(), nil
+}
@@ -0,0 +1,154 @@
+package aggregation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+// Aggregations is the parsed aggs section of a search request, keyed by aggregation name.
+type Aggregations map[string]Aggregation
+
+// Aggregation is a parsed aggregation request.
+// It creates a collector for every bucket the aggregation is computed in.
+type Aggregation interface {
+	// Fields returns the fields whose doc values are needed, including the ones of sub aggregations.
+	Fields() []string
+	NewCollector() Collector
+}
+
+// Collector accumulates the matching documents of one aggregation.
+type Collector interface {
+	Collect(doc *Document)
+	Result() *engine.AggregationResult
+}
+
+// bucketAggregation is an aggregation which can hold sub aggregations.
+type bucketAggregation interface {
+	Aggregation
+	SetSubAggregations(subs Aggregations)
+}
+
+/*
+{
+    "aggs" : {
+        "genres" : {
+            "terms" : { "field" : "genre" },
+            "aggs" : {
+                "avg_price" : { "avg" : { "field" : "price" } }
+            }
+        }
+    }
+}
+*/
+func ParseAggregations(data []byte) (Aggregations, error) {
+	tmp := make(map[string]map[string]json.RawMessage)
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return nil, err
+	}
+	aggs := make(Aggregations, len(tmp))
+	for name, body := range tmp {
+		var agg Aggregation
+		var subs Aggregations
+		for typ, raw := range body {
+			switch typ {
+			case "aggs", "aggregations":
+				subs, err = ParseAggregations(raw)
+			case engine.AggTerms:
+				agg, err = unmarshal(raw, NewTermsAggregation())
+			case engine.AggRange:
+				agg, err = unmarshal(raw, NewRangeAggregation())
+			case engine.AggHistogram:
+				agg, err = unmarshal(raw, NewHistogramAggregation())
+			case engine.AggDateHistogram:
+				agg, err = unmarshal(raw, NewDateHistogramAggregation())
+			case engine.AggStats, engine.AggMin, engine.AggMax, engine.AggAvg, engine.AggSum:
+				agg, err = unmarshal(raw, NewMetricAggregation(typ))
+			case engine.AggCardinality:
+				agg, err = unmarshal(raw, NewCardinalityAggregation())
+			default:
+				err = fmt.Errorf("unsupported aggregation type %s", typ)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid aggregation %s: %v", name, err)
+			}
+		}
+		if agg == nil {
+			return nil, fmt.Errorf("aggregation %s has no type", name)
+		}
+		if subs != nil {
+			bucket, ok := agg.(bucketAggregation)
+			if !ok {
+				return nil, fmt.Errorf("aggregation %s cannot have sub aggregations", name)
+			}
+			bucket.SetSubAggregations(subs)
+		}
+		aggs[name] = agg
+	}
+	return aggs, nil
+}
+
+func unmarshal(data []byte, agg Aggregation) (Aggregation, error) {
+	if err := json.Unmarshal(data, agg); err != nil {
+		return nil, err
+	}
+	return agg, nil
+}
+
+// Fields returns the distinct fields needed by all aggregations.
+func (aggs Aggregations) Fields() []string {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, agg := range aggs {
+		for _, f := range agg.Fields() {
+			if !seen[f] {
+				seen[f] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+	return fields
+}
+
+func (aggs Aggregations) newCollectors() map[string]Collector {
+	if len(aggs) == 0 {
+		return nil
+	}
+	collectors := make(map[string]Collector, len(aggs))
+	for name, agg := range aggs {
+		collectors[name] = agg.NewCollector()
+	}
+	return collectors
+}
+
+func collectAll(collectors map[string]Collector, doc *Document) {
+	for _, c := range collectors {
+		c.Collect(doc)
+	}
+}
+
+func resultsOf(collectors map[string]Collector) engine.Aggregations {
+	if len(collectors) == 0 {
+		return nil
+	}
+	results := make(engine.Aggregations, len(collectors))
+	for name, c := range collectors {
+		results[name] = c.Result()
+	}
+	return results
+}
+
+// bucketBase holds the sub aggregations shared by all bucket aggregations.
+type bucketBase struct {
+	subs Aggregations
+}
+
+func (b *bucketBase) SetSubAggregations(subs Aggregations) {
+	b.subs = subs
+}
+
+func (b *bucketBase) fields(field string) []string {
+	return append([]string{field}, b.subs.Fields()...)
+}
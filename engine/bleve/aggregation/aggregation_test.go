@@ -0,0 +1,220 @@
+package aggregation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	_ "github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/collector"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func testIndex(t *testing.T) bleve.Index {
+	dm := mapping.NewDocumentStaticMapping()
+	genre := mapping.NewTextFieldMapping()
+	genre.Analyzer = "keyword"
+	dm.AddFieldMappingsAt("genre", genre)
+	dm.AddFieldMappingsAt("price", mapping.NewNumericFieldMapping())
+	dm.AddFieldMappingsAt("date", mapping.NewDateTimeFieldMapping())
+	im := bleve.NewIndexMapping()
+	im.DefaultMapping = dm
+
+	index, err := bleve.NewMemOnly(im)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs := []map[string]interface{}{
+		{"genre": "rock", "price": 10.0, "date": "2018-01-15T10:00:00Z"},
+		{"genre": "rock", "price": 150.0, "date": "2018-01-20T10:00:00Z"},
+		{"genre": "jazz", "price": 250.0, "date": "2018-03-01T10:00:00Z"},
+		{"genre": "pop", "price": 120.0, "date": "2018-03-02T10:00:00Z"},
+	}
+	for i, doc := range docs {
+		if err = index.Index(string(rune('a'+i)), doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return index
+}
+
+func testFieldType(field string) string {
+	switch field {
+	case "price":
+		return FieldNumber
+	case "date":
+		return FieldDateTime
+	}
+	return FieldText
+}
+
+func aggregate(t *testing.T, index bleve.Index, data string) engine.Aggregations {
+	aggs, err := ParseAggregations([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i, _, err := index.Advanced()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := i.Reader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	searcher, err := bleve.NewMatchAllQuery().Searcher(reader, index.Mapping(), search.SearcherOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	builder := NewBuilder(aggs, testFieldType)
+	facets := search.NewFacetsBuilder(reader)
+	builder.Register(facets)
+	coll := collector.NewTopNCollector(0, 0, search.SortOrder{&search.SortScore{Desc: true}})
+	coll.SetFacetsBuilder(facets)
+	if err = coll.Collect(context.Background(), searcher, reader); err != nil {
+		t.Fatal(err)
+	}
+	return builder.Aggregations()
+}
+
+func TestTermsAggregation(t *testing.T) {
+	index := testIndex(t)
+	defer index.Close()
+
+	res := aggregate(t, index, `{
+        "genres": {
+            "terms": { "field": "genre", "size": 2 },
+            "aggs": { "avg_price": { "avg": { "field": "price" } } }
+        }
+    }`)
+	genres := res["genres"]
+	// a partition returns up to shard_size buckets, they are truncated to size by the reduce
+	if genres == nil || len(genres.Buckets) != 3 || genres.Terms == nil || genres.Terms.Size != 2 {
+		t.Fatalf("invalid terms result %v", genres)
+	}
+	res.Reduce()
+	if len(genres.Buckets) != 2 {
+		t.Fatalf("invalid reduced terms result %v", genres)
+	}
+	rock := genres.Buckets[0]
+	if rock.Key != "rock" || rock.DocCount != 2 {
+		t.Fatalf("invalid first bucket %v", rock)
+	}
+	if avg := rock.Aggregations["avg_price"].Value; avg == nil || *avg != 80 {
+		t.Fatalf("invalid sub aggregation %v", rock.Aggregations["avg_price"])
+	}
+	if genres.SumOtherDocCount != 1 {
+		t.Fatalf("invalid sum_other_doc_count %d", genres.SumOtherDocCount)
+	}
+}
+
+func TestRangeAggregation(t *testing.T) {
+	index := testIndex(t)
+	defer index.Close()
+
+	res := aggregate(t, index, `{
+        "prices": {
+            "range": {
+                "field": "price",
+                "ranges": [{ "to": 100 }, { "from": 100, "to": 200 }, { "key": "expensive", "from": 200 }]
+            }
+        }
+    }`)
+	expects := map[string]uint64{"*-100": 1, "100-200": 2, "expensive": 1}
+	buckets := res["prices"].Buckets
+	if len(buckets) != len(expects) {
+		t.Fatalf("invalid range result %v", res["prices"])
+	}
+	for _, b := range buckets {
+		if expects[b.Key.(string)] != b.DocCount {
+			t.Fatalf("invalid bucket %v", b)
+		}
+	}
+}
+
+func TestHistogramAggregation(t *testing.T) {
+	index := testIndex(t)
+	defer index.Close()
+
+	res := aggregate(t, index, `{ "prices": { "histogram": { "field": "price", "interval": 100 } } }`)
+	buckets := res["prices"].Buckets
+	expects := []uint64{1, 2, 1}
+	if len(buckets) != len(expects) {
+		t.Fatalf("invalid histogram result %v", res["prices"])
+	}
+	for i, b := range buckets {
+		if b.Key != float64(i*100) || b.DocCount != expects[i] {
+			t.Fatalf("invalid bucket %v", b)
+		}
+	}
+}
+
+func TestDateHistogramAggregation(t *testing.T) {
+	index := testIndex(t)
+	defer index.Close()
+
+	res := aggregate(t, index, `{
+        "months": { "date_histogram": { "field": "date", "calendar_interval": "month", "format": "yyyy-MM" } }
+    }`)
+	buckets := res["months"].Buckets
+	expects := []struct {
+		key   string
+		count uint64
+	}{{"2018-01", 2}, {"2018-02", 0}, {"2018-03", 2}}
+	if len(buckets) != len(expects) {
+		t.Fatalf("invalid date_histogram result %v", res["months"])
+	}
+	for i, b := range buckets {
+		if b.KeyAsString != expects[i].key || b.DocCount != expects[i].count {
+			t.Fatalf("invalid bucket %v", b)
+		}
+	}
+	jan := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	if buckets[0].Key != float64(jan.UnixNano()/int64(time.Millisecond)) {
+		t.Fatalf("invalid bucket key %v", buckets[0].Key)
+	}
+}
+
+func TestMetricAggregations(t *testing.T) {
+	index := testIndex(t)
+	defer index.Close()
+
+	res := aggregate(t, index, `{
+        "stats": { "stats": { "field": "price" } },
+        "min": { "min": { "field": "price" } },
+        "max": { "max": { "field": "price" } },
+        "sum": { "sum": { "field": "price" } },
+        "genres": { "cardinality": { "field": "genre" } }
+    }`)
+	stats := res["stats"]
+	if stats.Count != 4 || *stats.Min != 10 || *stats.Max != 250 || *stats.Sum != 530 || *stats.Avg != 132.5 {
+		t.Fatalf("invalid stats result %v", stats)
+	}
+	if *res["min"].Value != 10 || *res["max"].Value != 250 || *res["sum"].Value != 530 {
+		t.Fatal("invalid metric result")
+	}
+	if *res["genres"].Value != 3 {
+		t.Fatalf("invalid cardinality %v", *res["genres"].Value)
+	}
+}
+
+func TestParseAggregationsError(t *testing.T) {
+	inputs := []string{
+		`{ "a": { "unknown": { "field": "x" } } }`,
+		`{ "a": { "terms": {} } }`,
+		`{ "a": { "histogram": { "field": "x" } } }`,
+		`{ "a": { "date_histogram": { "field": "x", "interval": "2x" } } }`,
+		`{ "a": { "avg": { "field": "x" }, "aggs": { "b": { "sum": { "field": "y" } } } } }`,
+		`{ "a": { "aggs": { "b": { "sum": { "field": "y" } } } } }`,
+	}
+	for _, input := range inputs {
+		if _, err := ParseAggregations([]byte(input)); err == nil {
+			t.Fatalf("expect error for %s", input)
+		}
+	}
+}
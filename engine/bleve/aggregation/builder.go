@@ -0,0 +1,74 @@
+package aggregation
+
+import (
+	"github.com/blevesearch/bleve/search"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+// Builder computes aggregations on the doc values visited by a bleve collector.
+type Builder struct {
+	fields     []string
+	doc        *Document
+	collectors map[string]Collector
+}
+
+func NewBuilder(aggs Aggregations, fieldType FieldTypeFunc) *Builder {
+	return &Builder{
+		fields:     aggs.Fields(),
+		doc:        newDocument(fieldType),
+		collectors: aggs.newCollectors(),
+	}
+}
+
+// Register adds the builder to the facets builder of a bleve collector.
+// The facets builder asks doc values of one field per facet, so the other fields are registered by placeholders.
+func (b *Builder) Register(fb *search.FacetsBuilder) {
+	if len(b.fields) == 0 {
+		return
+	}
+	fb.Add("_aggregations", b)
+	for _, field := range b.fields[1:] {
+		fb.Add("_aggregations_"+field, fieldPlaceholder(field))
+	}
+}
+
+func (b *Builder) StartDoc() {
+	b.doc.reset()
+}
+
+func (b *Builder) UpdateVisitor(field string, term []byte) {
+	b.doc.add(field, term)
+}
+
+func (b *Builder) EndDoc() {
+	collectAll(b.collectors, b.doc)
+}
+
+// Result is part of the bleve facet builder interface, aggregation results are returned by Aggregations.
+func (b *Builder) Result() *search.FacetResult {
+	return &search.FacetResult{Field: b.Field()}
+}
+
+func (b *Builder) Field() string {
+	if len(b.fields) == 0 {
+		return ""
+	}
+	return b.fields[0]
+}
+
+func (b *Builder) Size() int {
+	return 0
+}
+
+func (b *Builder) Aggregations() engine.Aggregations {
+	return resultsOf(b.collectors)
+}
+
+type fieldPlaceholder string
+
+func (f fieldPlaceholder) StartDoc()                    {}
+func (f fieldPlaceholder) UpdateVisitor(string, []byte) {}
+func (f fieldPlaceholder) EndDoc()                      {}
+func (f fieldPlaceholder) Result() *search.FacetResult  { return &search.FacetResult{Field: string(f)} }
+func (f fieldPlaceholder) Field() string                { return string(f) }
+func (f fieldPlaceholder) Size() int                    { return 0 }
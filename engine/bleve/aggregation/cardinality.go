@@ -0,0 +1,66 @@
+package aggregation
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+/*
+{
+    "cardinality" : { "field" : "author" }
+}
+*/
+type CardinalityAggregation struct {
+	Field string
+}
+
+func NewCardinalityAggregation() *CardinalityAggregation {
+	return &CardinalityAggregation{}
+}
+
+func (a *CardinalityAggregation) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Field string `json:"field"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if tmp.Field == "" {
+		return errors.New("cardinality aggregation requires field")
+	}
+	a.Field = tmp.Field
+	return nil
+}
+
+func (a *CardinalityAggregation) Fields() []string {
+	return []string{a.Field}
+}
+
+func (a *CardinalityAggregation) NewCollector() Collector {
+	return &cardinalityCollector{agg: a, hll: engine.NewHyperLogLog()}
+}
+
+type cardinalityCollector struct {
+	agg *CardinalityAggregation
+	hll *engine.HyperLogLog
+}
+
+func (c *cardinalityCollector) Collect(doc *Document) {
+	for _, key := range doc.Keys(c.agg.Field) {
+		switch k := key.(type) {
+		case string:
+			c.hll.Add([]byte(k))
+		case float64:
+			c.hll.Add([]byte(formatFloat(k)))
+		}
+	}
+}
+
+// Result returns the sketch along with the estimate, so that the router can merge partitions without counting twice.
+func (c *cardinalityCollector) Result() *engine.AggregationResult {
+	value := float64(c.hll.Count())
+	return &engine.AggregationResult{Type: engine.AggCardinality, Value: &value, Sketch: c.hll.Bytes()}
+}
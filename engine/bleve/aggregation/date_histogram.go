@@ -0,0 +1,243 @@
+package aggregation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+const defaultDateFormat = "2006-01-02T15:04:05.000Z07:00"
+
+var calendarUnits = map[string]string{
+	"minute": "m", "1m": "m",
+	"hour": "h", "1h": "h",
+	"day": "d", "1d": "d",
+	"week": "w", "1w": "w",
+	"month": "M", "1M": "M",
+	"quarter": "q", "1q": "q",
+	"year": "y", "1y": "y",
+}
+
+var fixedUnits = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+}
+
+/*
+{
+    "date_histogram" : {
+        "field" : "date",
+        "calendar_interval" : "month",
+        "format" : "yyyy-MM-dd",
+        "time_zone" : "+08:00",
+        "min_doc_count" : 1
+    }
+}
+*/
+type DateHistogramAggregation struct {
+	bucketBase
+	Field string
+	// CalendarUnit is one of m, h, d, w, M, q, y, it is empty when a fixed interval is used
+	CalendarUnit  string
+	FixedInterval time.Duration
+	Layout        string
+	Location      *time.Location
+	MinDocCount   uint64
+}
+
+func NewDateHistogramAggregation() *DateHistogramAggregation {
+	return &DateHistogramAggregation{Layout: defaultDateFormat, Location: time.UTC}
+}
+
+func (a *DateHistogramAggregation) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Field            string `json:"field"`
+		Interval         string `json:"interval"`
+		CalendarInterval string `json:"calendar_interval"`
+		FixedInterval    string `json:"fixed_interval"`
+		Format           string `json:"format"`
+		TimeZone         string `json:"time_zone"`
+		MinDocCount      uint64 `json:"min_doc_count"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if tmp.Field == "" {
+		return errors.New("date_histogram aggregation requires field")
+	}
+	a.Field = tmp.Field
+	a.MinDocCount = tmp.MinDocCount
+
+	switch {
+	case tmp.CalendarInterval != "":
+		unit, ok := calendarUnits[tmp.CalendarInterval]
+		if !ok {
+			return fmt.Errorf("invalid calendar_interval %s", tmp.CalendarInterval)
+		}
+		a.CalendarUnit = unit
+	case tmp.FixedInterval != "":
+		if a.FixedInterval, err = parseFixedInterval(tmp.FixedInterval); err != nil {
+			return err
+		}
+	case tmp.Interval != "":
+		if unit, ok := calendarUnits[tmp.Interval]; ok {
+			a.CalendarUnit = unit
+		} else if a.FixedInterval, err = parseFixedInterval(tmp.Interval); err != nil {
+			return err
+		}
+	default:
+		return errors.New("date_histogram aggregation requires interval")
+	}
+
+	if tmp.Format != "" {
+		a.Layout = dateLayout(tmp.Format)
+	}
+	if tmp.TimeZone != "" {
+		if a.Location, err = parseTimeZone(tmp.TimeZone); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseFixedInterval(s string) (time.Duration, error) {
+	for _, suffix := range []string{"ms", "s", "m", "h", "d"} {
+		if !strings.HasSuffix(s, suffix) {
+			continue
+		}
+		n, err := strconv.ParseUint(strings.TrimSuffix(s, suffix), 10, 64)
+		if err != nil || n == 0 {
+			break
+		}
+		return time.Duration(n) * fixedUnits[suffix], nil
+	}
+	return 0, fmt.Errorf("invalid fixed_interval %s", s)
+}
+
+// parseTimeZone accepts an utc offset such as +08:00 or a location name such as Asia/Shanghai.
+func parseTimeZone(tz string) (*time.Location, error) {
+	if strings.HasPrefix(tz, "+") || strings.HasPrefix(tz, "-") {
+		t, err := time.Parse("-07:00", tz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time_zone %s", tz)
+		}
+		_, offset := t.Zone()
+		return time.FixedZone(tz, offset), nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time_zone %s", tz)
+	}
+	return loc, nil
+}
+
+var dateLayoutReplacer = strings.NewReplacer(
+	"yyyy", "2006",
+	"yy", "06",
+	"MM", "01",
+	"dd", "02",
+	"HH", "15",
+	"mm", "04",
+	"ss", "05",
+	"SSS", "000",
+	"Z", "Z07:00",
+	"'T'", "T",
+)
+
+// dateLayout converts a date format in the Elasticsearch syntax into a Go time layout.
+func dateLayout(format string) string {
+	switch format {
+	case "epoch_millis", "date_optional_time", "strict_date_optional_time":
+		return defaultDateFormat
+	}
+	return dateLayoutReplacer.Replace(format)
+}
+
+func (a *DateHistogramAggregation) Fields() []string {
+	return a.fields(a.Field)
+}
+
+func (a *DateHistogramAggregation) NewCollector() Collector {
+	return &histogramCollector{
+		typ:         engine.AggDateHistogram,
+		field:       a.Field,
+		subs:        a.subs,
+		minDocCount: a.MinDocCount,
+		round: func(v float64) float64 {
+			return toMillis(a.round(fromMillis(v).In(a.Location)))
+		},
+		next: func(key float64) float64 {
+			return toMillis(a.next(fromMillis(key).In(a.Location)))
+		},
+		format: func(key float64) string {
+			return fromMillis(key).In(a.Location).Format(a.Layout)
+		},
+		buckets: make(map[float64]*bucketCollector),
+	}
+}
+
+func (a *DateHistogramAggregation) round(t time.Time) time.Time {
+	if a.CalendarUnit == "" {
+		_, offset := t.Zone()
+		local := t.Add(time.Duration(offset) * time.Second)
+		return local.Truncate(a.FixedInterval).Add(-time.Duration(offset) * time.Second)
+	}
+	y, M, d := t.Date()
+	loc := t.Location()
+	switch a.CalendarUnit {
+	case "m":
+		return time.Date(y, M, d, t.Hour(), t.Minute(), 0, 0, loc)
+	case "h":
+		return time.Date(y, M, d, t.Hour(), 0, 0, 0, loc)
+	case "d":
+		return time.Date(y, M, d, 0, 0, 0, 0, loc)
+	case "w":
+		// weeks start on monday
+		weekday := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, M, d-weekday, 0, 0, 0, 0, loc)
+	case "M":
+		return time.Date(y, M, 1, 0, 0, 0, 0, loc)
+	case "q":
+		return time.Date(y, M-(M-1)%3, 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(y, 1, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+func (a *DateHistogramAggregation) next(t time.Time) time.Time {
+	switch a.CalendarUnit {
+	case "":
+		return t.Add(a.FixedInterval)
+	case "m":
+		return t.Add(time.Minute)
+	case "h":
+		return t.Add(time.Hour)
+	case "d":
+		return t.AddDate(0, 0, 1)
+	case "w":
+		return t.AddDate(0, 0, 7)
+	case "M":
+		return t.AddDate(0, 1, 0)
+	case "q":
+		return t.AddDate(0, 3, 0)
+	default:
+		return t.AddDate(1, 0, 0)
+	}
+}
+
+func fromMillis(ms float64) time.Time {
+	return time.Unix(0, int64(ms)*int64(time.Millisecond)).UTC()
+}
+
+func toMillis(t time.Time) float64 {
+	return float64(t.UnixNano() / int64(time.Millisecond))
+}
@@ -0,0 +1,106 @@
+package aggregation
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/blevesearch/bleve/numeric"
+)
+
+// Field types of the index mapping, as named by bleve.
+const (
+	FieldText     = "text"
+	FieldNumber   = "number"
+	FieldDateTime = "datetime"
+	FieldBoolean  = "boolean"
+	FieldGeoPoint = "geopoint"
+)
+
+// FieldTypeFunc returns the mapped type of a field, or an empty string if the field is unknown.
+type FieldTypeFunc func(field string) string
+
+// Document holds the doc values of the matching document being collected.
+type Document struct {
+	values    map[string][][]byte
+	fieldType FieldTypeFunc
+}
+
+func newDocument(fieldType FieldTypeFunc) *Document {
+	if fieldType == nil {
+		fieldType = func(string) string { return "" }
+	}
+	return &Document{values: make(map[string][][]byte), fieldType: fieldType}
+}
+
+func (d *Document) reset() {
+	for field := range d.values {
+		delete(d.values, field)
+	}
+}
+
+func (d *Document) add(field string, term []byte) {
+	d.values[field] = append(d.values[field], append([]byte(nil), term...))
+}
+
+// Numbers returns the full precision numeric values of the field, dates are given in epoch milliseconds.
+func (d *Document) Numbers(field string) []float64 {
+	var nums []float64
+	typ := d.fieldType(field)
+	for _, term := range d.values[field] {
+		i64, ok := fullPrecision(term)
+		if !ok {
+			continue
+		}
+		if typ == FieldDateTime {
+			nums = append(nums, float64(i64/int64(time.Millisecond)))
+		} else {
+			nums = append(nums, numeric.Int64ToFloat64(i64))
+		}
+	}
+	return nums
+}
+
+// Keys returns the distinct values of the field as bucket keys, numbers for numeric and date fields, strings otherwise.
+func (d *Document) Keys(field string) []interface{} {
+	switch d.fieldType(field) {
+	case FieldNumber, FieldDateTime:
+		var keys []interface{}
+		seen := make(map[float64]bool)
+		for _, n := range d.Numbers(field) {
+			if !seen[n] {
+				seen[n] = true
+				keys = append(keys, n)
+			}
+		}
+		return keys
+	case FieldGeoPoint:
+		return nil
+	default:
+		var keys []interface{}
+		seen := make(map[string]bool)
+		for _, term := range d.values[field] {
+			if !seen[string(term)] {
+				seen[string(term)] = true
+				keys = append(keys, string(term))
+			}
+		}
+		return keys
+	}
+}
+
+// fullPrecision decodes a prefix coded numeric term, lower precision terms of the trie are skipped.
+func fullPrecision(term []byte) (int64, bool) {
+	valid, shift := numeric.ValidPrefixCodedTermBytes(term)
+	if !valid || shift != 0 {
+		return 0, false
+	}
+	i64, err := numeric.PrefixCoded(term).Int64()
+	if err != nil {
+		return 0, false
+	}
+	return i64, true
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
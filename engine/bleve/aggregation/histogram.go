@@ -0,0 +1,139 @@
+package aggregation
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+/*
+{
+    "histogram" : {
+        "field" : "price",
+        "interval" : 50,
+        "offset" : 0,
+        "min_doc_count" : 0
+    }
+}
+*/
+type HistogramAggregation struct {
+	bucketBase
+	Field       string
+	Interval    float64
+	Offset      float64
+	MinDocCount uint64
+}
+
+func NewHistogramAggregation() *HistogramAggregation {
+	return &HistogramAggregation{}
+}
+
+func (a *HistogramAggregation) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Field       string  `json:"field"`
+		Interval    float64 `json:"interval"`
+		Offset      float64 `json:"offset"`
+		MinDocCount uint64  `json:"min_doc_count"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if tmp.Field == "" {
+		return errors.New("histogram aggregation requires field")
+	}
+	if tmp.Interval <= 0 {
+		return errors.New("histogram aggregation interval must be positive")
+	}
+	a.Field = tmp.Field
+	a.Interval = tmp.Interval
+	a.Offset = tmp.Offset
+	a.MinDocCount = tmp.MinDocCount
+	return nil
+}
+
+func (a *HistogramAggregation) Fields() []string {
+	return a.fields(a.Field)
+}
+
+func (a *HistogramAggregation) NewCollector() Collector {
+	return &histogramCollector{
+		typ:         engine.AggHistogram,
+		field:       a.Field,
+		subs:        a.subs,
+		minDocCount: a.MinDocCount,
+		round: func(v float64) float64 {
+			return math.Floor((v-a.Offset)/a.Interval)*a.Interval + a.Offset
+		},
+		next: func(key float64) float64 {
+			return key + a.Interval
+		},
+		buckets: make(map[float64]*bucketCollector),
+	}
+}
+
+// histogramCollector puts every value into the bucket of its rounded key,
+// it is shared by histogram and date_histogram aggregations.
+type histogramCollector struct {
+	typ         string
+	field       string
+	subs        Aggregations
+	minDocCount uint64
+	round       func(v float64) float64
+	next        func(key float64) float64
+	format      func(key float64) string
+	buckets     map[float64]*bucketCollector
+}
+
+func (c *histogramCollector) Collect(doc *Document) {
+	seen := make(map[float64]bool)
+	for _, v := range doc.Numbers(c.field) {
+		key := c.round(v)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		b, ok := c.buckets[key]
+		if !ok {
+			b = &bucketCollector{key: key, subs: c.subs.newCollectors()}
+			c.buckets[key] = b
+		}
+		b.collect(doc)
+	}
+}
+
+func (c *histogramCollector) Result() *engine.AggregationResult {
+	keys := make([]float64, 0, len(c.buckets))
+	for key := range c.buckets {
+		keys = append(keys, key)
+	}
+	sort.Float64s(keys)
+	// empty buckets between the first and the last key are filled in if min_doc_count is 0
+	if c.minDocCount == 0 && len(keys) > 1 {
+		filled := make([]float64, 0, len(keys))
+		for key := keys[0]; key <= keys[len(keys)-1]; key = c.next(key) {
+			filled = append(filled, key)
+		}
+		keys = filled
+	}
+
+	res := &engine.AggregationResult{Type: c.typ, Buckets: make([]*engine.Bucket, 0, len(keys))}
+	for _, key := range keys {
+		b, ok := c.buckets[key]
+		if !ok {
+			b = &bucketCollector{key: key, subs: c.subs.newCollectors()}
+		}
+		if b.docCount < c.minDocCount {
+			continue
+		}
+		bucket := b.result()
+		if c.format != nil {
+			bucket.KeyAsString = c.format(key)
+		}
+		res.Buckets = append(res.Buckets, bucket)
+	}
+	return res
+}
@@ -0,0 +1,86 @@
+package aggregation
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+/*
+{
+    "avg" : { "field" : "price" }
+}
+*/
+type MetricAggregation struct {
+	// Type is one of stats, min, max, avg and sum
+	Type  string
+	Field string
+}
+
+func NewMetricAggregation(typ string) *MetricAggregation {
+	return &MetricAggregation{Type: typ}
+}
+
+func (a *MetricAggregation) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Field string `json:"field"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if tmp.Field == "" {
+		return errors.New(a.Type + " aggregation requires field")
+	}
+	a.Field = tmp.Field
+	return nil
+}
+
+func (a *MetricAggregation) Fields() []string {
+	return []string{a.Field}
+}
+
+func (a *MetricAggregation) NewCollector() Collector {
+	return &metricCollector{agg: a, min: math.Inf(1), max: math.Inf(-1)}
+}
+
+type metricCollector struct {
+	agg   *MetricAggregation
+	count uint64
+	sum   float64
+	min   float64
+	max   float64
+}
+
+func (c *metricCollector) Collect(doc *Document) {
+	for _, v := range doc.Numbers(c.agg.Field) {
+		c.count++
+		c.sum += v
+		c.min = math.Min(c.min, v)
+		c.max = math.Max(c.max, v)
+	}
+}
+
+// Result keeps count and sum for every metric, so that partial averages can be reduced across partitions.
+func (c *metricCollector) Result() *engine.AggregationResult {
+	res := &engine.AggregationResult{Type: c.agg.Type, Count: c.count}
+	sum := c.sum
+	res.Sum = &sum
+	if c.count > 0 {
+		min, max, avg := c.min, c.max, c.sum/float64(c.count)
+		res.Min, res.Max, res.Avg = &min, &max, &avg
+	}
+	switch c.agg.Type {
+	case engine.AggMin:
+		res.Value = res.Min
+	case engine.AggMax:
+		res.Value = res.Max
+	case engine.AggAvg:
+		res.Value = res.Avg
+	case engine.AggSum:
+		res.Value = res.Sum
+	}
+	return res
+}
@@ -0,0 +1,119 @@
+package aggregation
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+/*
+{
+    "range" : {
+        "field" : "price",
+        "ranges" : [
+            { "to" : 100.0 },
+            { "from" : 100.0, "to" : 200.0 },
+            { "key" : "expensive", "from" : 200.0 }
+        ]
+    }
+}
+*/
+type RangeAggregation struct {
+	bucketBase
+	Field  string
+	Ranges []*Range
+}
+
+// Range is a bucket of a range aggregation, From is inclusive and To is exclusive.
+type Range struct {
+	Key  string   `json:"key,omitempty"`
+	From *float64 `json:"from,omitempty"`
+	To   *float64 `json:"to,omitempty"`
+}
+
+func NewRangeAggregation() *RangeAggregation {
+	return &RangeAggregation{}
+}
+
+func (a *RangeAggregation) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Field  string   `json:"field"`
+		Ranges []*Range `json:"ranges"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if tmp.Field == "" {
+		return errors.New("range aggregation requires field")
+	}
+	if len(tmp.Ranges) == 0 {
+		return errors.New("range aggregation requires ranges")
+	}
+	for _, r := range tmp.Ranges {
+		if r.Key == "" {
+			r.Key = rangeKey(r.From, r.To)
+		}
+	}
+	a.Field = tmp.Field
+	a.Ranges = tmp.Ranges
+	return nil
+}
+
+func rangeKey(from, to *float64) string {
+	key := "*"
+	if from != nil {
+		key = formatFloat(*from)
+	}
+	key += "-"
+	if to != nil {
+		key += formatFloat(*to)
+	} else {
+		key += "*"
+	}
+	return key
+}
+
+func (r *Range) contains(v float64) bool {
+	return (r.From == nil || v >= *r.From) && (r.To == nil || v < *r.To)
+}
+
+func (a *RangeAggregation) Fields() []string {
+	return a.fields(a.Field)
+}
+
+func (a *RangeAggregation) NewCollector() Collector {
+	c := &rangeCollector{agg: a, buckets: make([]*bucketCollector, len(a.Ranges))}
+	for i, r := range a.Ranges {
+		c.buckets[i] = &bucketCollector{key: r.Key, subs: a.subs.newCollectors()}
+	}
+	return c
+}
+
+type rangeCollector struct {
+	agg     *RangeAggregation
+	buckets []*bucketCollector
+}
+
+func (c *rangeCollector) Collect(doc *Document) {
+	values := doc.Numbers(c.agg.Field)
+	for i, r := range c.agg.Ranges {
+		for _, v := range values {
+			if r.contains(v) {
+				c.buckets[i].collect(doc)
+				break
+			}
+		}
+	}
+}
+
+func (c *rangeCollector) Result() *engine.AggregationResult {
+	res := &engine.AggregationResult{Type: engine.AggRange, Buckets: make([]*engine.Bucket, len(c.buckets))}
+	for i, b := range c.buckets {
+		res.Buckets[i] = b.result()
+		res.Buckets[i].From = c.agg.Ranges[i].From
+		res.Buckets[i].To = c.agg.Ranges[i].To
+	}
+	return res
+}
@@ -0,0 +1,178 @@
+package aggregation
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+const defaultTermsSize = 10
+
+/*
+{
+    "terms" : {
+        "field" : "genre",
+        "size" : 10,
+        "shard_size" : 25,
+        "min_doc_count" : 1,
+        "order" : { "_count" : "desc" }
+    }
+}
+*/
+type TermsAggregation struct {
+	bucketBase
+	Field string
+	Size  int
+	// ShardSize is the number of the buckets of a partition, it defaults to size * 1.5 + 10
+	ShardSize   int
+	MinDocCount uint64
+	// OrderBy is either _count or _key
+	OrderBy string
+	Desc    bool
+}
+
+func NewTermsAggregation() *TermsAggregation {
+	return &TermsAggregation{Size: defaultTermsSize, ShardSize: defaultTermsSize*3/2 + 10, MinDocCount: 1, OrderBy: "_count", Desc: true}
+}
+
+func (a *TermsAggregation) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Field       string            `json:"field"`
+		Size        *int              `json:"size"`
+		ShardSize   *int              `json:"shard_size"`
+		MinDocCount *uint64           `json:"min_doc_count"`
+		Order       map[string]string `json:"order"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if tmp.Field == "" {
+		return errors.New("terms aggregation requires field")
+	}
+	a.Field = tmp.Field
+	if tmp.Size != nil {
+		if *tmp.Size <= 0 {
+			return errors.New("terms aggregation size must be positive")
+		}
+		a.Size = *tmp.Size
+	}
+	a.ShardSize = a.Size*3/2 + 10
+	if tmp.ShardSize != nil {
+		if *tmp.ShardSize <= 0 {
+			return errors.New("terms aggregation shard_size must be positive")
+		}
+		a.ShardSize = *tmp.ShardSize
+	}
+	if a.ShardSize < a.Size {
+		a.ShardSize = a.Size
+	}
+	if tmp.MinDocCount != nil {
+		a.MinDocCount = *tmp.MinDocCount
+	}
+	if len(tmp.Order) > 1 {
+		return errors.New("terms aggregation supports only one order")
+	}
+	for by, dir := range tmp.Order {
+		if by != "_count" && by != "_key" {
+			return errors.New("terms aggregation can only be ordered by _count or _key")
+		}
+		a.OrderBy = by
+		switch dir {
+		case "asc":
+			a.Desc = false
+		case "desc":
+			a.Desc = true
+		default:
+			return errors.New("order direction must be asc or desc")
+		}
+	}
+	return nil
+}
+
+func (a *TermsAggregation) Fields() []string {
+	return a.fields(a.Field)
+}
+
+func (a *TermsAggregation) NewCollector() Collector {
+	return &termsCollector{agg: a, buckets: make(map[interface{}]*bucketCollector)}
+}
+
+// bucketCollector counts the documents of a bucket and collects its sub aggregations.
+type bucketCollector struct {
+	key      interface{}
+	docCount uint64
+	subs     map[string]Collector
+}
+
+func (b *bucketCollector) collect(doc *Document) {
+	b.docCount++
+	collectAll(b.subs, doc)
+}
+
+func (b *bucketCollector) result() *engine.Bucket {
+	return &engine.Bucket{Key: b.key, DocCount: b.docCount, Aggregations: resultsOf(b.subs)}
+}
+
+type termsCollector struct {
+	agg     *TermsAggregation
+	buckets map[interface{}]*bucketCollector
+}
+
+func (c *termsCollector) Collect(doc *Document) {
+	for _, key := range doc.Keys(c.agg.Field) {
+		b, ok := c.buckets[key]
+		if !ok {
+			b = &bucketCollector{key: key, subs: c.agg.subs.newCollectors()}
+			c.buckets[key] = b
+		}
+		b.collect(doc)
+	}
+}
+
+// Result returns up to shard_size buckets of the partition, they are filtered by min_doc_count
+// and truncated to size by engine.Aggregations.Reduce once the buckets of all partitions are summed up.
+func (c *termsCollector) Result() *engine.AggregationResult {
+	buckets := make([]*engine.Bucket, 0, len(c.buckets))
+	for _, b := range c.buckets {
+		buckets = append(buckets, b.result())
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if c.agg.OrderBy == "_key" || buckets[i].DocCount == buckets[j].DocCount {
+			less := keyLess(buckets[i].Key, buckets[j].Key)
+			if c.agg.OrderBy == "_key" && c.agg.Desc {
+				return keyLess(buckets[j].Key, buckets[i].Key)
+			}
+			return less
+		}
+		if c.agg.Desc {
+			return buckets[i].DocCount > buckets[j].DocCount
+		}
+		return buckets[i].DocCount < buckets[j].DocCount
+	})
+	res := &engine.AggregationResult{Type: engine.AggTerms, Buckets: buckets, Terms: &engine.TermsOptions{
+		Size: c.agg.Size, MinDocCount: c.agg.MinDocCount, OrderBy: c.agg.OrderBy, Desc: c.agg.Desc}}
+	if len(buckets) > c.agg.ShardSize {
+		for _, b := range buckets[c.agg.ShardSize:] {
+			res.SumOtherDocCount += b.DocCount
+		}
+		res.Buckets = buckets[:c.agg.ShardSize]
+	}
+	return res
+}
+
+func keyLess(a, b interface{}) bool {
+	switch x := a.(type) {
+	case float64:
+		if y, ok := b.(float64); ok {
+			return x < y
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return x < y
+		}
+	}
+	return false
+}
@@ -0,0 +1,349 @@
+package bleve
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/blevesearch/bleve/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/analysis/char/html"
+	"github.com/blevesearch/bleve/analysis/lang/en"
+	"github.com/blevesearch/bleve/analysis/token/apostrophe"
+	"github.com/blevesearch/bleve/analysis/token/edgengram"
+	"github.com/blevesearch/bleve/analysis/token/length"
+	"github.com/blevesearch/bleve/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/analysis/token/ngram"
+	"github.com/blevesearch/bleve/analysis/token/porter"
+	"github.com/blevesearch/bleve/analysis/token/shingle"
+	"github.com/blevesearch/bleve/analysis/token/stop"
+	"github.com/blevesearch/bleve/analysis/token/truncate"
+	"github.com/blevesearch/bleve/analysis/token/unique"
+	"github.com/blevesearch/bleve/analysis/tokenizer/letter"
+	"github.com/blevesearch/bleve/analysis/tokenizer/regexp"
+	"github.com/blevesearch/bleve/analysis/tokenizer/single"
+	"github.com/blevesearch/bleve/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/analysis/tokenizer/web"
+	"github.com/blevesearch/bleve/analysis/tokenizer/whitespace"
+	"github.com/blevesearch/bleve/analysis/tokenmap"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/tiglabs/baudengine/engine/bleve/analysis"
+)
+
+// the ES names of the built in components which are named differently in bleve, the other names refer to the bleve registry
+var (
+	builtinTokenizers = map[string]string{
+		"standard":      unicode.Name,
+		"keyword":       single.Name,
+		"whitespace":    whitespace.Name,
+		"letter":        letter.Name,
+		"uax_url_email": web.Name,
+	}
+	builtinTokenFilters = map[string]string{
+		"lowercase":   lowercase.Name,
+		"stop":        en.StopName,
+		"porter_stem": porter.Name,
+		"unique":      unique.Name,
+		"apostrophe":  apostrophe.Name,
+	}
+	builtinCharFilters = map[string]string{
+		"html_strip": html.Name,
+	}
+)
+
+// analysisSettings is the analysis section of the ES index settings.
+type analysisSettings struct {
+	CharFilters map[string]map[string]interface{} `json:"char_filter,omitempty"`
+	Tokenizers  map[string]map[string]interface{} `json:"tokenizer,omitempty"`
+	Filters     map[string]map[string]interface{} `json:"filter,omitempty"`
+	Analyzers   map[string]map[string]interface{} `json:"analyzer,omitempty"`
+}
+
+/*
+parseAnalysis registers the analysis components of the settings in the index mapping, so every index has its own components.
+
+{
+    "analysis": {
+        "char_filter": { "dash": { "type": "mapping", "mappings": ["- => _"] } },
+        "filter": {
+            "my_stop": { "type": "stop", "stopwords": ["the", "a"] },
+            "my_synonym": { "type": "synonym", "synonyms": ["quick, fast"] }
+        },
+        "analyzer": {
+            "my_analyzer": {
+                "type": "custom",
+                "char_filter": ["html_strip", "dash"],
+                "tokenizer": "standard",
+                "filter": ["lowercase", "my_stop", "my_synonym"]
+            }
+        }
+    }
+}
+
+The analyzer named default is the default analyzer of the index.
+*/
+func parseAnalysis(m *mapping.IndexMappingImpl, data []byte) error {
+	settings, err := parseAnalysisSettings(data)
+	if err != nil || settings == nil {
+		return err
+	}
+	if err = settings.register(m); err != nil {
+		return err
+	}
+	if _, ok := settings.Analyzers["default"]; ok {
+		m.DefaultAnalyzer = "default"
+	}
+	return nil
+}
+
+// parseAnalysisSettings returns the analysis section of the settings, it is nil if there is none.
+func parseAnalysisSettings(data []byte) (*analysisSettings, error) {
+	tmp := struct {
+		Analysis *analysisSettings `json:"analysis,omitempty"`
+		Index    *struct {
+			Analysis *analysisSettings `json:"analysis,omitempty"`
+		} `json:"index,omitempty"`
+	}{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return nil, fmt.Errorf("invalid settings: %v", err)
+	}
+	if tmp.Analysis == nil && tmp.Index != nil {
+		return tmp.Index.Analysis, nil
+	}
+	return tmp.Analysis, nil
+}
+
+// register adds the components to the index mapping, the components may refer to the ones defined before them.
+func (settings *analysisSettings) register(m *mapping.IndexMappingImpl) error {
+	for _, name := range sortedNames(settings.CharFilters) {
+		config, err := charFilterConfig(settings.CharFilters[name])
+		if err == nil {
+			err = m.AddCustomCharFilter(name, config)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid char_filter %s: %v", name, err)
+		}
+	}
+	for _, name := range sortedNames(settings.Tokenizers) {
+		config, err := tokenizerConfig(settings.Tokenizers[name])
+		if err == nil {
+			err = m.AddCustomTokenizer(name, config)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid tokenizer %s: %v", name, err)
+		}
+	}
+	for _, name := range sortedNames(settings.Filters) {
+		if err := addTokenFilter(m, name, settings.Filters[name]); err != nil {
+			return fmt.Errorf("invalid filter %s: %v", name, err)
+		}
+	}
+	for _, name := range sortedNames(settings.Analyzers) {
+		if err := addAnalyzer(m, settings, name, settings.Analyzers[name]); err != nil {
+			return fmt.Errorf("invalid analyzer %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func sortedNames(components map[string]map[string]interface{}) []string {
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func charFilterConfig(def map[string]interface{}) (map[string]interface{}, error) {
+	switch typ, _ := def["type"].(string); typ {
+	case "html_strip":
+		return map[string]interface{}{"type": html.Name}, nil
+	case "mapping":
+		return map[string]interface{}{"type": analysis.MappingName, "mappings": def["mappings"]}, nil
+	case "pattern_replace":
+		return map[string]interface{}{"type": analysis.PatternReplaceName, "pattern": def["pattern"], "replacement": def["replacement"]}, nil
+	default:
+		return nil, fmt.Errorf("unknown type %s", typ)
+	}
+}
+
+func tokenizerConfig(def map[string]interface{}) (map[string]interface{}, error) {
+	typ, _ := def["type"].(string)
+	if typ == "simple_pattern" {
+		return map[string]interface{}{"type": regexp.Name, "regexp": def["pattern"]}, nil
+	}
+	if name, ok := builtinTokenizers[typ]; ok {
+		return map[string]interface{}{"type": name}, nil
+	}
+	return nil, fmt.Errorf("unknown type %s", typ)
+}
+
+// addTokenFilter registers the token filter, the stop words are registered as a token map of the same name.
+func addTokenFilter(m *mapping.IndexMappingImpl, name string, def map[string]interface{}) error {
+	var config map[string]interface{}
+	switch typ, _ := def["type"].(string); typ {
+	case "lowercase":
+		config = map[string]interface{}{"type": lowercase.Name}
+	case "stop":
+		stopMap, err := addStopWords(m, name, def["stopwords"], "_english_")
+		if err != nil {
+			return err
+		}
+		config = map[string]interface{}{"type": stop.Name, "stop_token_map": stopMap}
+	case "synonym", "synonym_graph":
+		config = map[string]interface{}{"type": analysis.SynonymName, "synonyms": def["synonyms"]}
+		if expand, ok := def["expand"]; ok {
+			config["expand"] = expand
+		}
+	case "length":
+		config = map[string]interface{}{"type": length.Name}
+		copyOption(config, "min", def, "min", nil)
+		copyOption(config, "max", def, "max", nil)
+	case "truncate":
+		config = map[string]interface{}{"type": truncate.Name}
+		copyOption(config, "length", def, "length", float64(10))
+	case "ngram", "nGram":
+		config = map[string]interface{}{"type": ngram.Name}
+		copyOption(config, "min", def, "min_gram", float64(1))
+		copyOption(config, "max", def, "max_gram", float64(2))
+	case "edge_ngram", "edgeNGram":
+		config = map[string]interface{}{"type": edgengram.Name, "back": def["side"] == "back"}
+		copyOption(config, "min", def, "min_gram", float64(1))
+		copyOption(config, "max", def, "max_gram", float64(2))
+	case "shingle":
+		config = map[string]interface{}{"type": shingle.Name}
+		copyOption(config, "min", def, "min_shingle_size", float64(2))
+		copyOption(config, "max", def, "max_shingle_size", float64(2))
+		copyOption(config, "output_original", def, "output_unigrams", true)
+		copyOption(config, "separator", def, "token_separator", " ")
+		copyOption(config, "filler", def, "filler_token", "_")
+	case "porter_stem":
+		config = map[string]interface{}{"type": porter.Name}
+	case "stemmer":
+		if language, _ := def["language"].(string); language != "" && language != "english" && language != "porter" {
+			return fmt.Errorf("unsupported stemmer language %s", language)
+		}
+		config = map[string]interface{}{"type": porter.Name}
+	case "unique":
+		config = map[string]interface{}{"type": unique.Name}
+	case "apostrophe":
+		config = map[string]interface{}{"type": apostrophe.Name}
+	default:
+		return fmt.Errorf("unknown type %s", typ)
+	}
+	return m.AddCustomTokenFilter(name, config)
+}
+
+// addStopWords registers the stop words as a token map and returns its name, the stop words may be
+// a list of words, _english_ or _none_.
+func addStopWords(m *mapping.IndexMappingImpl, name string, stopwords interface{}, defaultWords string) (string, error) {
+	if stopwords == nil {
+		stopwords = defaultWords
+	}
+	switch stopwords {
+	case "_english_":
+		return en.StopName, nil
+	case "_none_":
+		stopwords = []interface{}{}
+	}
+	words, ok := stopwords.([]interface{})
+	if !ok {
+		return "", fmt.Errorf("invalid stopwords %v", stopwords)
+	}
+	if err := m.AddCustomTokenMap(name, map[string]interface{}{"type": tokenmap.Name, "tokens": words}); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func copyOption(config map[string]interface{}, key string, def map[string]interface{}, esKey string, defaultValue interface{}) {
+	if v, ok := def[esKey]; ok {
+		config[key] = v
+	} else if defaultValue != nil {
+		config[key] = defaultValue
+	}
+}
+
+// addAnalyzer registers the analyzer as a custom analyzer of bleve.
+func addAnalyzer(m *mapping.IndexMappingImpl, settings *analysisSettings, name string, def map[string]interface{}) error {
+	var tokenizer string
+	tokenFilters := []interface{}{}
+	charFilters := []interface{}{}
+	switch typ, _ := def["type"].(string); typ {
+	case "custom", "":
+		var ok bool
+		if tokenizer, ok = def["tokenizer"].(string); !ok {
+			return fmt.Errorf("analyzer requires a tokenizer")
+		}
+		if _, ok = settings.Tokenizers[tokenizer]; !ok {
+			if builtin, ok := builtinTokenizers[tokenizer]; ok {
+				tokenizer = builtin
+			}
+		}
+		for _, filter := range componentNames(def["filter"]) {
+			if _, ok := settings.Filters[filter]; !ok {
+				if builtin, ok := builtinTokenFilters[filter]; ok {
+					filter = builtin
+				}
+			}
+			tokenFilters = append(tokenFilters, filter)
+		}
+		for _, filter := range componentNames(def["char_filter"]) {
+			if _, ok := settings.CharFilters[filter]; !ok {
+				if builtin, ok := builtinCharFilters[filter]; ok {
+					filter = builtin
+				}
+			}
+			charFilters = append(charFilters, filter)
+		}
+	case "standard", "stop":
+		tokenizer = unicode.Name
+		if typ == "stop" {
+			tokenizer = letter.Name
+		}
+		tokenFilters = append(tokenFilters, lowercase.Name)
+		if stopwords, ok := def["stopwords"]; ok || typ == "stop" {
+			stopMap, err := addStopWords(m, name+"_stopwords", stopwords, "_english_")
+			if err != nil {
+				return err
+			}
+			stopFilter := name + "_stop"
+			if err = m.AddCustomTokenFilter(stopFilter, map[string]interface{}{"type": stop.Name, "stop_token_map": stopMap}); err != nil {
+				return err
+			}
+			tokenFilters = append(tokenFilters, stopFilter)
+		}
+	case "simple":
+		tokenizer = letter.Name
+		tokenFilters = append(tokenFilters, lowercase.Name)
+	case "whitespace":
+		tokenizer = whitespace.Name
+	case "keyword":
+		tokenizer = single.Name
+	default:
+		return fmt.Errorf("unknown type %s", typ)
+	}
+	return m.AddCustomAnalyzer(name, map[string]interface{}{
+		"type":          custom.Name,
+		"tokenizer":     tokenizer,
+		"token_filters": tokenFilters,
+		"char_filters":  charFilters,
+	})
+}
+
+// componentNames returns the names of the filters, which may be a name or a list of names.
+func componentNames(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		names := make([]string, 0, len(v))
+		for _, name := range v {
+			if s, ok := name.(string); ok {
+				names = append(names, s)
+			}
+		}
+		return names
+	}
+	return nil
+}
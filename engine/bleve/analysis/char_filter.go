@@ -0,0 +1,100 @@
+package analysis
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/registry"
+)
+
+const (
+	MappingName        = "baud_mapping"
+	PatternReplaceName = "baud_pattern_replace"
+)
+
+// MappingCharFilter replaces the strings by the mappings like "ph => f", the longest match wins.
+type MappingCharFilter struct {
+	replacer *strings.Replacer
+}
+
+func NewMappingCharFilter(mappings []string) (*MappingCharFilter, error) {
+	var pairs []string
+	for _, m := range mappings {
+		i := strings.Index(m, "=>")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid mapping %q", m)
+		}
+		from, to := strings.TrimSpace(m[:i]), strings.TrimSpace(m[i+2:])
+		if from == "" {
+			return nil, fmt.Errorf("invalid mapping %q", m)
+		}
+		pairs = append(pairs, from, to)
+	}
+	return &MappingCharFilter{replacer: strings.NewReplacer(pairs...)}, nil
+}
+
+func (f *MappingCharFilter) Filter(input []byte) []byte {
+	return []byte(f.replacer.Replace(string(input)))
+}
+
+func MappingCharFilterConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.CharFilter, error) {
+	mappings, err := stringList(config["mappings"])
+	if err != nil {
+		return nil, err
+	}
+	return NewMappingCharFilter(mappings)
+}
+
+// PatternReplaceCharFilter replaces the matches of the pattern by the replacement, which may refer to the groups by $1.
+type PatternReplaceCharFilter struct {
+	pattern     *regexp.Regexp
+	replacement []byte
+}
+
+func NewPatternReplaceCharFilter(pattern, replacement string) (*PatternReplaceCharFilter, error) {
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &PatternReplaceCharFilter{pattern: r, replacement: []byte(replacement)}, nil
+}
+
+func (f *PatternReplaceCharFilter) Filter(input []byte) []byte {
+	return f.pattern.ReplaceAll(input, f.replacement)
+}
+
+func PatternReplaceCharFilterConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.CharFilter, error) {
+	pattern, ok := config["pattern"].(string)
+	if !ok {
+		return nil, fmt.Errorf("must specify pattern")
+	}
+	replacement, _ := config["replacement"].(string)
+	return NewPatternReplaceCharFilter(pattern, replacement)
+}
+
+func stringList(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case []string:
+		return v, nil
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid string %v", item)
+			}
+			list = append(list, s)
+		}
+		return list, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("invalid string list %v", v)
+}
+
+func init() {
+	registry.RegisterCharFilter(MappingName, MappingCharFilterConstructor)
+	registry.RegisterCharFilter(PatternReplaceName, PatternReplaceCharFilterConstructor)
+}
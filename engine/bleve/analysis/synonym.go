@@ -0,0 +1,133 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/registry"
+)
+
+const SynonymName = "baud_synonym"
+
+// SynonymFilter adds the synonyms of the tokens at the same positions.
+type SynonymFilter struct {
+	synonyms map[string][]string
+}
+
+/*
+NewSynonymFilter parses the synonym rules in the Solr format, such as
+
+	"ipod, i-pod, i pod"
+	"sea biscuit, sea biscit => seabiscuit"
+
+The equivalent words of a rule without => are synonyms of each other, or all of them are replaced by the first one
+if expand is false. The words on the left of => are replaced by the words on the right.
+Only single-word synonyms are supported, as the rules are not analyzed.
+*/
+func NewSynonymFilter(rules []string, expand bool) (*SynonymFilter, error) {
+	f := &SynonymFilter{synonyms: make(map[string][]string)}
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "" || strings.HasPrefix(rule, "#") {
+			continue
+		}
+		var from, to []string
+		var err error
+		if i := strings.Index(rule, "=>"); i >= 0 {
+			if from, err = parseWords(rule[:i]); err != nil {
+				return nil, err
+			}
+			if to, err = parseWords(rule[i+2:]); err != nil {
+				return nil, err
+			}
+		} else {
+			if from, err = parseWords(rule); err != nil {
+				return nil, err
+			}
+			to = from
+			if !expand {
+				to = from[:1]
+			}
+		}
+		if len(from) == 0 || len(to) == 0 {
+			return nil, fmt.Errorf("invalid synonym rule %q", rule)
+		}
+		for _, word := range from {
+			f.synonyms[word] = appendUnique(f.synonyms[word], to...)
+		}
+	}
+	return f, nil
+}
+
+func parseWords(s string) ([]string, error) {
+	var words []string
+	for _, word := range strings.Split(s, ",") {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		if strings.IndexFunc(word, isSpace) >= 0 {
+			return nil, fmt.Errorf("multi-word synonym %q is not supported", word)
+		}
+		words = append(words, word)
+	}
+	return words, nil
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+func appendUnique(words []string, added ...string) []string {
+	for _, word := range added {
+		found := false
+		for _, w := range words {
+			if w == word {
+				found = true
+				break
+			}
+		}
+		if !found {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func (f *SynonymFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	output := make(analysis.TokenStream, 0, len(input))
+	for _, token := range input {
+		synonyms, ok := f.synonyms[string(token.Term)]
+		if !ok || token.KeyWord {
+			output = append(output, token)
+			continue
+		}
+		for _, synonym := range synonyms {
+			output = append(output, &analysis.Token{
+				Term:     []byte(synonym),
+				Start:    token.Start,
+				End:      token.End,
+				Position: token.Position,
+				Type:     token.Type,
+			})
+		}
+	}
+	return output
+}
+
+func SynonymFilterConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.TokenFilter, error) {
+	rules, err := stringList(config["synonyms"])
+	if err != nil {
+		return nil, err
+	}
+	expand := true
+	if v, ok := config["expand"].(bool); ok {
+		expand = v
+	}
+	return NewSynonymFilter(rules, expand)
+}
+
+func init() {
+	registry.RegisterTokenFilter(SynonymName, SynonymFilterConstructor)
+}
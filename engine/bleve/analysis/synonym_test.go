@@ -0,0 +1,48 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve/analysis"
+)
+
+func TestSynonymFilter(t *testing.T) {
+	terms := func(stream analysis.TokenStream) []string {
+		var result []string
+		for _, token := range stream {
+			result = append(result, string(token.Term))
+		}
+		return result
+	}
+	input := func(words ...string) analysis.TokenStream {
+		stream := make(analysis.TokenStream, 0, len(words))
+		for i, word := range words {
+			stream = append(stream, &analysis.Token{Term: []byte(word), Position: i + 1})
+		}
+		return stream
+	}
+
+	f, err := NewSynonymFilter([]string{"# comment", "quick, fast", "tv => television"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := f.Filter(input("the", "fast", "tv"))
+	if expect := []string{"the", "quick", "fast", "television"}; !reflect.DeepEqual(terms(output), expect) {
+		t.Fatalf("invalid terms %v, expect %v", terms(output), expect)
+	}
+	if output[1].Position != output[2].Position {
+		t.Fatal("synonym is not at the position of the token")
+	}
+
+	if f, err = NewSynonymFilter([]string{"quick, fast"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if output = f.Filter(input("fast")); !reflect.DeepEqual(terms(output), []string{"quick"}) {
+		t.Fatalf("invalid terms %v", terms(output))
+	}
+
+	if _, err = NewSynonymFilter([]string{"sea biscuit => seabiscuit"}, true); err == nil {
+		t.Fatal("expect error of multi-word synonym")
+	}
+}
@@ -0,0 +1,219 @@
+// Package zh registers the analyzers, tokenizers and filters of the kernel in the bleve registry,
+// so that the bleve mappings can analyze the Chinese text by them.
+package zh
+
+import (
+	"fmt"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/registry"
+	kernel "github.com/tiglabs/baudengine/engine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/filter/lower"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/filter/stop"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/tokenizer/chinese"
+	kunicode "github.com/tiglabs/baudengine/engine/kernel/analysis/tokenizer/unicode"
+	"github.com/tiglabs/baudengine/engine/kernel/config"
+)
+
+const (
+	// TokenizerName is the jieba tokenizer of the kernel.
+	TokenizerName = "baud_zh"
+	// SegmentTokenizerName splits the text into segments by script, and tokenizes the Han segments by jieba.
+	SegmentTokenizerName = "baud_segment"
+	LowerName            = "baud_lower"
+	StopName             = "baud_stop"
+
+	// AnalyzerName is the jieba tokenizer with the lower and stop filters.
+	AnalyzerName = "baud_zh"
+	// StandardName is the standard analyzer of the kernel, the segment tokenizer with the lower and stop filters.
+	StandardName = "baud_standard"
+)
+
+// the config keys of the dicts and their default names in the kernel config
+var jiebaDicts = []struct {
+	key  string
+	name string
+}{
+	{"dict", "baud_zh.dict"},
+	{"hmm", "hmm_model.utf8"},
+	{"user_dict", "user.dict"},
+	{"idf", "idf.utf8"},
+	{"stop_words", "stop.dict"},
+}
+
+var (
+	jiebaLock sync.Mutex
+	// jieba loads large dicts, so the indexes share the tokenizers by the dicts
+	jiebas = make(map[string]*chinese.ZhTokenizer)
+)
+
+// Tokenizer adapts the tokenizer of the kernel to bleve.
+type Tokenizer struct {
+	tokenizer kernel.Tokenizer
+}
+
+func NewTokenizer(tokenizer kernel.Tokenizer) *Tokenizer {
+	return &Tokenizer{tokenizer: tokenizer}
+}
+
+func (t *Tokenizer) Tokenize(input []byte) analysis.TokenStream {
+	tokens := t.tokenizer.Tokenize(input)
+	stream := make(analysis.TokenStream, 0, len(tokens))
+	for _, token := range tokens {
+		stream = append(stream, &analysis.Token{
+			Term:     token.Term,
+			Start:    token.Start,
+			End:      token.End,
+			Position: token.Position,
+			Type:     tokenType(token.Term),
+		})
+	}
+	return stream
+}
+
+// tokenType returns the type of the term, as the kernel tokenizers do not set it.
+func tokenType(term []byte) analysis.TokenType {
+	r, _ := utf8.DecodeRune(term)
+	if unicode.Is(unicode.Han, r) {
+		return analysis.Ideographic
+	}
+	for _, r := range string(term) {
+		if !unicode.IsDigit(r) {
+			return analysis.AlphaNumeric
+		}
+	}
+	return analysis.Numeric
+}
+
+// TokenFilter adapts the token filter of the kernel to bleve, the filtered tokens keep their types.
+type TokenFilter struct {
+	filter kernel.TokenFilter
+}
+
+func NewTokenFilter(filter kernel.TokenFilter) *TokenFilter {
+	return &TokenFilter{filter: filter}
+}
+
+func (f *TokenFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	tokens := make(kernel.TokenSet, 0, len(input))
+	origins := make(map[*kernel.Token]*analysis.Token, len(input))
+	for _, token := range input {
+		t := &kernel.Token{Term: token.Term, Start: token.Start, End: token.End, Position: token.Position}
+		tokens = append(tokens, t)
+		origins[t] = token
+	}
+
+	output := make(analysis.TokenStream, 0, len(tokens))
+	for _, t := range f.filter.Filter(tokens) {
+		token, ok := origins[t]
+		if !ok {
+			token = &analysis.Token{Start: t.Start, End: t.End, Position: t.Position, Type: tokenType(t.Term)}
+		}
+		token.Term = t.Term
+		output = append(output, token)
+	}
+	return output
+}
+
+// dictPath returns the path of the dict from the config of the component, or from the kernel config.
+func dictPath(cfg map[string]interface{}, key, name string) (string, error) {
+	if path, ok := cfg[key].(string); ok && path != "" {
+		return path, nil
+	}
+	if path, ok := config.LookupWordDictPath(name); ok {
+		return path, nil
+	}
+	return "", fmt.Errorf("dict %s is not configured", name)
+}
+
+func jiebaTokenizer(cfg map[string]interface{}) (*chinese.ZhTokenizer, error) {
+	paths := make([]string, 0, len(jiebaDicts))
+	for _, dict := range jiebaDicts {
+		path, err := dictPath(cfg, dict.key, dict.name)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	key := fmt.Sprint(paths)
+
+	jiebaLock.Lock()
+	defer jiebaLock.Unlock()
+	tokenizer, ok := jiebas[key]
+	if !ok {
+		tokenizer = chinese.NewZhTokenizer(paths[0], paths[1], paths[2], paths[3], paths[4])
+		jiebas[key] = tokenizer
+	}
+	return tokenizer, nil
+}
+
+func TokenizerConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.Tokenizer, error) {
+	tokenizer, err := jiebaTokenizer(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewTokenizer(tokenizer), nil
+}
+
+func SegmentTokenizerConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.Tokenizer, error) {
+	tokenizer, err := jiebaTokenizer(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewTokenizer(kunicode.NewUnicodeTokenizerWith(tokenizer)), nil
+}
+
+func LowerConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.TokenFilter, error) {
+	return NewTokenFilter(lower.New()), nil
+}
+
+func StopConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.TokenFilter, error) {
+	path, err := dictPath(config, "stop_words", "stop_word.dict")
+	if err != nil {
+		return nil, err
+	}
+	filter, err := stop.NewWithDict(path)
+	if err != nil {
+		return nil, fmt.Errorf("load the stop words %s: %v", path, err)
+	}
+	return NewTokenFilter(filter), nil
+}
+
+func newAnalyzer(tokenizerName string, cache *registry.Cache) (*analysis.Analyzer, error) {
+	tokenizer, err := cache.TokenizerNamed(tokenizerName)
+	if err != nil {
+		return nil, err
+	}
+	lowerFilter, err := cache.TokenFilterNamed(LowerName)
+	if err != nil {
+		return nil, err
+	}
+	stopFilter, err := cache.TokenFilterNamed(StopName)
+	if err != nil {
+		return nil, err
+	}
+	return &analysis.Analyzer{
+		Tokenizer:    tokenizer,
+		TokenFilters: []analysis.TokenFilter{lowerFilter, stopFilter},
+	}, nil
+}
+
+func AnalyzerConstructor(config map[string]interface{}, cache *registry.Cache) (*analysis.Analyzer, error) {
+	return newAnalyzer(TokenizerName, cache)
+}
+
+func StandardConstructor(config map[string]interface{}, cache *registry.Cache) (*analysis.Analyzer, error) {
+	return newAnalyzer(SegmentTokenizerName, cache)
+}
+
+func init() {
+	registry.RegisterTokenizer(TokenizerName, TokenizerConstructor)
+	registry.RegisterTokenizer(SegmentTokenizerName, SegmentTokenizerConstructor)
+	registry.RegisterTokenFilter(LowerName, LowerConstructor)
+	registry.RegisterTokenFilter(StopName, StopConstructor)
+	registry.RegisterAnalyzer(AnalyzerName, AnalyzerConstructor)
+	registry.RegisterAnalyzer(StandardName, StandardConstructor)
+}
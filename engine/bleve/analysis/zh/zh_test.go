@@ -0,0 +1,58 @@
+package zh
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"unicode"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/filter/lower"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/filter/stop"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/tokenizer/character"
+)
+
+func TestAdapter(t *testing.T) {
+	f, err := ioutil.TempFile("", "stop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("the\n的\n")
+	f.Close()
+
+	stopFilter, err := stop.NewWithDict(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer := NewTokenizer(character.NewCharTokenizer(unicode.IsSpace))
+	filters := []analysis.TokenFilter{NewTokenFilter(lower.New()), NewTokenFilter(stopFilter)}
+	stream := tokenizer.Tokenize([]byte("The 中国 的 2018"))
+	for _, filter := range filters {
+		stream = filter.Filter(stream)
+	}
+
+	var terms []string
+	var types []analysis.TokenType
+	for _, token := range stream {
+		terms = append(terms, string(token.Term))
+		types = append(types, token.Type)
+	}
+	if expect := []string{"中国", "2018"}; !reflect.DeepEqual(terms, expect) {
+		t.Fatalf("invalid terms %v, expect %v", terms, expect)
+	}
+	if expect := []analysis.TokenType{analysis.Ideographic, analysis.Numeric}; !reflect.DeepEqual(types, expect) {
+		t.Fatalf("invalid types %v, expect %v", types, expect)
+	}
+	if stream[0].Position != 2 || stream[0].Start != 4 || stream[0].End != 10 {
+		t.Fatalf("invalid token %v", stream[0])
+	}
+}
+
+func TestStopConstructorMissingDict(t *testing.T) {
+	filter, err := StopConstructor(map[string]interface{}{"stop_words": "/nonexistent/stop_word.dict"}, nil)
+	if err == nil || filter != nil {
+		t.Fatalf("expect the error of the missing dict, got %v", filter)
+	}
+}
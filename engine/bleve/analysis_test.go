@@ -0,0 +1,157 @@
+package bleve
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestCustomAnalyzer(t *testing.T) {
+	newMemory := func(schema string) engine.Engine {
+		e, err := New(engine.EngineConfig{Schema: schema, ExtraOptions: `{"store": "memory"}`})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	ctx := context.Background()
+	count := func(e engine.Engine, q string) uint64 {
+		result, err := e.Search(ctx, &engine.SearchRequest{Query: []byte(q), Size: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result.Hits.Total
+	}
+
+	// two spaces define different analyzers with the same name
+	e1 := newMemory(`{
+  "settings": {
+    "analysis": {
+      "char_filter": { "dash": { "type": "mapping", "mappings": ["- => _"] } },
+      "filter": {
+        "my_stop":    { "type": "stop", "stopwords": ["the", "a"] },
+        "my_synonym": { "type": "synonym", "synonyms": ["quick, fast"] }
+      },
+      "analyzer": {
+        "my_analyzer": {
+          "type": "custom",
+          "char_filter": ["html_strip", "dash"],
+          "tokenizer": "whitespace",
+          "filter": ["lowercase", "my_stop", "my_synonym"]
+        }
+      }
+    }
+  },
+  "mappings": { "doc": { "properties": { "title": { "type": "text", "analyzer": "my_analyzer" } } } }
+}`)
+	defer e1.Close()
+	e2 := newMemory(`{
+  "settings": { "index": { "analysis": { "analyzer": { "my_analyzer": { "type": "keyword" } } } } },
+  "mappings": { "doc": { "properties": { "title": { "type": "text", "analyzer": "my_analyzer" } } } }
+}`)
+	defer e2.Close()
+
+	doc := map[string]interface{}{"title": "<b>The</b> Fast well-known Fox"}
+	for _, e := range []engine.Engine{e1, e2} {
+		if err := e.AddDocument(ctx, engine.DOC_ID("1"), doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for q, expect := range map[string]uint64{
+		`{"term": {"title": "quick"}}`:      1,
+		`{"term": {"title": "fast"}}`:       1,
+		`{"term": {"title": "well_known"}}`: 1,
+		`{"term": {"title": "the"}}`:        0,
+		`{"term": {"title": "<b>"}}`:        0,
+	} {
+		if n := count(e1, q); n != expect {
+			t.Fatalf("query %s has %d hits, expect %d", q, n, expect)
+		}
+	}
+	if n := count(e2, `{"term": {"title": "<b>The</b> Fast well-known Fox"}}`); n != 1 {
+		t.Fatalf("analyzer of the other space is used, %d hits", n)
+	}
+
+	for _, invalid := range []string{
+		`{"settings": {"analysis": {"analyzer": {"a": {"type": "unknown"}}}}, "mappings": {"doc": {}}}`,
+		`{"settings": {"analysis": {"filter": {"f": {"type": "unknown"}}}}, "mappings": {"doc": {}}}`,
+		`{"settings": {"analysis": {"analyzer": {"a": {"type": "custom", "tokenizer": "unknown"}}}}, "mappings": {"doc": {}}}`,
+	} {
+		if _, err := ParseSchema([]byte(invalid)); err == nil {
+			t.Fatalf("expect error of schema %s", invalid)
+		}
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	e, err := New(engine.EngineConfig{ExtraOptions: `{"store": "memory"}`, Schema: `{
+  "settings": {
+    "analysis": {
+      "filter":   { "my_stop": { "type": "stop", "stopwords": ["the"] } },
+      "analyzer": { "my_analyzer": { "tokenizer": "whitespace", "filter": ["lowercase", "my_stop"] } }
+    }
+  },
+  "mappings": { "doc": { "properties": {
+    "title": { "type": "text", "analyzer": "my_analyzer" },
+    "tag":   { "type": "keyword" }
+  } } }
+}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	analyze := func(body string) []engine.AnalyzeToken {
+		req := &engine.AnalyzeRequest{}
+		if err := json.Unmarshal([]byte(body), req); err != nil {
+			t.Fatal(err)
+		}
+		result, err := e.(engine.Analyzer).Analyze(context.Background(), req)
+		if err != nil {
+			t.Fatalf("analyze %s error: %v", body, err)
+		}
+		return result.Tokens
+	}
+	terms := func(tokens []engine.AnalyzeToken) []string {
+		var result []string
+		for _, token := range tokens {
+			result = append(result, token.Token)
+		}
+		return result
+	}
+
+	tokens := analyze(`{"analyzer": "my_analyzer", "text": "The Quick Fox"}`)
+	if !reflect.DeepEqual(terms(tokens), []string{"quick", "fox"}) {
+		t.Fatalf("invalid tokens %v", tokens)
+	}
+	if expect := (engine.AnalyzeToken{Token: "quick", StartOffset: 4, EndOffset: 9, Type: "<ALPHANUM>", Position: 1}); tokens[0] != expect {
+		t.Fatalf("invalid token %v, expect %v", tokens[0], expect)
+	}
+	if tokens = analyze(`{"field": "title", "text": ["The Fox", "Dog"]}`); !reflect.DeepEqual(terms(tokens), []string{"fox", "dog"}) || tokens[1].Position != 102 || tokens[1].StartOffset != 8 {
+		t.Fatalf("invalid tokens of the texts %v", tokens)
+	}
+	if tokens = analyze(`{"field": "tag", "text": "The Fox"}`); !reflect.DeepEqual(terms(tokens), []string{"The Fox"}) {
+		t.Fatalf("invalid tokens of keyword %v", tokens)
+	}
+	tokens = analyze(`{"tokenizer": "whitespace", "filter": ["my_stop", {"type": "length", "min": 4}], "char_filter": ["html_strip"], "text": "the <b>quick</b> fox"}`)
+	if !reflect.DeepEqual(terms(tokens), []string{"quick"}) {
+		t.Fatalf("invalid tokens of the ad-hoc analyzer %v", tokens)
+	}
+
+	for _, invalid := range []string{
+		`{"analyzer": "unknown", "text": "fox"}`,
+		`{"analyzer": "my_analyzer", "field": "title", "text": "fox"}`,
+		`{"filter": ["lowercase"], "text": "fox"}`,
+		`{"tokenizer": {"type": "unknown"}, "text": "fox"}`,
+	} {
+		req := &engine.AnalyzeRequest{}
+		if err = json.Unmarshal([]byte(invalid), req); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = e.(engine.Analyzer).Analyze(context.Background(), req); err == nil {
+			t.Fatalf("expect error of %s", invalid)
+		}
+	}
+}
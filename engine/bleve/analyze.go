@@ -0,0 +1,158 @@
+package bleve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+const (
+	// the position and offset gaps between the texts of a request, as the gaps between the values of a text field
+	analyzePositionGap = 100
+	analyzeOffsetGap   = 1
+
+	adHocAnalyzer = "_analyze"
+)
+
+var _ engine.Analyzer = &Bleve{}
+
+var tokenTypeNames = map[analysis.TokenType]string{
+	analysis.AlphaNumeric: "<ALPHANUM>",
+	analysis.Ideographic:  "<IDEOGRAPHIC>",
+	analysis.Numeric:      "<NUM>",
+	analysis.DateTime:     "<DATE>",
+	analysis.Shingle:      "shingle",
+	analysis.Single:       "word",
+	analysis.Double:       "<DOUBLE>",
+	analysis.Boolean:      "<BOOLEAN>",
+}
+
+// Analyze returns the tokens of the texts, the analyzers are resolved by the mapping of the engine.
+func (b *Bleve) Analyze(ctx context.Context, req *engine.AnalyzeRequest) (*engine.AnalyzeResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	analyzer, err := b.analyzer(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &engine.AnalyzeResult{Tokens: make([]engine.AnalyzeToken, 0)}
+	position, offset := 0, 0
+	for i, text := range req.Text {
+		if i > 0 {
+			position += analyzePositionGap
+			offset += analyzeOffsetGap
+		}
+		last := 0
+		for _, token := range analyzer.Analyze([]byte(text)) {
+			result.Tokens = append(result.Tokens, engine.AnalyzeToken{
+				Token:       string(token.Term),
+				StartOffset: offset + token.Start,
+				EndOffset:   offset + token.End,
+				Type:        tokenTypeNames[token.Type],
+				Position:    position + token.Position - 1,
+			})
+			if token.Position > last {
+				last = token.Position
+			}
+		}
+		position += last
+		offset += len(text)
+	}
+	return result, nil
+}
+
+func (b *Bleve) analyzer(req *engine.AnalyzeRequest) (*analysis.Analyzer, error) {
+	m, ok := b.Mapping().(*IndexMapping)
+	if !ok {
+		return nil, fmt.Errorf("engine has no schema")
+	}
+	name := m.DefaultAnalyzer
+	switch {
+	case req.Field != "":
+		name = m.AnalyzerNameForPath(req.Field)
+	case req.Analyzer != "":
+		name = req.Analyzer
+	case len(req.Tokenizer) > 0:
+		return m.adHocAnalyzer(req)
+	}
+	if analyzer := m.AnalyzerNamed(name); analyzer != nil {
+		return analyzer, nil
+	}
+	return nil, fmt.Errorf("analyzer %s not found", name)
+}
+
+// adHocAnalyzer builds the analyzer of the components in the request, which may refer to the components of the schema.
+func (m *IndexMapping) adHocAnalyzer(req *engine.AnalyzeRequest) (*analysis.Analyzer, error) {
+	schema := struct {
+		Settings json.RawMessage `json:"settings,omitempty"`
+	}{}
+	if err := json.Unmarshal(m.schema, &schema); err != nil {
+		return nil, err
+	}
+	settings := &analysisSettings{}
+	if len(schema.Settings) > 0 {
+		s, err := parseAnalysisSettings(schema.Settings)
+		if err != nil {
+			return nil, err
+		}
+		if s != nil {
+			settings = s
+		}
+	}
+
+	def := map[string]interface{}{"type": "custom"}
+	var err error
+	if def["tokenizer"], err = adHocComponent(&settings.Tokenizers, "_analyze_tokenizer", req.Tokenizer); err != nil {
+		return nil, fmt.Errorf("invalid tokenizer: %v", err)
+	}
+	filters := make([]interface{}, 0, len(req.Filters))
+	for i, filter := range req.Filters {
+		name, err := adHocComponent(&settings.Filters, fmt.Sprintf("_analyze_filter_%d", i), filter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter: %v", err)
+		}
+		filters = append(filters, name)
+	}
+	charFilters := make([]interface{}, 0, len(req.CharFilters))
+	for i, filter := range req.CharFilters {
+		name, err := adHocComponent(&settings.CharFilters, fmt.Sprintf("_analyze_char_filter_%d", i), filter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid char_filter: %v", err)
+		}
+		charFilters = append(charFilters, name)
+	}
+	def["filter"], def["char_filter"] = filters, charFilters
+	settings.Analyzers = map[string]map[string]interface{}{adHocAnalyzer: def}
+
+	im := mapping.NewIndexMapping()
+	if err = settings.register(im); err != nil {
+		return nil, err
+	}
+	if analyzer := im.AnalyzerNamed(adHocAnalyzer); analyzer != nil {
+		return analyzer, nil
+	}
+	return nil, fmt.Errorf("invalid analyze request")
+}
+
+// adHocComponent returns the name of the component, the component is added to the definitions if it is not a name.
+func adHocComponent(defs *map[string]map[string]interface{}, name string, data json.RawMessage) (string, error) {
+	var ref string
+	if err := json.Unmarshal(data, &ref); err == nil {
+		return ref, nil
+	}
+	def := make(map[string]interface{})
+	if err := json.Unmarshal(data, &def); err != nil {
+		return "", err
+	}
+	if *defs == nil {
+		*defs = make(map[string]map[string]interface{})
+	}
+	(*defs)[name] = def
+	return name, nil
+}
@@ -12,7 +12,6 @@ var _ store.KVIterator  = &Iterator{}
 
 type Iterator struct {
 	close  sync.Once
-	tx     *badger.Txn
 	iter   *badger.Iterator
 	prefix []byte
 	start  []byte
@@ -122,9 +121,6 @@ func (i *Iterator) Close() error {
 		if i.iter != nil {
 			i.iter.Close()
 		}
-		if i.tx != nil {
-			i.tx.Discard()
-		}
 	})
 
 	return nil
@@ -46,7 +46,6 @@ func (r *Reader)PrefixIterator(prefix []byte) store.KVIterator {
 	opts.PrefetchSize = 10
 	it := r.tx.NewIterator(opts)
 	rv := &Iterator{
-		tx:     r.tx,
 		iter:   it,
 		prefix: prefix,
 	}
@@ -62,7 +61,6 @@ func (r *Reader)RangeIterator(start, end []byte) store.KVIterator {
 	opts.PrefetchSize = 10
 	it := r.tx.NewIterator(opts)
 	rv := &Iterator{
-		tx:    r.tx,
 		iter:  it,
 		start: start,
 		end:   end,
@@ -72,7 +70,7 @@ func (r *Reader)RangeIterator(start, end []byte) store.KVIterator {
 	return rv
 }
 
-// Close closes the iterator
+// Close discards the transaction of the reader, the iterators of the reader share it
 func (r *Reader)Close() error {
 	r.tx.Discard()
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/dgraph-io/badger"
 	"github.com/blevesearch/bleve/index/store"
 	"github.com/blevesearch/bleve/registry"
+	"github.com/tiglabs/baudengine/util"
 )
 
 const (
@@ -38,6 +39,18 @@ func New(mo store.MergeOperator, config map[string]interface{}) (store.KVStore,
 	if ok {
 		opts.ReadOnly = ro
 	}
+	if size, ok := util.ConfigInt(config, "value_log_file_size"); ok {
+		opts.ValueLogFileSize = size
+	}
+	if size, ok := util.ConfigInt(config, "max_table_size"); ok {
+		opts.MaxTableSize = size
+	}
+	if n, ok := util.ConfigInt(config, "num_memtables"); ok {
+		opts.NumMemtables = int(n)
+	}
+	if n, ok := util.ConfigInt(config, "value_threshold"); ok {
+		opts.ValueThreshold = int(n)
+	}
 
 	db, err := badger.Open(opts)
 	if err != nil {
@@ -2,65 +2,308 @@ package bleve
 
 import (
 	"context"
-	"path"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 
 	"github.com/blevesearch/bleve"
 	"github.com/tiglabs/baudengine/engine"
 	"github.com/blevesearch/bleve/index/store"
+	"github.com/blevesearch/bleve/index/store/boltdb"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/registry"
 	"github.com/tiglabs/baudengine/engine/bleve/badgerdb"
+	"github.com/tiglabs/baudengine/engine/bleve/memdb"
 )
 
 const Name = "bleve"
 
 var RAFT_APPLY_ID = []byte("_raft_apply_id")
 
+// DYNAMIC_MAPPING_KEY keeps the fields added by the dynamic templates, so that they are mapped again when the index is opened.
+var DYNAMIC_MAPPING_KEY = []byte("_dynamic_mapping")
+
 var _ engine.Engine = &Bleve{}
+var _ engine.SchemaUpdater = &Bleve{}
 
 type Bleve struct {
-	index bleve.Index
+	path     string
+	mapping  mapping.IndexMapping
+	// current is the mapping with the fields added by the dynamic templates, it is replaced when a batch adds fields.
+	current  atomic.Value
+	// mappingLock serializes the changes of the current mapping.
+	mappingLock sync.Mutex
+	kvstore  string
+	kvconfig map[string]interface{}
+	// indexLock guards the index and its kv config against their replacement by ApplySnapshot.
+	indexLock sync.RWMutex
+	index    bleve.Index
 }
 
 func New(cfg engine.EngineConfig) (engine.Engine, error) {
 
-	docMappings, err := ParseSchema([]byte(cfg.Schema))
+	mapping, err := newIndexMapping([]byte(cfg.Schema), cfg.SchemaVersion)
 	if err != nil {
 		return nil, err
 	}
-	if docMappings == nil {
-		return nil, errors.New("invalid schema")
+	kvstore, kvconfig, err := parseStoreOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bleve{path: path.Join(cfg.Path, "baud.bleve"), mapping: mapping, kvstore: kvstore, kvconfig: kvconfig}
+	if kvstore != memdb.Name {
+		if err = migrateLegacyStore(b.path); err != nil {
+			return nil, err
+		}
 	}
-	mapping := bleve.NewIndexMapping()
-	mapping.DefaultMapping = docMappings[0]
-    kvconfig := make(map[string]interface{})
-    kvconfig["path"] = path.Join(cfg.Path, "baud.bleve", "data")
-    kvconfig["sync"] = false
-    kvconfig["read_only"] = cfg.ReadOnly
-	index, err := bleve.NewUsing(path.Join(cfg.Path, "baud.bleve"), mapping, bleve.Config.DefaultIndexType, badgerdb.Name, kvconfig)
+	b.index, err = b.open()
 	if err != nil {
 		return nil, err
 	}
-	return &Bleve{index: index}, nil
+	if err = b.loadDynamicMapping(); err != nil {
+		b.index.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+// Mapping returns the current mapping of the documents.
+func (b *Bleve) Mapping() mapping.IndexMapping {
+	if m, ok := b.current.Load().(mapping.IndexMapping); ok {
+		return m
+	}
+	return b.mapping
+}
+
+// loadDynamicMapping adds the fields kept by the dynamic templates to the schema mapping.
+func (b *Bleve) loadDynamicMapping() error {
+	m, ok := b.Mapping().(*IndexMapping)
+	if !ok {
+		return nil
+	}
+	data, err := b.index.GetInternal(DYNAMIC_MAPPING_KEY)
+	if err != nil {
+		return err
+	}
+	fields := make(map[string]map[string][]*mapping.FieldMapping)
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &fields); err != nil {
+			return fmt.Errorf("invalid dynamic mapping: %v", err)
+		}
+	}
+	if m, err = newIndexMapping(m.schema, m.version, fields); err != nil {
+		return err
+	}
+	b.mappingLock.Lock()
+	b.current.Store(m)
+	b.mappingLock.Unlock()
+	return nil
+}
+
+// UpdateSchema replaces the schema of the documents, the fields added by the dynamic templates are kept.
+// Only the documents written later are mapped by the new schema.
+func (b *Bleve) UpdateSchema(schema string, version uint64) error {
+	b.mappingLock.Lock()
+	defer b.mappingLock.Unlock()
+	current, ok := b.Mapping().(*IndexMapping)
+	if !ok {
+		return errors.New("the mapping of the engine can not be updated")
+	}
+	if version <= current.version {
+		return nil
+	}
+	m, err := newIndexMapping([]byte(schema), version, current.dynamicFields)
+	if err != nil {
+		return err
+	}
+	b.current.Store(m)
+	return nil
+}
+
+// commitMapping replaces the current mapping by the mapping of a committed batch,
+// the dynamic fields of the batch are added to the new schema if the schema is updated meanwhile.
+func (b *Bleve) commitMapping(m *IndexMapping) error {
+	b.mappingLock.Lock()
+	defer b.mappingLock.Unlock()
+	if current, ok := b.Mapping().(*IndexMapping); ok && current.version != m.version {
+		var err error
+		if m, err = current.withDynamicFields(m.dynamicFields); err != nil {
+			return err
+		}
+	}
+	b.current.Store(m)
+	return nil
+}
+
+// storeNames maps the store option to the registered kv store.
+var storeNames = map[string]string{
+	"":         badgerdb.Name,
+	"badger":   badgerdb.Name,
+	"badgerdb": badgerdb.Name,
+	"rocksdb":  "rocksdb",
+	"boltdb":   boltdb.Name,
+	"memory":   memdb.Name,
+}
+
+/*
+parseStoreOptions returns the kv store and its config from the ExtraOptions of the engine config, such as
+
+{
+    "store": "badger",
+    "sync": true,
+    "value_log_file_size": 1073741824
+}
+
+The store is one of badger (the default), rocksdb, boltdb and memory, the other options are passed to the store.
+*/
+func parseStoreOptions(cfg engine.EngineConfig) (string, map[string]interface{}, error) {
+	kvconfig := make(map[string]interface{})
+	if cfg.ExtraOptions != "" {
+		if err := json.Unmarshal([]byte(cfg.ExtraOptions), &kvconfig); err != nil {
+			return "", nil, fmt.Errorf("invalid engine options: %v", err)
+		}
+	}
+	option, _ := kvconfig["store"].(string)
+	delete(kvconfig, "store")
+	kvstore, ok := storeNames[option]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown store %s", option)
+	}
+	if registry.KVStoreConstructorByName(kvstore) == nil {
+		return "", nil, fmt.Errorf("store %s is not built in, build with -tags %s", option, option)
+	}
+	if _, ok := kvconfig["sync"]; !ok {
+		kvconfig["sync"] = false
+	}
+	kvconfig["read_only"] = cfg.ReadOnly
+	switch kvstore {
+	case boltdb.Name:
+		sync, _ := kvconfig["sync"].(bool)
+		kvconfig["nosync"] = !sync
+	case memdb.Name:
+		kvconfig[memdb.ConfigDB] = memdb.NewDB()
+	}
+	return kvstore, kvconfig, nil
+}
+
+// storeConfig returns a copy of the kv config, because bleve adds the runtime options to the config it is given.
+func storeConfig(kvconfig map[string]interface{}) map[string]interface{} {
+	config := make(map[string]interface{}, len(kvconfig))
+	for k, v := range kvconfig {
+		config[k] = v
+	}
+	return config
+}
+
+// legacyStoreDir is the badger directory of the indexes written before the store path was left to bleve.
+// Those indexes were created in the store directory of bleve but reopened in legacyStoreDir,
+// so once it exists it holds the data and the raft apply ID of the index.
+const legacyStoreDir = "data"
+
+// migrateLegacyStore moves the legacy store of the index at path to the store directory of bleve.
+func migrateLegacyStore(path string) error {
+	legacy := filepath.Join(path, legacyStoreDir)
+	if _, err := os.Stat(legacy); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	current := filepath.Join(path, "store")
+	if err := os.RemoveAll(current); err != nil {
+		return err
+	}
+	return os.Rename(legacy, current)
+}
+
+// open opens the index at the engine path, creating it if it does not exist yet.
+func (b *Bleve) open() (bleve.Index, error) {
+	return b.openAt(b.path, b.kvconfig)
+}
+
+// openAt opens the index at the path with the kv config, creating it if it does not exist yet.
+// The in-memory index has no path, it is opened on the data kept by the memdb.DB of the config.
+func (b *Bleve) openAt(path string, kvconfig map[string]interface{}) (bleve.Index, error) {
+	if b.kvstore == memdb.Name {
+		return bleve.NewUsing("", b.mapping, bleve.Config.DefaultIndexType, b.kvstore, storeConfig(kvconfig))
+	}
+	index, err := bleve.OpenUsing(path, storeConfig(kvconfig))
+	if err == bleve.ErrorIndexPathDoesNotExist {
+		index, err = bleve.NewUsing(path, b.mapping, bleve.Config.DefaultIndexType, b.kvstore, storeConfig(kvconfig))
+	}
+	return index, err
 }
 
 func(b *Bleve)NewWriteBatch() engine.Batch {
-	return NewBatch(b.index)
+	return NewBatch(b)
 }
 
+// snapshotReaderOpened is called by NewSnapshot between the openings of its index reader and its kv reader, it is set by the tests.
+var snapshotReaderOpened = func() {}
+
+// NewSnapshot opens a point in time view of the index.
+// Its search reader and its kv reader are opened one after the other, so the apply ID is read by the kv reader
+// whose pairs are streamed to the followers.
 func (b *Bleve)NewSnapshot() (engine.Snapshot, error) {
-	_, store, err := b.index.Advanced()
+	b.indexLock.RLock()
+	defer b.indexLock.RUnlock()
+	i, store, err := b.index.Advanced()
+	if err != nil {
+		return nil, err
+	}
+	indexReader, err := i.Reader()
 	if err != nil {
 		return nil, err
 	}
+	snapshotReaderOpened()
 	reader, err := store.Reader()
 	if err != nil {
+		indexReader.Close()
 		return nil, err
 	}
-	return &Snapshot{reader: reader}, nil
+	return &Snapshot{reader: reader, indexReader: indexReader, bleve: b}, nil
 }
 
+// ApplySnapshot replaces all local data by the key/value pairs of iter.
+// The new index is built aside, at a temporary path or on a new memdb.DB, and it replaces the current index
+// only when all the pairs are written, so a failed snapshot leaves the current index open and unchanged.
+// The raft apply ID is carried as an internal key, so it is restored together with the data.
 func (b *Bleve)ApplySnapshot(ctx context.Context, iter engine.Iterator) error {
-	_, _store, err := b.index.Advanced()
+	b.indexLock.RLock()
+	kvconfig := storeConfig(b.kvconfig)
+	b.indexLock.RUnlock()
+	tmpPath := b.path + ".snapshot"
+	if b.kvstore == memdb.Name {
+		kvconfig[memdb.ConfigDB] = memdb.NewDB()
+	} else if err := os.RemoveAll(tmpPath); err != nil {
+		return err
+	}
+	index, err := b.openAt(tmpPath, kvconfig)
+	if err != nil {
+		return err
+	}
+	if err = writeSnapshot(ctx, index, iter); err == nil {
+		err = index.Close()
+	} else {
+		index.Close()
+	}
+	if err != nil {
+		if b.kvstore != memdb.Name {
+			os.RemoveAll(tmpPath)
+		}
+		return err
+	}
+	return b.replaceIndex(tmpPath, kvconfig)
+}
+
+// writeSnapshot writes the key/value pairs of iter into the store of the index in batches.
+func writeSnapshot(ctx context.Context, index bleve.Index, iter engine.Iterator) error {
+	_, _store, err := index.Advanced()
 	if err != nil {
 		return err
 	}
@@ -70,24 +313,80 @@ func (b *Bleve)ApplySnapshot(ctx context.Context, iter engine.Iterator) error {
 	}
 	var batch store.KVBatch
 	var count int
-	for iter.Valid() {
+	for ; iter.Valid(); iter.Next() {
+		select {
+		case <-ctx.Done():
+			writer.Close()
+			return ctx.Err()
+		default:
+		}
 		if batch == nil {
-			batch = store.NewEmulatedBatch(nil)
+			batch = writer.NewBatch()
 		}
 		batch.Set(iter.Key(), iter.Value())
 		count++
 		if count % 100 == 0 {
 			err = writer.ExecuteBatch(batch)
 			if err != nil {
+				writer.Close()
 				return err
 			}
 			batch = nil
 		}
 	}
 	if batch != nil {
-		return writer.ExecuteBatch(batch)
+		if err = writer.ExecuteBatch(batch); err != nil {
+			writer.Close()
+			return err
+		}
 	}
-	return nil
+	return writer.Close()
 }
 
+// replaceIndex replaces the current index by the index built at path with kvconfig.
+// The old index is kept until the new one is opened, it is opened again if the new one fails.
+func (b *Bleve) replaceIndex(path string, kvconfig map[string]interface{}) error {
+	b.indexLock.Lock()
+	defer b.indexLock.Unlock()
+	if err := b.index.Close(); err != nil {
+		return err
+	}
+	oldPath, oldConfig := b.path + ".old", b.kvconfig
+	moved := false
+	restore := func(err error) error {
+		if moved {
+			os.RemoveAll(b.path)
+			os.Rename(oldPath, b.path)
+		}
+		b.kvconfig = oldConfig
+		index, rerr := b.open()
+		if rerr != nil {
+			return fmt.Errorf("%v, and the old index can not be opened: %v", err, rerr)
+		}
+		b.index = index
+		return err
+	}
 
+	if b.kvstore != memdb.Name {
+		if err := os.RemoveAll(oldPath); err != nil {
+			return restore(err)
+		}
+		if err := os.Rename(b.path, oldPath); err != nil && !os.IsNotExist(err) {
+			return restore(err)
+		}
+		moved = true
+		if err := os.Rename(path, b.path); err != nil {
+			return restore(err)
+		}
+	}
+	b.kvconfig = kvconfig
+	index, err := b.open()
+	if err != nil {
+		return restore(err)
+	}
+	b.index = index
+	if b.kvstore != memdb.Name {
+		os.RemoveAll(oldPath)
+	}
+	return b.loadDynamicMapping()
+}
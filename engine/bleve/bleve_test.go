@@ -7,16 +7,16 @@ import (
 	"golang.org/x/net/context"
 )
 
-var path = "/tmp/baud"
+var testPath = "/tmp/baud"
 
 
 func clear() {
-	os.Remove(path)
+	os.RemoveAll(testPath)
 }
 
 func blever(t *testing.T, schema string) engine.Engine {
 	cfg := engine.EngineConfig{
-		Path: path,
+		Path: testPath,
 		ReadOnly: false,
 		Schema: schema,
 	}
@@ -48,5 +48,148 @@ func TestWriteDoc(t *testing.T) {
 		index.Close()
 		clear()
 	}()
-	index.AddDocument(context.Background(), engine.DOC_ID("doc1"), )
+	err := index.AddDocument(context.Background(), engine.DOC_ID("doc1"), map[string]interface{}{"name": "baud", "age": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateLegacyStore(t *testing.T) {
+	clear()
+	defer clear()
+	schema := `{"mappings": {"baud": {"properties": {"name": {"type": "string"}}}}}`
+	index := blever(t, schema)
+	ctx := context.Background()
+	if err := index.AddDocument(ctx, engine.DOC_ID("doc1"), map[string]interface{}{"name": "baud"}); err != nil {
+		t.Fatal(err)
+	}
+	index.Close()
+
+	// the legacy layout reopened the store in the data directory, next to the store directory of the creation
+	dir := testPath + "/baud.bleve/"
+	if err := os.Rename(dir+"store", dir+legacyStoreDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(dir+"store", 0700); err != nil {
+		t.Fatal(err)
+	}
+	index = blever(t, schema)
+	defer index.Close()
+	if _, found := index.GetDocument(ctx, engine.DOC_ID("doc1"), nil); !found {
+		t.Fatal("document of the legacy store is lost")
+	}
+	if _, err := os.Stat(dir + legacyStoreDir); !os.IsNotExist(err) {
+		t.Fatalf("the legacy store is left: %v", err)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	schema := `{"mappings": {"baud": {"properties": {"name": {"type": "string"}}}}}`
+	newMemory := func() engine.Engine {
+		e, err := New(engine.EngineConfig{Schema: schema, ExtraOptions: `{"store": "memory"}`})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	src, dst := newMemory(), newMemory()
+	defer src.Close()
+	defer dst.Close()
+
+	ctx := context.Background()
+	if err := src.AddDocument(ctx, engine.DOC_ID("doc1"), map[string]interface{}{"name": "baud"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.SetApplyID(10); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := dst.GetDocument(ctx, engine.DOC_ID("doc1"), nil); found {
+		t.Fatal("memory stores must not share data")
+	}
+
+	snap, err := src.NewSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	iter := snap.NewIterator()
+	err = dst.ApplySnapshot(ctx, iter)
+	iter.Close()
+	snap.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := dst.GetDocument(ctx, engine.DOC_ID("doc1"), nil); !found {
+		t.Fatal("document is lost after applying the snapshot")
+	}
+	if id, err := dst.GetApplyID(); err != nil || id != 10 {
+		t.Fatalf("invalid apply id %d after applying the snapshot: %v", id, err)
+	}
+
+	for _, options := range []string{`{"store": "leveldb"}`, `{"store": "rocksdb"}`, `{"store"`} {
+		if _, err := New(engine.EngineConfig{Schema: schema, ExtraOptions: options}); err == nil {
+			t.Fatalf("expect error of options %s", options)
+		}
+	}
+}
+
+func TestApplySnapshotReplace(t *testing.T) {
+	clear()
+	schema := `{"mappings": {"baud": {"properties": {"name": {"type": "string"}}}}}`
+	src, err := New(engine.EngineConfig{Schema: schema, ExtraOptions: `{"store": "memory"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+	dst := blever(t, schema)
+	defer func() {
+		dst.Close()
+		clear()
+	}()
+
+	ctx := context.Background()
+	if err = src.AddDocument(ctx, engine.DOC_ID("new"), map[string]interface{}{"name": "baud"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = dst.AddDocument(ctx, engine.DOC_ID("old"), map[string]interface{}{"name": "baud"}); err != nil {
+		t.Fatal(err)
+	}
+	apply := func(ctx context.Context) error {
+		snap, err := src.NewSnapshot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer snap.Close()
+		iter := snap.NewIterator()
+		defer iter.Close()
+		return dst.ApplySnapshot(ctx, iter)
+	}
+
+	// a failed snapshot keeps the current index
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err = apply(canceled); err == nil {
+		t.Fatal("expect the error of the canceled snapshot")
+	}
+	if _, found := dst.GetDocument(ctx, engine.DOC_ID("old"), nil); !found {
+		t.Fatal("document is lost by a failed snapshot")
+	}
+	if _, err = os.Stat(testPath + "/baud.bleve.snapshot"); !os.IsNotExist(err) {
+		t.Fatalf("the temporary index is left: %v", err)
+	}
+
+	if err = apply(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := dst.GetDocument(ctx, engine.DOC_ID("new"), nil); !found {
+		t.Fatal("document is lost after applying the snapshot")
+	}
+	if _, found := dst.GetDocument(ctx, engine.DOC_ID("old"), nil); found {
+		t.Fatal("document is kept after applying the snapshot")
+	}
+	if err = dst.AddDocument(ctx, engine.DOC_ID("next"), map[string]interface{}{"name": "baud"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := dst.GetDocument(ctx, engine.DOC_ID("next"), nil); !found {
+		t.Fatal("document is not written after applying the snapshot")
+	}
 }
@@ -0,0 +1,71 @@
+package bleve
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestFunctionScoreQuery(t *testing.T) {
+	b := memBleve(t, map[string]interface{}{
+		"a": map[string]interface{}{"title": "apple", "price": 10.0, "date": "2018-01-01T00:00:00Z", "location": []interface{}{116.40, 39.90}},
+		"b": map[string]interface{}{"title": "apple pie", "price": 40.0, "date": "2018-01-11T00:00:00Z", "location": []interface{}{116.40, 40.00}},
+		"c": map[string]interface{}{"title": "apple tree", "price": 20.0, "date": "2018-01-06T00:00:00Z", "location": []interface{}{116.40, 40.85}},
+	})
+	defer b.Close()
+
+	groups := []struct {
+		query string
+		ids   []string
+	}{
+		{`{"function_score": {"query": {"match": {"title": "apple"}}, "field_value_factor": {"field": "price", "modifier": "sqrt"}, "boost_mode": "replace"}}`, []string{"b", "c", "a"}},
+		{`{"function_score": {"functions": [{"gauss": {"price": {"origin": 20, "scale": 10}}}]}}`, []string{"c", "a", "b"}},
+		{`{"function_score": {"functions": [{"exp": {"date": {"origin": "2018-01-11", "scale": "2d", "offset": "1d"}}}]}}`, []string{"b", "c", "a"}},
+		{`{"function_score": {"functions": [{"linear": {"location": {"origin": "40.85, 116.40", "scale": "200km"}}}]}}`, []string{"c", "b", "a"}},
+		{`{"function_score": {"functions": [{"filter": {"match": {"title": "tree"}}, "weight": 3}, {"filter": {"match": {"title": "pie"}}, "weight": 2}], "score_mode": "sum"}}`, []string{"c", "b", "a"}},
+		{`{"function_score": {"query": {"match": {"title": "apple"}}, "field_value_factor": {"field": "price"}, "boost_mode": "replace", "min_score": 15}}`, []string{"b", "c"}},
+		{`{"boosting": {"positive": {"match": {"title": "apple pie tree"}}, "negative": {"match": {"title": "pie"}}, "negative_boost": 0.1}}`, []string{"c", "a", "b"}},
+	}
+	for _, group := range groups {
+		req := engine.NewSearchQuery("db", "space")
+		req.SetQuery([]byte(group.query))
+		req.SetSort([]byte(`["_score", "_id"]`))
+		res, err := b.Search(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, hit := range res.Hits.Hits {
+			ids = append(ids, hit.Id)
+		}
+		if !reflect.DeepEqual(ids, group.ids) {
+			t.Fatalf("query %s, expect %v, got %v", group.query, group.ids, ids)
+		}
+	}
+
+	random := `{"function_score": {"random_score": {"seed": 10}, "boost_mode": "replace"}}`
+	req := engine.NewSearchQuery("db", "space")
+	req.SetQuery([]byte(random))
+	res, err := b.Search(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scores := make(map[string]float64)
+	for _, hit := range res.Hits.Hits {
+		if hit.Score < 0 || hit.Score >= 1 {
+			t.Fatalf("invalid random score %v", hit.Score)
+		}
+		scores[hit.Id] = hit.Score
+	}
+	if res, err = b.Search(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	for _, hit := range res.Hits.Hits {
+		if math.Abs(scores[hit.Id]-hit.Score) > 1e-9 {
+			t.Fatalf("random score of %s is not reproducible", hit.Id)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package bleve
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestGeoQueryAndSort(t *testing.T) {
+	b := memBleve(t, map[string]interface{}{
+		// distances from (lon 116.40, lat 39.90) are about 0, 11km, 106km and 1000km
+		"center": map[string]interface{}{"location": map[string]interface{}{"lon": 116.40, "lat": 39.90}},
+		"near":   map[string]interface{}{"location": map[string]interface{}{"lon": 116.40, "lat": 40.00}},
+		"far":    map[string]interface{}{"location": []interface{}{116.40, 40.85}},
+		"remote": map[string]interface{}{"location": map[string]interface{}{"lon": 121.47, "lat": 31.23}},
+		"none":   map[string]interface{}{"price": 1.0},
+	})
+	defer b.Close()
+
+	groups := []struct {
+		query string
+		ids   []string
+	}{
+		{`{"geo_distance": {"distance": "20km", "location": {"lat": 39.90, "lon": 116.40}}}`, []string{"center", "near"}},
+		{`{"geo_distance": {"distance": "200000m", "location": "39.90, 116.40"}}`, []string{"center", "far", "near"}},
+		{`{"geo_bounding_box": {"location": {"top_left": [116.0, 41.0], "bottom_right": [117.0, 39.95]}}}`, []string{"far", "near"}},
+		{`{"geo_polygon": {"location": {"points": [[116.0, 39.5], [117.0, 39.5], [116.5, 40.5]]}}}`, []string{"center", "near"}},
+	}
+	for _, group := range groups {
+		req := engine.NewSearchQuery("db", "space")
+		req.SetQuery([]byte(group.query))
+		req.SetSort([]byte(`"_id"`))
+		res, err := b.Search(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, hit := range res.Hits.Hits {
+			ids = append(ids, hit.Id)
+		}
+		if !reflect.DeepEqual(ids, group.ids) {
+			t.Fatalf("query %s, expect %v, got %v", group.query, group.ids, ids)
+		}
+	}
+
+	sort := `[{"_geo_distance": {"location": {"lat": 39.90, "lon": 116.40}, "order": "asc", "unit": "km"}}]`
+	ids, hits := searchIDs(t, b, sort, "", 3)
+	if !reflect.DeepEqual(ids, []string{"center", "near", "far"}) {
+		t.Fatalf("invalid geo distance sort: %v", ids)
+	}
+	if d, ok := hits[1].Sort[0].(float64); !ok || d < 10 || d > 12 {
+		t.Fatalf("invalid distance of near: %v", hits[1].Sort)
+	}
+	ids, _ = searchIDs(t, b, sort, `[110]`, 10)
+	if !reflect.DeepEqual(ids, []string{"remote", "none"}) {
+		t.Fatalf("invalid search after geo distance: %v", ids)
+	}
+}
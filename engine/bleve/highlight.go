@@ -0,0 +1,37 @@
+package bleve
+
+import (
+	"math"
+
+	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/highlight/format/html"
+	"github.com/blevesearch/bleve/search/highlight/fragmenter/simple"
+	simpleHighlighter "github.com/blevesearch/bleve/search/highlight/highlighter/simple"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+// highlight builds the fragments of the hit from the term locations of the matches,
+// the highlighted fields must be stored with term vectors.
+func highlight(hit *engine.HitDoc, dm *search.DocumentMatch, doc *document.Document, h *engine.Highlight) {
+	for pattern := range h.Fields {
+		for field := range dm.Locations {
+			if !engine.WildcardMatch(pattern, field) {
+				continue
+			}
+			size, num, pre, post := h.FieldOptions(pattern)
+			if num == 0 {
+				size, num = math.MaxInt32, 1
+			}
+			highlighter := simpleHighlighter.NewHighlighter(simple.NewFragmenter(size), html.NewFragmentFormatter(pre, post), "")
+			fragments := highlighter.BestFragmentsInField(dm, doc, field, num)
+			if len(fragments) == 0 {
+				continue
+			}
+			if hit.Highlight == nil {
+				hit.Highlight = make(map[string][]string)
+			}
+			hit.Highlight[field] = fragments
+		}
+	}
+}
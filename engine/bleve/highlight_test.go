@@ -0,0 +1,58 @@
+package bleve
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestHighlight(t *testing.T) {
+	dm := mapping.NewDocumentStaticMapping()
+	dm.AddFieldMappingsAt("title", mapping.NewTextFieldMapping())
+	dm.AddFieldMappingsAt("body", mapping.NewTextFieldMapping())
+	im := bleve.NewIndexMapping()
+	im.DefaultMapping = dm
+	index, err := bleve.NewMemOnly(im)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &Bleve{mapping: im, index: index}
+	defer b.Close()
+
+	doc := `{"title": "baud engine", "body": "a distributed search engine. the engine is built on bleve."}`
+	if err = b.AddDocument(context.Background(), engine.DOC_ID("1"), []byte(doc)); err != nil {
+		t.Fatal(err)
+	}
+
+	req := engine.NewSearchQuery("db", "space")
+	err = json.Unmarshal([]byte(`{
+        "query": {"multi_match": {"query": "engine", "fields": ["title", "body"]}},
+        "highlight": {
+            "pre_tags": ["<b>"],
+            "post_tags": ["</b>"],
+            "fields": {"title": {"number_of_fragments": 0}, "bo*": {"fragment_size": 30, "number_of_fragments": 1}}
+        }
+    }`), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := b.Search(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Hits.Hits) != 1 {
+		t.Fatalf("invalid hits %v", res.Hits)
+	}
+	highlight := res.Hits.Hits[0].Highlight
+	if !reflect.DeepEqual(highlight["title"], []string{"baud <b>engine</b>"}) {
+		t.Fatalf("invalid title highlight %v", highlight["title"])
+	}
+	if len(highlight["body"]) != 1 || len(highlight["body"][0]) > 60 {
+		t.Fatalf("invalid body highlight %v", highlight["body"])
+	}
+}
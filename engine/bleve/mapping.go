@@ -1,38 +1,121 @@
 package bleve
 
 import (
-	"errors"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/document"
 	"github.com/blevesearch/bleve/mapping"
+	"github.com/tiglabs/baudengine/engine/bleve/aggregation"
+)
+
+// TypeField is the document field holding the name of its type in the mappings.
+const TypeField = "_type"
+
+// DefaultType is the type of the documents without _type when the schema has several types.
+const DefaultType = "_default_"
+
+// the values of the dynamic setting of objects
+const (
+	DynamicTrue   = "true"
+	DynamicFalse  = "false"
+	DynamicStrict = "strict"
 )
 
+// StrictMappingError is returned when a document has a field not in the mapping of a strict object.
+type StrictMappingError struct {
+	Field  string
+	Object string
+}
+
+func (e *StrictMappingError) Error() string {
+	return fmt.Sprintf("mapping set to strict, dynamic introduction of [%s] within [%s] is not allowed", e.Field, e.Object)
+}
+
 type DocumentMapping struct {
 	*mapping.DocumentMapping
+	// DynamicMode is how the fields not in the mapping are handled, true indexes them,
+	// false keeps them only in _source and strict rejects the document.
+	DynamicMode string
+	// Objects are the mappings of the object properties.
+	Objects map[string]*DocumentMapping
+	// DynamicTemplates map the new fields of the type, the first matching template is used.
+	DynamicTemplates []*DynamicTemplate
 }
 
 func NewDocumentMapping() *DocumentMapping {
-	return &DocumentMapping{DocumentMapping: mapping.NewDocumentStaticMapping()}
+	return newDocumentMapping(DynamicTrue)
+}
+
+func newDocumentMapping(dynamic string) *DocumentMapping {
+	dm := &DocumentMapping{DocumentMapping: mapping.NewDocumentMapping(), Objects: make(map[string]*DocumentMapping)}
+	dm.setDynamic(dynamic)
+	return dm
+}
+
+func (d *DocumentMapping) setDynamic(dynamic string) {
+	d.DynamicMode = dynamic
+	d.Dynamic = dynamic == DynamicTrue
 }
 
 type FieldMapping struct {
-	Name     string
+	Name string
 	*mapping.FieldMapping
+	// Fields are the multi-fields indexing the same value in other ways, named name.sub.
+	Fields []*FieldMapping
 }
 
 func NewFieldMapping(name string) *FieldMapping {
 	return &FieldMapping{Name: name}
 }
 
-func (f *FieldMapping)UnmarshalJSON(data []byte) error {
+// mappings returns the field mapping followed by its multi-fields.
+func (f *FieldMapping) mappings() []*mapping.FieldMapping {
+	fms := []*mapping.FieldMapping{f.FieldMapping}
+	for _, sub := range f.Fields {
+		fms = append(fms, sub.mappings()...)
+	}
+	return fms
+}
+
+// builtinDateFormats are the ES date formats the default date parser of bleve handles.
+var builtinDateFormats = map[string]bool{
+	"date":                      true,
+	"date_time":                 true,
+	"date_optional_time":        true,
+	"strict_date":               true,
+	"strict_date_time":          true,
+	"strict_date_optional_time": true,
+	"epoch_millis":              true,
+	"epoch_second":              true,
+}
+
+func isBuiltinDateFormat(format string) bool {
+	for _, f := range strings.Split(format, "||") {
+		if !builtinDateFormats[strings.TrimSpace(f)] {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *FieldMapping) UnmarshalJSON(data []byte) error {
 	tmp := struct {
-		Type     string       `json:"type"`
-		Analyzer *string      `json:"analyzer,omitempty"`
-		DocValues *bool       `json:"doc_values,omitempty"`
-		Index     *string     `json:"index,omitempty"`
-		Store     *bool       `json:"store,omitempty"`
-		TermVector *string    `json:"term_vector,omitempty"`
-		IncludeInAll *bool    `json:"include_in_all,omitempty"`
-		Format   *string      `json:"format,omitempty"`
+		Type         string                     `json:"type"`
+		Analyzer     *string                    `json:"analyzer,omitempty"`
+		DocValues    *bool                      `json:"doc_values,omitempty"`
+		Index        interface{}                `json:"index,omitempty"`
+		Store        *bool                      `json:"store,omitempty"`
+		TermVector   *string                    `json:"term_vector,omitempty"`
+		IncludeInAll *bool                      `json:"include_in_all,omitempty"`
+		Format       *string                    `json:"format,omitempty"`
+		Fields       map[string]json.RawMessage `json:"fields,omitempty"`
 	}{}
 	err := json.Unmarshal(data, &tmp)
 	if err != nil {
@@ -40,18 +123,26 @@ func (f *FieldMapping)UnmarshalJSON(data []byte) error {
 	}
 	var fieldMapping *mapping.FieldMapping
 	switch tmp.Type {
-	case "text", "string", "keyword":
+	case "text", "string":
 		fieldMapping = mapping.NewTextFieldMapping()
+	case "keyword", "ip":
+		fieldMapping = mapping.NewTextFieldMapping()
+		fieldMapping.Analyzer = keyword.Name
 	case "date":
 		fieldMapping = mapping.NewDateTimeFieldMapping()
-	case "long", "integer", "short", "byte", "double", "float":
+	case "long", "integer", "short", "byte", "double", "float", "half_float", "scaled_float":
 		fieldMapping = mapping.NewNumericFieldMapping()
 	case "boolean":
 		fieldMapping = mapping.NewBooleanFieldMapping()
 	case "geo_point":
 		fieldMapping = mapping.NewGeoPointFieldMapping()
+	case "binary":
+		// binary values are only kept in _source
+		fieldMapping = mapping.NewTextFieldMapping()
+		fieldMapping.Index = false
+		fieldMapping.Store = false
 	default:
-		return errors.New("invalid field type")
+		return fmt.Errorf("invalid field type %s", tmp.Type)
 	}
 	if tmp.Store != nil {
 		fieldMapping.Store = *tmp.Store
@@ -60,19 +151,24 @@ func (f *FieldMapping)UnmarshalJSON(data []byte) error {
 		fieldMapping.Analyzer = *tmp.Analyzer
 	}
 	if tmp.Index != nil {
-		switch *tmp.Index {
-		case "analyzed":
+		switch tmp.Index {
+		case true, "true", "analyzed":
 			fieldMapping.Index = true
-		case "not_analyzed":
+		case false, "false", "no":
 			fieldMapping.Index = false
+		case "not_analyzed":
+			fieldMapping.Index = true
+			if tmp.Analyzer == nil {
+				fieldMapping.Analyzer = keyword.Name
+			}
 		default:
-			return errors.New("invalid index")
+			return fmt.Errorf("invalid index %v", tmp.Index)
 		}
 	}
 	if tmp.DocValues != nil {
 		fieldMapping.DocValues = *tmp.DocValues
 	}
-	if tmp.Format != nil {
+	if tmp.Format != nil && !isBuiltinDateFormat(*tmp.Format) {
 		fieldMapping.DateFormat = *tmp.Format
 	}
 	if tmp.IncludeInAll != nil {
@@ -87,57 +183,587 @@ func (f *FieldMapping)UnmarshalJSON(data []byte) error {
 	}
 	fieldMapping.Name = f.Name
 	f.FieldMapping = fieldMapping
+
+	names := make([]string, 0, len(tmp.Fields))
+	for name := range tmp.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sub := NewFieldMapping(f.Name + "." + name)
+		if err = json.Unmarshal(tmp.Fields[name], sub); err != nil {
+			return fmt.Errorf("invalid mapping of field %s: %v", sub.Name, err)
+		}
+		f.Fields = append(f.Fields, sub)
+	}
 	return nil
 }
 
 type All struct {
-	Enabled   bool     `json:"enabled"`
+	Enabled bool `json:"enabled"`
+}
+
+// parseDynamic parses the dynamic setting, which is a bool or one of "true", "false" and "strict".
+func parseDynamic(v interface{}) (string, error) {
+	switch v {
+	case true, DynamicTrue:
+		return DynamicTrue, nil
+	case false, DynamicFalse:
+		return DynamicFalse, nil
+	case DynamicStrict:
+		return DynamicStrict, nil
+	}
+	return "", fmt.Errorf("invalid dynamic %v", v)
 }
 
-func (d *DocumentMapping)UnmarshalJSON(schema []byte) error {
+// isObjectMapping reports whether the property is an object with its own properties rather than a field.
+func isObjectMapping(data []byte) bool {
 	tmp := struct {
-		All       *All                         `json:"_all,omitempty"`
-		Properties map[string]json.RawMessage `json:"properties"`
+		Type       string          `json:"type"`
+		Properties json.RawMessage `json:"properties"`
+	}{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return false
+	}
+	return tmp.Type == "object" || tmp.Type == "nested" || (tmp.Type == "" && len(tmp.Properties) > 0)
+}
+
+func (d *DocumentMapping) UnmarshalJSON(schema []byte) error {
+	return d.parse(schema, DynamicTrue)
+}
+
+// parse parses the mapping of a type or an object, the dynamic setting is inherited from the parent object.
+func (d *DocumentMapping) parse(schema []byte, dynamic string) error {
+	tmp := struct {
+		All              *All                         `json:"_all,omitempty"`
+		Dynamic          interface{}                  `json:"dynamic,omitempty"`
+		Enabled          *bool                        `json:"enabled,omitempty"`
+		Properties       map[string]json.RawMessage   `json:"properties"`
+		DynamicTemplates []map[string]json.RawMessage `json:"dynamic_templates,omitempty"`
 	}{}
 	err := json.Unmarshal(schema, &tmp)
-    if err != nil {
-    	return err
-    }
-    for name, data := range tmp.Properties {
-    	f := NewFieldMapping(name)
-    	err = json.Unmarshal(data, f)
-    	if err != nil {
-    		// try property
-    		doc := NewDocumentMapping()
-    		err = json.Unmarshal(data, doc)
-    		if err != nil {
-    			return err
-		    }
-    		d.AddSubDocumentMapping(name, doc.DocumentMapping)
-	    }
-	    d.AddFieldMapping(f.FieldMapping)
-    }
-    return nil
+	if err != nil {
+		return err
+	}
+	if tmp.Dynamic != nil {
+		if dynamic, err = parseDynamic(tmp.Dynamic); err != nil {
+			return err
+		}
+	}
+	d.DocumentMapping = mapping.NewDocumentMapping()
+	d.Objects = make(map[string]*DocumentMapping)
+	d.setDynamic(dynamic)
+	if tmp.Enabled != nil {
+		d.Enabled = *tmp.Enabled
+	}
+	for name, data := range tmp.Properties {
+		if isObjectMapping(data) {
+			sub := &DocumentMapping{}
+			if err = sub.parse(data, d.DynamicMode); err != nil {
+				return fmt.Errorf("invalid mapping of object %s: %v", name, err)
+			}
+			d.AddSubDocumentMapping(name, sub.DocumentMapping)
+			d.Objects[name] = sub
+			continue
+		}
+		f := NewFieldMapping(name)
+		if err = json.Unmarshal(data, f); err != nil {
+			return fmt.Errorf("invalid mapping of field %s: %v", name, err)
+		}
+		d.AddFieldMappingsAt(name, f.mappings()...)
+	}
+	for _, templates := range tmp.DynamicTemplates {
+		for name, data := range templates {
+			t := &DynamicTemplate{Name: name}
+			if err = json.Unmarshal(data, t); err != nil {
+				return fmt.Errorf("invalid dynamic template %s: %v", name, err)
+			}
+			d.DynamicTemplates = append(d.DynamicTemplates, t)
+		}
+	}
+	return nil
+}
+
+/*
+DynamicTemplate maps the new fields matching it, such as
+
+	{
+	    "match_mapping_type": "string",
+	    "match": "*_id",
+	    "unmatch": "tmp_*",
+	    "mapping": { "type": "keyword" }
+	}
+
+match and unmatch are matched against the field name, path_match and path_unmatch against the full path,
+they support * wildcards or regular expressions if match_pattern is regex.
+{name} and {dynamic_type} in the mapping are replaced by the field name and the detected type.
+*/
+type DynamicTemplate struct {
+	Name             string
+	MatchMappingType string
+	Mapping          json.RawMessage
+
+	match       *regexp.Regexp
+	unmatch     *regexp.Regexp
+	pathMatch   *regexp.Regexp
+	pathUnmatch *regexp.Regexp
 }
 
-func ParseSchema(schema []byte) ([]*mapping.DocumentMapping, error) {
+func (t *DynamicTemplate) UnmarshalJSON(data []byte) error {
 	tmp := struct {
-		Mapping   map[string]json.RawMessage  `json:"mappings"`
+		Match            string          `json:"match,omitempty"`
+		Unmatch          string          `json:"unmatch,omitempty"`
+		PathMatch        string          `json:"path_match,omitempty"`
+		PathUnmatch      string          `json:"path_unmatch,omitempty"`
+		MatchPattern     string          `json:"match_pattern,omitempty"`
+		MatchMappingType string          `json:"match_mapping_type,omitempty"`
+		Mapping          json.RawMessage `json:"mapping"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if len(tmp.Mapping) == 0 {
+		return errors.New("dynamic template requires a mapping")
+	}
+	switch tmp.MatchMappingType {
+	case "", "*", "boolean", "long", "double", "string", "date", "object":
+	default:
+		return fmt.Errorf("invalid match_mapping_type %s", tmp.MatchMappingType)
+	}
+	regex := false
+	switch tmp.MatchPattern {
+	case "", "simple":
+	case "regex":
+		regex = true
+	default:
+		return fmt.Errorf("invalid match_pattern %s", tmp.MatchPattern)
+	}
+	for _, p := range []struct {
+		pattern string
+		re      **regexp.Regexp
+	}{{tmp.Match, &t.match}, {tmp.Unmatch, &t.unmatch}, {tmp.PathMatch, &t.pathMatch}, {tmp.PathUnmatch, &t.pathUnmatch}} {
+		if p.pattern == "" {
+			continue
+		}
+		if *p.re, err = compilePattern(p.pattern, regex); err != nil {
+			return err
+		}
+	}
+	t.MatchMappingType = tmp.MatchMappingType
+	t.Mapping = tmp.Mapping
+	// check the mapping is valid before any document uses it
+	_, err = t.fieldMappings("field", "string")
+	return err
+}
+
+// compilePattern compiles a regular expression, or a simple pattern where * matches any characters.
+func compilePattern(pattern string, regex bool) (*regexp.Regexp, error) {
+	if !regex {
+		pattern = "^" + strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1) + "$"
+	}
+	return regexp.Compile(pattern)
+}
+
+// matches reports whether the template maps the field at path, whose value has the detected type.
+func (t *DynamicTemplate) matches(path, name, mappingType string) bool {
+	if t.MatchMappingType != "" && t.MatchMappingType != "*" && t.MatchMappingType != mappingType {
+		return false
+	}
+	if t.match != nil && !t.match.MatchString(name) {
+		return false
+	}
+	if t.unmatch != nil && t.unmatch.MatchString(name) {
+		return false
+	}
+	if t.pathMatch != nil && !t.pathMatch.MatchString(path) {
+		return false
+	}
+	if t.pathUnmatch != nil && t.pathUnmatch.MatchString(path) {
+		return false
+	}
+	return true
+}
+
+// fieldMappings returns the mappings of the field created by the template.
+func (t *DynamicTemplate) fieldMappings(name, mappingType string) ([]*mapping.FieldMapping, error) {
+	data := strings.Replace(string(t.Mapping), "{name}", name, -1)
+	data = strings.Replace(data, "{dynamic_type}", mappingType, -1)
+	tmp := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(data), &tmp); err != nil {
+		return nil, err
+	}
+	if _, ok := tmp["type"]; !ok {
+		tmp["type"] = mappingType
+		if mappingType == "string" {
+			tmp["type"] = "text"
+		}
+		raw, err := json.Marshal(tmp)
+		if err != nil {
+			return nil, err
+		}
+		data = string(raw)
+	}
+	f := NewFieldMapping(name)
+	if err := json.Unmarshal([]byte(data), f); err != nil {
+		return nil, err
+	}
+	return f.mappings(), nil
+}
+
+// detectType returns the dynamic type of a json value, it is empty for null values.
+func detectType(v interface{}) string {
+	switch v := v.(type) {
+	case bool:
+		return "boolean"
+	case float64:
+		if v == float64(int64(v)) {
+			return "long"
+		}
+		return "double"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "long"
+		}
+		return "double"
+	case string:
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+			if _, err := time.Parse(layout, v); err == nil {
+				return "date"
+			}
+		}
+		return "string"
+	case map[string]interface{}:
+		return "object"
+	}
+	return ""
+}
+
+// IndexMapping registers the mapping of every type in the schema, documents select their type by _type.
+// The fields added by the dynamic templates are kept apart, so that the mapping can be rebuilt with them.
+type IndexMapping struct {
+	*mapping.IndexMappingImpl
+	schema  []byte
+	version uint64
+	types   map[string]*DocumentMapping
+	// dynamicFields are the field mappings created by the dynamic templates, by type and path.
+	dynamicFields map[string]map[string][]*mapping.FieldMapping
+}
+
+/*
+ParseSchema parses the mappings of the schema, such as
+
+	{
+	    "mappings": {
+	        "user": {
+	            "dynamic": "strict",
+	            "properties": {
+	                "name": { "type": "text" },
+	                "address": { "properties": { "city": { "type": "keyword" } } }
+	            }
+	        },
+	        "blogpost": {
+	            "dynamic_templates": [
+	                { "ids": { "match": "*_id", "mapping": { "type": "keyword" } } }
+	            ],
+	            "properties": { "title": { "type": "text" } }
+	        }
+	    }
+	}
+
+The documents without _type use the _default_ type, or the only type if there is one.
+The analyzers declared in settings.analysis are only registered in this mapping, see parseAnalysis.
+*/
+func ParseSchema(schema []byte) (*IndexMapping, error) {
+	tmp := struct {
+		Settings json.RawMessage            `json:"settings,omitempty"`
+		Mapping  map[string]json.RawMessage `json:"mappings"`
 	}{Mapping: make(map[string]json.RawMessage)}
 
 	err := json.Unmarshal(schema, &tmp)
 	if err != nil {
 		return nil, err
 	}
+	if len(tmp.Mapping) == 0 {
+		return nil, errors.New("invalid schema: no mappings")
+	}
 
-	var dms []*mapping.DocumentMapping
-	for _, data := range tmp.Mapping {
-		dm := NewDocumentMapping()
-		err = json.Unmarshal(data, dm)
-		if err != nil {
+	m := &IndexMapping{
+		IndexMappingImpl: mapping.NewIndexMapping(),
+		schema:           schema,
+		types:            make(map[string]*DocumentMapping),
+		dynamicFields:    make(map[string]map[string][]*mapping.FieldMapping),
+	}
+	m.TypeField = TypeField
+	if len(tmp.Settings) > 0 {
+		if err = parseAnalysis(m.IndexMappingImpl, tmp.Settings); err != nil {
 			return nil, err
 		}
-		dms = append(dms, dm.DocumentMapping)
 	}
-	return dms, nil
+	for name, data := range tmp.Mapping {
+		dm := &DocumentMapping{}
+		if err = json.Unmarshal(data, dm); err != nil {
+			return nil, fmt.Errorf("invalid mapping of type %s: %v", name, err)
+		}
+		m.types[name] = dm
+		m.AddDocumentMapping(name, dm.DocumentMapping)
+	}
+	if _, ok := m.types[DefaultType]; !ok && len(m.types) == 1 {
+		for name := range m.types {
+			m.DefaultType = name
+		}
+	} else {
+		m.DefaultType = DefaultType
+		if _, ok := m.types[DefaultType]; !ok {
+			m.types[DefaultType] = NewDocumentMapping()
+		}
+	}
+	m.DefaultMapping = m.types[m.DefaultType].DocumentMapping
+	return m, nil
+}
+
+// documentType returns the type of the document and its mapping.
+func (m *IndexMapping) documentType(data interface{}) (string, *DocumentMapping) {
+	if doc, ok := data.(map[string]interface{}); ok {
+		if name, ok := doc[TypeField].(string); ok {
+			if dm, ok := m.types[name]; ok {
+				return name, dm
+			}
+		}
+	}
+	return m.DefaultType, m.types[m.DefaultType]
+}
+
+// MapDocument maps the document by the mapping of its type, it fails if a strict object has fields not in the mapping.
+func (m *IndexMapping) MapDocument(doc *document.Document, data interface{}) error {
+	_, dm := m.documentType(data)
+	if err := checkStrict(dm, "", data); err != nil {
+		return err
+	}
+	return m.IndexMappingImpl.MapDocument(doc, data)
+}
+
+func checkStrict(dm *DocumentMapping, path string, data interface{}) error {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for name, value := range v {
+			if path == "" && name == TypeField {
+				continue
+			}
+			if sub, ok := dm.Objects[name]; ok {
+				if err := checkStrict(sub, path+name+".", value); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, ok := dm.Properties[name]; ok {
+				continue
+			}
+			if dm.DynamicMode == DynamicStrict {
+				object := strings.TrimSuffix(path, ".")
+				if object == "" {
+					object = "_doc"
+				}
+				return &StrictMappingError{Field: name, Object: object}
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := checkStrict(dm, path, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// templateFields returns the type of the document and the field mappings the dynamic templates create for its new fields.
+func (m *IndexMapping) templateFields(data interface{}) (string, map[string][]*mapping.FieldMapping, error) {
+	name, dm := m.documentType(data)
+	if len(dm.DynamicTemplates) == 0 {
+		return name, nil, nil
+	}
+	fields := make(map[string][]*mapping.FieldMapping)
+	if err := collectTemplateFields(dm, dm.DynamicTemplates, "", data, fields); err != nil {
+		return name, nil, err
+	}
+	return name, fields, nil
+}
+
+func collectTemplateFields(dm *DocumentMapping, templates []*DynamicTemplate, path string, data interface{}, fields map[string][]*mapping.FieldMapping) error {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for name, value := range v {
+			if path == "" && name == TypeField {
+				continue
+			}
+			if sub, ok := dm.Objects[name]; ok {
+				if sub.Enabled {
+					if err := collectTemplateFields(sub, templates, path+name+".", value, fields); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if _, ok := dm.Properties[name]; ok || dm.DynamicMode != DynamicTrue {
+				continue
+			}
+			if err := collectNewFields(templates, path+name, name, value, fields); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := collectTemplateFields(dm, templates, path, value, fields); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collectNewFields finds the templates of the fields in a value not in the mapping.
+func collectNewFields(templates []*DynamicTemplate, path, name string, value interface{}, fields map[string][]*mapping.FieldMapping) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for child, value := range v {
+			if err := collectNewFields(templates, path+"."+child, child, value, fields); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		for _, value := range v {
+			if err := collectNewFields(templates, path, name, value, fields); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	mappingType := detectType(value)
+	if _, ok := fields[path]; ok || mappingType == "" {
+		return nil
+	}
+	for _, t := range templates {
+		if !t.matches(path, name, mappingType) {
+			continue
+		}
+		fms, err := t.fieldMappings(name, mappingType)
+		if err != nil {
+			return fmt.Errorf("dynamic template %s: %v", t.Name, err)
+		}
+		fields[path] = fms
+		return nil
+	}
+	return nil
+}
+
+// newIndexMapping parses the schema of the version and adds the dynamic fields kept by the mappings of former schemas.
+func newIndexMapping(schema []byte, version uint64, dynamicFields ...map[string]map[string][]*mapping.FieldMapping) (*IndexMapping, error) {
+	m, err := ParseSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	m.version = version
+	for _, fields := range dynamicFields {
+		for typeName, typeFields := range fields {
+			for path, fms := range typeFields {
+				m.addDynamicField(typeName, path, fms)
+			}
+		}
+	}
+	return m, nil
+}
+
+// withDynamicFields returns a copy of the mapping with the fields added, the mapping itself is not changed.
+func (m *IndexMapping) withDynamicFields(fields map[string]map[string][]*mapping.FieldMapping) (*IndexMapping, error) {
+	return newIndexMapping(m.schema, m.version, m.dynamicFields, fields)
+}
+
+func (m *IndexMapping) addDynamicField(typeName, path string, fms []*mapping.FieldMapping) {
+	dm, ok := m.types[typeName]
+	if !ok {
+		return
+	}
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		sub, ok := dm.Objects[name]
+		if !ok {
+			sub = newDocumentMapping(DynamicTrue)
+			dm.AddSubDocumentMapping(name, sub.DocumentMapping)
+			dm.Objects[name] = sub
+		}
+		dm = sub
+	}
+	if _, ok := dm.Properties[names[len(names)-1]]; ok {
+		// the field is mapped by the schema now
+		return
+	}
+	dm.AddFieldMappingsAt(names[len(names)-1], fms...)
+	if m.dynamicFields[typeName] == nil {
+		m.dynamicFields[typeName] = make(map[string][]*mapping.FieldMapping)
+	}
+	m.dynamicFields[typeName][path] = fms
+}
+
+// indexMappingImpl returns the bleve mapping of m.
+func indexMappingImpl(m mapping.IndexMapping) (*mapping.IndexMappingImpl, bool) {
+	switch m := m.(type) {
+	case *IndexMapping:
+		return m.IndexMappingImpl, true
+	case *mapping.IndexMappingImpl:
+		return m, true
+	}
+	return nil, false
+}
+
+// fieldTypeFunc looks up the mapped type of fields in the document mapping of docType.
+// Without a docType, or with a docType not in the mappings, the fields are looked up in the default document mapping,
+// then in the mappings of the types.
+func fieldTypeFunc(m mapping.IndexMapping, docType string) aggregation.FieldTypeFunc {
+	impl, ok := indexMappingImpl(m)
+	return func(field string) string {
+		if !ok {
+			return ""
+		}
+		if dm, found := impl.TypeMapping[docType]; found {
+			return documentFieldType(dm, field)
+		}
+		if t := documentFieldType(impl.DefaultMapping, field); t != "" {
+			return t
+		}
+		names := make([]string, 0, len(impl.TypeMapping))
+		for name := range impl.TypeMapping {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if t := documentFieldType(impl.TypeMapping[name], field); t != "" {
+				return t
+			}
+		}
+		return ""
+	}
+}
+
+func documentFieldType(dm *mapping.DocumentMapping, field string) string {
+	if dm == nil {
+		return ""
+	}
+	path := strings.Split(field, ".")
+	for _, name := range path[:len(path)-1] {
+		if dm = dm.Properties[name]; dm == nil {
+			return ""
+		}
+	}
+	name := path[len(path)-1]
+	if sub, ok := dm.Properties[name]; ok && len(sub.Fields) > 0 {
+		return sub.Fields[0].Type
+	}
+	for _, f := range dm.Fields {
+		if f.Name == name {
+			return f.Type
+		}
+	}
+	return ""
 }
@@ -1,55 +1,237 @@
 package bleve
 
 import (
+	"context"
 	"testing"
-	"fmt"
+
+	"github.com/tiglabs/baudengine/engine"
 )
 
 func TestMapping(t *testing.T) {
 	schema := `{
   "mappings": {
-    "user": { 
-      "_all":       { "enabled": false  }, 
-      "properties": { 
-        "title":    { "type": "string"  }, 
-        "name":     { "type": "string"  }, 
-        "age":      { "type": "integer" }  
+    "user": {
+      "_all":       { "enabled": false  },
+      "dynamic":    "strict",
+      "properties": {
+        "title":    { "type": "string"  },
+        "name":     { "type": "string", "fields": { "raw": { "type": "keyword" } } },
+        "age":      { "type": "integer" }
       }
     },
-    "blogpost": { 
-      "properties": { 
-        "title":    { "type": "string"  }, 
-        "body":     { "type": "string"  }, 
+    "blogpost": {
+      "properties": {
+        "title":    { "type": "string"  },
+        "body":     { "type": "string"  },
         "user_id":  {
-          "type":   "string", 
+          "type":   "string",
           "index":  "not_analyzed"
         },
         "created":  {
-          "type":   "date", 
+          "type":   "date",
           "format": "strict_date_optional_time||epoch_millis"
         },
        "baud": {
-      "properties": { 
-        "title":    { "type": "string"  }, 
-        "name":     { "type": "string"  }, 
-        "age":      { "type": "integer" }  
+      "properties": {
+        "title":    { "type": "string"  },
+        "name":     { "type": "string"  },
+        "age":      { "type": "integer" }
       }
     }
       }
     }
   }
 }`
-    dms, err := ParseSchema([]byte(schema))
-    if err != nil {
-    	t.Fatal(err)
-    }
-    for _, dm := range dms {
-    	fmt.Println(dm.Dynamic, dm.Enabled, dm.DefaultAnalyzer, dm.StructTagKey)
-    	for n, d := range dm.Properties {
-    		fmt.Println("doc ", n, d)
-	    }
-	    for _,f := range dm.Fields {
-	    	fmt.Println("field ", f)
-	    }
+	m, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.TypeMapping) != 2 || m.TypeField != TypeField || m.DefaultType != DefaultType {
+		t.Fatalf("invalid types %v, type field %s, default type %s", m.TypeMapping, m.TypeField, m.DefaultType)
+	}
+	if m.types["user"].DynamicMode != DynamicStrict || m.types["blogpost"].DynamicMode != DynamicTrue {
+		t.Fatal("invalid dynamic mode")
+	}
+	if dm := m.types["blogpost"].Objects["baud"]; dm == nil || dm.DynamicMode != DynamicTrue || len(dm.Properties) != 3 {
+		t.Fatal("invalid object mapping")
+	}
+	fields := m.TypeMapping["user"].Properties["name"].Fields
+	if len(fields) != 2 || fields[1].Name != "name.raw" || fields[1].Analyzer != "keyword" {
+		t.Fatalf("invalid multi-fields %v", fields)
+	}
+	userID := m.TypeMapping["blogpost"].Properties["user_id"].Fields[0]
+	if !userID.Index || userID.Analyzer != "keyword" {
+		t.Fatalf("invalid not_analyzed field %v", userID)
+	}
+	if created := m.TypeMapping["blogpost"].Properties["created"].Fields[0]; created.DateFormat != "" {
+		t.Fatalf("invalid date format %s", created.DateFormat)
+	}
+	if typ := fieldTypeFunc(m, "")("baud.age"); typ != "number" {
+		t.Fatalf("invalid type %s of baud.age", typ)
+	}
+	// the fields of a type are resolved only by its document mapping
+	for _, c := range []struct{ docType, field, typ string }{
+		{"user", "age", "number"},
+		{"blogpost", "age", ""},
+		{"blogpost", "created", "datetime"},
+		{"user", "created", ""},
+		{"", "created", "datetime"},
+	} {
+		if typ := fieldTypeFunc(m, c.docType)(c.field); typ != c.typ {
+			t.Fatalf("invalid type %s of %s in %q, expect %s", typ, c.field, c.docType, c.typ)
+		}
+	}
+
+	for _, invalid := range []string{
+		`{"mappings": {}}`,
+		`{"mappings": {"baud": {"dynamic": "yes"}}}`,
+		`{"mappings": {"baud": {"properties": {"name": {"type": "unknown"}}}}}`,
+		`{"mappings": {"baud": {"dynamic_templates": [{"ids": {"match": "*_id"}}]}}}`,
+		`{"mappings": {"baud": {"dynamic_templates": [{"ids": {"match": "(", "match_pattern": "regex", "mapping": {}}}]}}}`,
+	} {
+		if _, err := ParseSchema([]byte(invalid)); err == nil {
+			t.Fatalf("expect error of schema %s", invalid)
+		}
+	}
+}
+
+func TestDynamicMapping(t *testing.T) {
+	schema := `{
+  "mappings": {
+    "user": {
+      "dynamic": "strict",
+      "properties": {
+        "name":    { "type": "text" },
+        "extra":   { "dynamic": true, "properties": { "note": { "type": "text" } } }
+      }
+    },
+    "log": {
+      "dynamic": false,
+      "properties": { "message": { "type": "text" } }
+    },
+    "_default_": {
+      "dynamic_templates": [
+        { "ids":     { "match": "*_id", "match_mapping_type": "string", "mapping": { "type": "keyword" } } },
+        { "strings": { "match_mapping_type": "string", "mapping": { "type": "text", "fields": { "raw": { "type": "keyword" } } } } }
+      ]
     }
+  }
+}`
+	newMemory := func() engine.Engine {
+		e, err := New(engine.EngineConfig{Schema: schema, ExtraOptions: `{"store": "memory"}`})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	e := newMemory()
+	defer e.Close()
+	ctx := context.Background()
+	add := func(id string, doc map[string]interface{}) error {
+		return e.AddDocument(ctx, engine.DOC_ID(id), doc)
+	}
+	count := func(e engine.Engine, q string) uint64 {
+		result, err := e.Search(ctx, &engine.SearchRequest{Query: []byte(q), Size: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result.Hits.Total
+	}
+
+	err := add("u1", map[string]interface{}{"_type": "user", "name": "alice", "age": 30})
+	if _, ok := err.(*StrictMappingError); !ok || err.Error() != "mapping set to strict, dynamic introduction of [age] within [_doc] is not allowed" {
+		t.Fatalf("expect strict mapping error, got %v", err)
+	}
+	if err = add("u1", map[string]interface{}{"_type": "user", "name": "alice", "extra": map[string]interface{}{"note": "x", "color": "red"}}); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(e, `{"match": {"extra.color": "red"}}`); n != 1 {
+		t.Fatalf("dynamic field of user is not indexed, %d hits", n)
+	}
+
+	if err = add("l1", map[string]interface{}{"_type": "log", "message": "boot", "level": "error"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(e, `{"match": {"level": "error"}}`); n != 0 {
+		t.Fatalf("field of a static type is indexed, %d hits", n)
+	}
+	if n := count(e, `{"match": {"message": "boot"}}`); n != 1 {
+		t.Fatalf("mapped field of log is not indexed, %d hits", n)
+	}
+
+	if err = add("d1", map[string]interface{}{"order_id": "A-1 B", "title": "Hello World", "meta": map[string]interface{}{"owner_id": "X Y"}}); err != nil {
+		t.Fatal(err)
+	}
+	for q, expect := range map[string]uint64{
+		`{"term": {"order_id": "A-1 B"}}`:        1,
+		`{"term": {"meta.owner_id": "X Y"}}`:     1,
+		`{"match": {"title": "hello"}}`:          1,
+		`{"term": {"title.raw": "Hello World"}}`: 1,
+		`{"term": {"title": "Hello World"}}`:     0,
+	} {
+		if n := count(e, q); n != expect {
+			t.Fatalf("query %s has %d hits, expect %d", q, n, expect)
+		}
+	}
+
+	// the dynamic fields are mapped again after applying a snapshot
+	dst := newMemory()
+	defer dst.Close()
+	snap, err := e.NewSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	iter := snap.NewIterator()
+	err = dst.ApplySnapshot(ctx, iter)
+	iter.Close()
+	snap.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := dst.(*Bleve).Mapping().(*IndexMapping)
+	if _, ok := m.dynamicFields[DefaultType]["title"]; !ok {
+		t.Fatalf("dynamic field is not mapped after applying the snapshot: %v", m.dynamicFields)
+	}
+	if n := count(dst, `{"term": {"title.raw": "Hello World"}}`); n != 1 {
+		t.Fatalf("dynamic field is not searchable after applying the snapshot, %d hits", n)
+	}
+}
+
+func TestUpdateSchema(t *testing.T) {
+	schema := `{"mappings": {"user": {"dynamic": "strict", "properties": {"name": {"type": "text"}},
+		"dynamic_templates": [{"ids": {"match": "*_id", "mapping": {"type": "keyword"}}}]}}}`
+	e, err := New(engine.EngineConfig{Schema: schema, SchemaVersion: 1, ExtraOptions: `{"store": "memory"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	ctx := context.Background()
+	if err = e.AddDocument(ctx, engine.DOC_ID("1"), map[string]interface{}{"name": "alice", "age": 30}); err == nil {
+		t.Fatal("expect strict mapping error")
+	}
+
+	updated := `{"mappings": {"user": {"dynamic": "strict", "properties": {"name": {"type": "text"}, "age": {"type": "integer"}}}}}`
+	updater := e.(engine.SchemaUpdater)
+	if err = updater.UpdateSchema(updated, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err = updater.UpdateSchema(schema, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err = e.AddDocument(ctx, engine.DOC_ID("1"), map[string]interface{}{"name": "alice", "age": 30}); err != nil {
+		t.Fatal(err)
+	}
+	if m := e.(*Bleve).Mapping().(*IndexMapping); m.version != 2 {
+		t.Fatalf("invalid schema version %d", m.version)
+	}
+	result, err := e.Search(ctx, &engine.SearchRequest{Query: []byte(`{"range": {"age": {"gte": 30}}}`), Size: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Hits.Total != 1 {
+		t.Fatalf("new field is not indexed, %d hits", result.Hits.Total)
+	}
+	if err = updater.UpdateSchema(`{"mappings": {}}`, 3); err == nil {
+		t.Fatal("expect error of invalid schema")
+	}
 }
@@ -0,0 +1,102 @@
+package memdb
+
+import (
+	"bytes"
+
+	"github.com/blevesearch/bleve/index/store"
+	"github.com/steveyen/gtreap"
+)
+
+// iteratorBatchSize is the number of items an iterator loads from the treap at a time.
+const iteratorBatchSize = 64
+
+var _ store.KVIterator = &Iterator{}
+
+type Iterator struct {
+	t      *gtreap.Treap
+	prefix []byte
+	start  []byte
+	end    []byte
+	items  []*item
+	pos    int
+	valid  bool
+	key    []byte
+	val    []byte
+}
+
+// load loads the items following the pivot, the pivot itself is skipped unless inclusive is true.
+func (i *Iterator) load(pivot []byte, inclusive bool) {
+	i.items, i.pos = i.items[:0], 0
+	i.t.VisitAscend(&item{k: pivot}, func(itm gtreap.Item) bool {
+		it := itm.(*item)
+		if !inclusive && bytes.Equal(it.k, pivot) {
+			return true
+		}
+		i.items = append(i.items, it)
+		return len(i.items) < iteratorBatchSize
+	})
+}
+
+func (i *Iterator) update() {
+	if i.pos < len(i.items) {
+		i.key, i.val = i.items[i.pos].k, i.items[i.pos].v
+	} else {
+		i.key, i.val = nil, nil
+	}
+	i.valid = (i.key != nil)
+	if i.valid {
+		if i.prefix != nil {
+			i.valid = bytes.HasPrefix(i.key, i.prefix)
+		} else if i.end != nil {
+			i.valid = bytes.Compare(i.key, i.end) < 0
+		}
+	}
+}
+
+func (i *Iterator) Seek(k []byte) {
+	if i.start != nil && bytes.Compare(k, i.start) < 0 {
+		k = i.start
+	}
+	if i.prefix != nil && !bytes.HasPrefix(k, i.prefix) {
+		if bytes.Compare(k, i.prefix) < 0 {
+			k = i.prefix
+		} else {
+			i.items, i.pos = i.items[:0], 0
+			i.update()
+			return
+		}
+	}
+	i.load(k, true)
+	i.update()
+}
+
+func (i *Iterator) Next() {
+	if !i.valid {
+		return
+	}
+	i.pos++
+	if i.pos == len(i.items) && len(i.items) == iteratorBatchSize {
+		i.load(i.key, false)
+	}
+	i.update()
+}
+
+func (i *Iterator) Current() ([]byte, []byte, bool) {
+	return i.key, i.val, i.valid
+}
+
+func (i *Iterator) Key() []byte {
+	return i.key
+}
+
+func (i *Iterator) Value() []byte {
+	return i.val
+}
+
+func (i *Iterator) Valid() bool {
+	return i.valid
+}
+
+func (i *Iterator) Close() error {
+	return nil
+}
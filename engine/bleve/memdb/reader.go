@@ -0,0 +1,52 @@
+package memdb
+
+import (
+	"github.com/blevesearch/bleve/index/store"
+	"github.com/steveyen/gtreap"
+)
+
+var _ store.KVReader = &Reader{}
+
+type Reader struct {
+	t *gtreap.Treap
+}
+
+func NewReader(t *gtreap.Treap) *Reader {
+	return &Reader{t: t}
+}
+
+func (r *Reader) Get(key []byte) ([]byte, error) {
+	itm := r.t.Get(&item{k: key})
+	if itm == nil {
+		return nil, nil
+	}
+	v := itm.(*item).v
+	rv := make([]byte, len(v))
+	copy(rv, v)
+	return rv, nil
+}
+
+// MultiGet retrieves multiple values in one call.
+func (r *Reader) MultiGet(keys [][]byte) ([][]byte, error) {
+	return store.MultiGet(r, keys)
+}
+
+// PrefixIterator returns a KVIterator that will
+// visit all K/V pairs with the provided prefix
+func (r *Reader) PrefixIterator(prefix []byte) store.KVIterator {
+	rv := &Iterator{t: r.t, prefix: prefix}
+	rv.Seek(prefix)
+	return rv
+}
+
+// RangeIterator returns a KVIterator that will
+// visit all K/V pairs >= start AND < end
+func (r *Reader) RangeIterator(start, end []byte) store.KVIterator {
+	rv := &Iterator{t: r.t, start: start, end: end}
+	rv.Seek(start)
+	return rv
+}
+
+func (r *Reader) Close() error {
+	return nil
+}
@@ -0,0 +1,77 @@
+package memdb
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/blevesearch/bleve/index/store"
+	"github.com/blevesearch/bleve/registry"
+	"github.com/steveyen/gtreap"
+)
+
+const (
+	Name = "memdb"
+	// ConfigDB is the config key of the *DB a store is opened on.
+	ConfigDB = "db"
+)
+
+var _ store.KVStore = &Store{}
+
+type item struct {
+	k []byte
+	v []byte
+}
+
+func itemCompare(a, b interface{}) int {
+	return bytes.Compare(a.(*item).k, b.(*item).k)
+}
+
+// DB holds the data of the in-memory stores. It outlives the stores opened on it,
+// so an index can be closed and reopened without losing the data.
+type DB struct {
+	m sync.Mutex
+	t *gtreap.Treap
+}
+
+func NewDB() *DB {
+	return &DB{t: gtreap.NewTreap(itemCompare)}
+}
+
+// snapshot returns the current treap, it is immutable and never changed by the writers.
+func (db *DB) snapshot() *gtreap.Treap {
+	db.m.Lock()
+	defer db.m.Unlock()
+	return db.t
+}
+
+type Store struct {
+	db *DB
+	mo store.MergeOperator
+}
+
+// New opens a store on the *DB of the config, or on a new DB if there is none.
+func New(mo store.MergeOperator, config map[string]interface{}) (store.KVStore, error) {
+	db, ok := config[ConfigDB].(*DB)
+	if !ok {
+		db = NewDB()
+	}
+	return &Store{db: db, mo: mo}, nil
+}
+
+func (s *Store) Writer() (store.KVWriter, error) {
+	return NewWriter(s.db, s.mo), nil
+}
+
+// Reader returns a KVReader of the snapshot when it is called.
+func (s *Store) Reader() (store.KVReader, error) {
+	return NewReader(s.db.snapshot()), nil
+}
+
+// Close keeps the data in the DB.
+func (s *Store) Close() error {
+	return nil
+}
+
+func init() {
+	registry.RegisterKVStore(Name, New)
+}
@@ -0,0 +1,104 @@
+package memdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/blevesearch/bleve/index/store"
+	"github.com/blevesearch/bleve/index/store/test"
+)
+
+func open(t *testing.T) store.KVStore {
+	rv, err := New(nil, map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rv
+}
+
+func cleanup(t *testing.T, s store.KVStore) {
+	err := s.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemDBKVCrud(t *testing.T) {
+	s := open(t)
+	defer cleanup(t, s)
+	test.CommonTestKVCrud(t, s)
+}
+
+func TestMemDBReaderIsolation(t *testing.T) {
+	s := open(t)
+	defer cleanup(t, s)
+	test.CommonTestReaderIsolation(t, s)
+}
+
+func TestMemDBReaderOwnsGetBytes(t *testing.T) {
+	s := open(t)
+	defer cleanup(t, s)
+	test.CommonTestReaderOwnsGetBytes(t, s)
+}
+
+func TestMemDBWriterOwnsBytes(t *testing.T) {
+	s := open(t)
+	defer cleanup(t, s)
+	test.CommonTestWriterOwnsBytes(t, s)
+}
+
+func TestMemDBPrefixIterator(t *testing.T) {
+	s := open(t)
+	defer cleanup(t, s)
+	test.CommonTestPrefixIterator(t, s)
+}
+
+func TestMemDBPrefixIteratorSeek(t *testing.T) {
+	s := open(t)
+	defer cleanup(t, s)
+	test.CommonTestPrefixIteratorSeek(t, s)
+}
+
+func TestMemDBRangeIterator(t *testing.T) {
+	s := open(t)
+	defer cleanup(t, s)
+	test.CommonTestRangeIterator(t, s)
+}
+
+func TestMemDBRangeIteratorSeek(t *testing.T) {
+	s := open(t)
+	defer cleanup(t, s)
+	test.CommonTestRangeIteratorSeek(t, s)
+}
+
+func TestMemDBReopen(t *testing.T) {
+	db := NewDB()
+	s, _ := New(nil, map[string]interface{}{ConfigDB: db})
+	writer, _ := s.Writer()
+	batch := writer.NewBatch()
+	for i := 0; i < 3*iteratorBatchSize; i++ {
+		batch.Set([]byte(fmt.Sprintf("k%03d", i)), []byte("v"))
+	}
+	if err := writer.ExecuteBatch(batch); err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+	s.Close()
+
+	s, _ = New(nil, map[string]interface{}{ConfigDB: db})
+	defer cleanup(t, s)
+	reader, _ := s.Reader()
+	defer reader.Close()
+	iter := reader.PrefixIterator([]byte("k"))
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		if key := fmt.Sprintf("k%03d", count); string(iter.Key()) != key {
+			t.Fatalf("expect key %s, got %s", key, iter.Key())
+		}
+		count++
+	}
+	if count != 3*iteratorBatchSize {
+		t.Fatalf("expect %d keys after reopen, got %d", 3*iteratorBatchSize, count)
+	}
+}
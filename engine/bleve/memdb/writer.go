@@ -0,0 +1,65 @@
+package memdb
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/blevesearch/bleve/index/store"
+)
+
+var _ store.KVWriter = &Writer{}
+
+type Writer struct {
+	db *DB
+	mo store.MergeOperator
+}
+
+func NewWriter(db *DB, mo store.MergeOperator) *Writer {
+	return &Writer{db: db, mo: mo}
+}
+
+func (w *Writer) NewBatch() store.KVBatch {
+	return store.NewEmulatedBatch(w.mo)
+}
+
+func (w *Writer) NewBatchEx(options store.KVBatchOptions) ([]byte, store.KVBatch, error) {
+	return make([]byte, options.TotalBytes), w.NewBatch(), nil
+}
+
+// ExecuteBatch applies the batch to a new version of the treap, so the readers never see a partial batch.
+func (w *Writer) ExecuteBatch(batch store.KVBatch) error {
+	emulatedBatch, ok := batch.(*store.EmulatedBatch)
+	if !ok {
+		return fmt.Errorf("wrong type of batch")
+	}
+
+	w.db.m.Lock()
+	defer w.db.m.Unlock()
+	t := w.db.t
+	for k, mergeOps := range emulatedBatch.Merger.Merges {
+		kb := []byte(k)
+		var existingVal []byte
+		if itm := t.Get(&item{k: kb}); itm != nil {
+			existingVal = itm.(*item).v
+		}
+		mergedVal, fullMergeOk := w.mo.FullMerge(kb, existingVal, mergeOps)
+		if !fullMergeOk {
+			return fmt.Errorf("merge operator returned failure")
+		}
+		t = t.Upsert(&item{k: kb, v: mergedVal}, rand.Int())
+	}
+	for _, op := range emulatedBatch.Ops {
+		k := append([]byte(nil), op.K...)
+		if op.V != nil {
+			t = t.Upsert(&item{k: k, v: append([]byte(nil), op.V...)}, rand.Int())
+		} else {
+			t = t.Delete(&item{k: k})
+		}
+	}
+	w.db.t = t
+	return nil
+}
+
+func (w *Writer) Close() error {
+	return nil
+}
@@ -0,0 +1,110 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+)
+
+type BoostingQuery struct {
+	query.Query
+}
+
+func NewBoostingQuery() *BoostingQuery {
+	return &BoostingQuery{}
+}
+
+/*
+{
+    "positive" : { "term" : { "text" : "apple" } },
+    "negative" : { "term" : { "text" : "pie tart fruit crumble tree" } },
+    "negative_boost" : 0.5
+}
+*/
+func (b *BoostingQuery) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Positive      json.RawMessage `json:"positive"`
+		Negative      json.RawMessage `json:"negative"`
+		NegativeBoost *float64        `json:"negative_boost"`
+		Boost         *Boost          `json:"boost,omitempty"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if len(tmp.Positive) == 0 || len(tmp.Negative) == 0 {
+		return errors.New("boosting query requires positive and negative queries")
+	}
+	if tmp.NegativeBoost == nil || *tmp.NegativeBoost < 0 {
+		return errors.New("boosting query requires a non-negative negative_boost")
+	}
+	q := &boostingQuery{negativeBoost: *tmp.NegativeBoost}
+	if q.positive, err = ParseQuery(tmp.Positive); err != nil {
+		return err
+	}
+	if q.negative, err = ParseQuery(tmp.Negative); err != nil {
+		return err
+	}
+	if tmp.Boost != nil {
+		q.SetBoost(tmp.Boost.Value())
+	}
+	b.Query = q
+	return nil
+}
+
+// boostingQuery matches the documents of the positive query, and demotes the scores of those matching the negative query.
+type boostingQuery struct {
+	positive      query.Query
+	negative      query.Query
+	negativeBoost float64
+	boost         *query.Boost
+}
+
+func (q *boostingQuery) SetBoost(b float64) {
+	boost := query.Boost(b)
+	q.boost = &boost
+}
+
+func (q *boostingQuery) Boost() float64 {
+	return q.boost.Value()
+}
+
+func (q *boostingQuery) Searcher(i index.IndexReader, m mapping.IndexMapping, options search.SearcherOptions) (search.Searcher, error) {
+	s, err := q.positive.Searcher(i, m, options)
+	if err != nil {
+		return nil, err
+	}
+	ss := newScoreSearcher(s)
+	negative, err := ss.newDocMatcher(q.negative, i, m)
+	if err != nil {
+		ss.Close()
+		return nil, err
+	}
+	ss.rescore = func(ctx *search.SearchContext, d *search.DocumentMatch) (bool, error) {
+		matched, err := negative.matches(ctx, d.IndexInternalID)
+		if err != nil {
+			return false, err
+		}
+		if matched {
+			d.Score *= q.negativeBoost
+		}
+		d.Score *= q.boost.Value()
+		return true, nil
+	}
+	return ss, nil
+}
+
+func (q *boostingQuery) Validate() error {
+	for _, sub := range []query.Query{q.positive, q.negative} {
+		if vq, ok := sub.(query.ValidatableQuery); ok {
+			if err := vq.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
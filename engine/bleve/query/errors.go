@@ -5,5 +5,4 @@ import "errors"
 var (
 	ErrInvalidMinMatch                = errors.New("invalid minimum_should_match")
     ErrInvalidTermQuery               = errors.New("invalid term query")
-    ErrNotSupportPhrasePrefix         = errors.New("not support phrase_prefix match")
 )
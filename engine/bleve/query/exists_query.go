@@ -1,3 +1,85 @@
 package query
 
-// TODO
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/blevesearch/bleve/search/searcher"
+)
+
+type ExistsQuery struct {
+	query.Query
+}
+
+func NewExistsQuery() *ExistsQuery {
+	return &ExistsQuery{}
+}
+
+/*
+{ "field" : "user" }
+*/
+func (e *ExistsQuery) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Field string `json:"field"`
+		Boost *Boost `json:"boost,omitempty"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if tmp.Field == "" {
+		return errors.New("exists query requires a field")
+	}
+	q := newExistsQuery(tmp.Field)
+	if tmp.Boost != nil {
+		q.SetBoost(tmp.Boost.Value())
+	}
+	e.Query = q
+	return nil
+}
+
+// existsQuery matches the documents which have any indexed term in the field.
+type existsQuery struct {
+	field string
+	boost *query.Boost
+}
+
+func newExistsQuery(field string) *existsQuery {
+	return &existsQuery{field: field}
+}
+
+func (q *existsQuery) SetBoost(b float64) {
+	boost := query.Boost(b)
+	q.boost = &boost
+}
+
+func (q *existsQuery) Boost() float64 {
+	return q.boost.Value()
+}
+
+func (q *existsQuery) SetField(f string) {
+	q.field = f
+}
+
+func (q *existsQuery) Field() string {
+	return q.field
+}
+
+func (q *existsQuery) Searcher(i index.IndexReader, m mapping.IndexMapping, options search.SearcherOptions) (search.Searcher, error) {
+	s, err := searcher.NewMatchAllSearcher(i, q.boost.Value(), options)
+	if err != nil {
+		return nil, err
+	}
+	fields := []string{q.field}
+	return searcher.NewFilteringSearcher(s, func(d *search.DocumentMatch) bool {
+		var exists bool
+		err := i.DocumentVisitFieldTerms(d.IndexInternalID, fields, func(field string, term []byte) {
+			exists = true
+		})
+		return err == nil && exists
+	}), nil
+}
@@ -0,0 +1,417 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+)
+
+type FunctionScoreQuery struct {
+	query.Query
+}
+
+func NewFunctionScoreQuery() *FunctionScoreQuery {
+	return &FunctionScoreQuery{}
+}
+
+/*
+{
+    "query": { "match_all": {} },
+    "boost": 5,
+    "functions": [
+        {
+            "filter": { "match": { "test": "bar" } },
+            "random_score": {},
+            "weight": 23
+        },
+        {
+            "filter": { "match": { "test": "cat" } },
+            "weight": 42
+        },
+        {
+            "gauss": { "price": { "origin": "0", "scale": "20" } }
+        }
+    ],
+    "max_boost": 42,
+    "score_mode": "max",
+    "boost_mode": "multiply",
+    "min_score" : 42
+}
+*/
+func (q *FunctionScoreQuery) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Query     json.RawMessage   `json:"query,omitempty"`
+		Functions []json.RawMessage `json:"functions,omitempty"`
+		Boost     *Boost            `json:"boost,omitempty"`
+		MaxBoost  *float64          `json:"max_boost,omitempty"`
+		ScoreMode string            `json:"score_mode,omitempty"`
+		BoostMode string            `json:"boost_mode,omitempty"`
+		MinScore  *float64          `json:"min_score,omitempty"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	fq := &functionScoreQuery{scoreMode: "multiply", boostMode: "multiply", maxBoost: math.MaxFloat32, minScore: tmp.MinScore}
+	if len(tmp.Query) > 0 {
+		if fq.query, err = ParseQuery(tmp.Query); err != nil {
+			return err
+		}
+	} else {
+		fq.query = query.NewMatchAllQuery()
+	}
+	if tmp.MaxBoost != nil {
+		fq.maxBoost = *tmp.MaxBoost
+	}
+	if tmp.ScoreMode != "" {
+		fq.scoreMode = strings.ToLower(tmp.ScoreMode)
+		switch fq.scoreMode {
+		case "multiply", "sum", "avg", "first", "max", "min":
+		default:
+			return fmt.Errorf("invalid score_mode %s", tmp.ScoreMode)
+		}
+	}
+	if tmp.BoostMode != "" {
+		fq.boostMode = strings.ToLower(tmp.BoostMode)
+		switch fq.boostMode {
+		case "multiply", "replace", "sum", "avg", "max", "min":
+		default:
+			return fmt.Errorf("invalid boost_mode %s", tmp.BoostMode)
+		}
+	}
+
+	if len(tmp.Functions) == 0 {
+		// a single function may be given at the top level
+		f, err := parseFilterFunction(data, false)
+		if err != nil {
+			return err
+		}
+		if f != nil {
+			fq.functions = append(fq.functions, f)
+		}
+	}
+	for _, raw := range tmp.Functions {
+		f, err := parseFilterFunction(raw, true)
+		if err != nil {
+			return err
+		}
+		if f == nil {
+			return fmt.Errorf("function_score function requires a function or weight: %s", raw)
+		}
+		fq.functions = append(fq.functions, f)
+	}
+	if tmp.Boost != nil {
+		fq.SetBoost(tmp.Boost.Value())
+	}
+	q.Query = fq
+	return nil
+}
+
+// filterFunction is a score function applied to the documents matching the filter.
+type filterFunction struct {
+	filter   query.Query
+	weight   float64
+	function scoreFunction
+}
+
+// parseFilterFunction parses the function of function_score, it returns nil if there is no function or weight.
+func parseFilterFunction(data []byte, hasFilter bool) (*filterFunction, error) {
+	tmp := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return nil, err
+	}
+	f := &filterFunction{weight: 1.0}
+	var err error
+	if raw, ok := tmp["filter"]; ok && hasFilter {
+		if f.filter, err = ParseQuery(raw); err != nil {
+			return nil, err
+		}
+	}
+	hasWeight := false
+	if raw, ok := tmp["weight"]; ok {
+		if err = json.Unmarshal(raw, &f.weight); err != nil {
+			return nil, err
+		}
+		hasWeight = true
+	}
+	for name, raw := range tmp {
+		var function scoreFunction
+		switch name {
+		case "field_value_factor":
+			function, err = parseFieldValueFactor(raw)
+		case "random_score":
+			function, err = parseRandomScore(raw)
+		case "gauss", "exp", "linear":
+			function, err = parseDecayFunction(name, raw)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if f.function != nil {
+			return nil, fmt.Errorf("function_score function has more than one function: %s", data)
+		}
+		f.function = function
+	}
+	if f.function == nil {
+		if !hasWeight {
+			return nil, nil
+		}
+		f.function = weightFunction{}
+	}
+	return f, nil
+}
+
+// functionScoreQuery modifies the scores of the documents matching the query by the functions.
+type functionScoreQuery struct {
+	query     query.Query
+	functions []*filterFunction
+	scoreMode string
+	boostMode string
+	maxBoost  float64
+	minScore  *float64
+	boost     *query.Boost
+}
+
+func (q *functionScoreQuery) SetBoost(b float64) {
+	boost := query.Boost(b)
+	q.boost = &boost
+}
+
+func (q *functionScoreQuery) Boost() float64 {
+	return q.boost.Value()
+}
+
+func (q *functionScoreQuery) Searcher(i index.IndexReader, m mapping.IndexMapping, options search.SearcherOptions) (search.Searcher, error) {
+	s, err := q.query.Searcher(i, m, options)
+	if err != nil {
+		return nil, err
+	}
+	filters := make([]*docMatcher, len(q.functions))
+	ss := newScoreSearcher(s)
+	for j, f := range q.functions {
+		if f.filter == nil {
+			continue
+		}
+		if filters[j], err = ss.newDocMatcher(f.filter, i, m); err != nil {
+			ss.Close()
+			return nil, err
+		}
+	}
+
+	ss.rescore = func(ctx *search.SearchContext, d *search.DocumentMatch) (bool, error) {
+		var scores, weights []float64
+		for j, f := range q.functions {
+			if filters[j] != nil {
+				matched, err := filters[j].matches(ctx, d.IndexInternalID)
+				if err != nil {
+					return false, err
+				}
+				if !matched {
+					continue
+				}
+			}
+			score, err := f.function.score(i, d)
+			if err != nil {
+				return false, err
+			}
+			scores = append(scores, score*f.weight)
+			weights = append(weights, f.weight)
+			if q.scoreMode == "first" {
+				break
+			}
+		}
+
+		functionScore := math.Min(combineScores(q.scoreMode, scores, weights), q.maxBoost)
+		queryScore := d.Score
+		d.Score = combineBoost(q.boostMode, queryScore, functionScore) * q.boost.Value()
+		if options.Explain {
+			d.Expl = &search.Explanation{
+				Value:    d.Score,
+				Message:  fmt.Sprintf("function score, score mode [%s], boost mode [%s]", q.scoreMode, q.boostMode),
+				Children: []*search.Explanation{d.Expl, {Value: functionScore, Message: "functions"}},
+			}
+		}
+		return q.minScore == nil || d.Score >= *q.minScore, nil
+	}
+	return ss, nil
+}
+
+// combineScores combines the weighted scores of the functions by the score mode, it is 1 if no function matches.
+func combineScores(mode string, scores, weights []float64) float64 {
+	if len(scores) == 0 {
+		return 1
+	}
+	result := scores[0]
+	switch mode {
+	case "multiply":
+		for _, s := range scores[1:] {
+			result *= s
+		}
+	case "sum":
+		for _, s := range scores[1:] {
+			result += s
+		}
+	case "avg":
+		var totalWeight float64
+		for j, s := range scores {
+			if j > 0 {
+				result += s
+			}
+			totalWeight += weights[j]
+		}
+		result /= totalWeight
+	case "max":
+		for _, s := range scores[1:] {
+			result = math.Max(result, s)
+		}
+	case "min":
+		for _, s := range scores[1:] {
+			result = math.Min(result, s)
+		}
+	}
+	return result
+}
+
+// combineBoost combines the score of the query and the score of the functions by the boost mode.
+func combineBoost(mode string, queryScore, functionScore float64) float64 {
+	switch mode {
+	case "replace":
+		return functionScore
+	case "sum":
+		return queryScore + functionScore
+	case "avg":
+		return (queryScore + functionScore) / 2
+	case "max":
+		return math.Max(queryScore, functionScore)
+	case "min":
+		return math.Min(queryScore, functionScore)
+	default:
+		return queryScore * functionScore
+	}
+}
+
+func (q *functionScoreQuery) Validate() error {
+	if vq, ok := q.query.(query.ValidatableQuery); ok {
+		if err := vq.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, f := range q.functions {
+		if vq, ok := f.filter.(query.ValidatableQuery); ok {
+			if err := vq.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scoreSearcher rescores the documents of the child searcher, and skips the documents if rescore returns false.
+type scoreSearcher struct {
+	search.Searcher
+	rescore  func(ctx *search.SearchContext, d *search.DocumentMatch) (bool, error)
+	matchers []*docMatcher
+}
+
+func newScoreSearcher(s search.Searcher) *scoreSearcher {
+	return &scoreSearcher{Searcher: s}
+}
+
+// newDocMatcher creates a matcher of the query which is closed with the searcher.
+func (s *scoreSearcher) newDocMatcher(q query.Query, i index.IndexReader, m mapping.IndexMapping) (*docMatcher, error) {
+	searcher, err := q.Searcher(i, m, search.SearcherOptions{})
+	if err != nil {
+		return nil, err
+	}
+	matcher := &docMatcher{searcher: searcher}
+	s.matchers = append(s.matchers, matcher)
+	return matcher, nil
+}
+
+func (s *scoreSearcher) Next(ctx *search.SearchContext) (*search.DocumentMatch, error) {
+	for {
+		d, err := s.Searcher.Next(ctx)
+		if err != nil || d == nil {
+			return nil, err
+		}
+		if keep, err := s.rescore(ctx, d); err != nil || keep {
+			return d, err
+		}
+		ctx.DocumentMatchPool.Put(d)
+	}
+}
+
+func (s *scoreSearcher) Advance(ctx *search.SearchContext, ID index.IndexInternalID) (*search.DocumentMatch, error) {
+	d, err := s.Searcher.Advance(ctx, ID)
+	if err != nil || d == nil {
+		return nil, err
+	}
+	if keep, err := s.rescore(ctx, d); err != nil || keep {
+		return d, err
+	}
+	ctx.DocumentMatchPool.Put(d)
+	return s.Next(ctx)
+}
+
+func (s *scoreSearcher) Close() error {
+	err := s.Searcher.Close()
+	for _, m := range s.matchers {
+		if e := m.searcher.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (s *scoreSearcher) Size() int {
+	size := s.Searcher.Size()
+	for _, m := range s.matchers {
+		size += m.searcher.Size()
+	}
+	return size
+}
+
+func (s *scoreSearcher) DocumentMatchPoolSize() int {
+	size := s.Searcher.DocumentMatchPoolSize()
+	for _, m := range s.matchers {
+		size += m.searcher.DocumentMatchPoolSize()
+	}
+	return size
+}
+
+// docMatcher reports whether the documents match a query, the documents must be checked in the order of internal id.
+type docMatcher struct {
+	searcher search.Searcher
+	current  *search.DocumentMatch
+	done     bool
+}
+
+func (m *docMatcher) matches(ctx *search.SearchContext, id index.IndexInternalID) (bool, error) {
+	if m.done {
+		return false, nil
+	}
+	if m.current != nil && m.current.IndexInternalID.Compare(id) >= 0 {
+		return m.current.IndexInternalID.Equals(id), nil
+	}
+	if m.current != nil {
+		ctx.DocumentMatchPool.Put(m.current)
+	}
+	next, err := m.searcher.Advance(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if next == nil {
+		m.current, m.done = nil, true
+		return false, nil
+	}
+	m.current = next
+	return next.IndexInternalID.Equals(id), nil
+}
@@ -0,0 +1,118 @@
+package query
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDecayFunction(t *testing.T) {
+	for _, name := range []string{"gauss", "exp", "linear"} {
+		f, err := parseDecayFunction(name, []byte(`{"price": {"origin": 100, "scale": 20, "offset": 5, "decay": 0.3}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.kind != decayNumeric || f.mode != "min" {
+			t.Fatalf("invalid %s function %v", name, f)
+		}
+		if score := f.decay(0); score != 1 {
+			t.Fatalf("%s score at origin is %v", name, score)
+		}
+		if score := f.decay(20); math.Abs(score-0.3) > 1e-9 {
+			t.Fatalf("%s score at scale is %v", name, score)
+		}
+	}
+
+	f, err := parseDecayFunction("gauss", []byte(`{"date": {"origin": "2018-01-01", "scale": "10d"}, "multi_value_mode": "avg"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.kind != decayDate || f.mode != "avg" || f.origin != float64(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()) {
+		t.Fatalf("invalid date decay function %v", f)
+	}
+	if score := f.decay(float64(10 * 24 * time.Hour)); math.Abs(score-0.5) > 1e-9 {
+		t.Fatalf("date score at scale is %v", score)
+	}
+	if f, err = parseDecayFunction("exp", []byte(`{"location": {"origin": {"lat": 40, "lon": 116}, "scale": "2km"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if f.kind != decayGeo || f.originLon != 116 || f.originLat != 40 || math.Abs(f.decay(2000)-0.5) > 1e-9 {
+		t.Fatalf("invalid geo decay function %v", f)
+	}
+	if f, err = parseDecayFunction("linear", []byte(`{"date": {"scale": "1h"}}`)); err != nil || f.kind != decayDate {
+		t.Fatalf("expect date decay from now: %v %v", f, err)
+	}
+
+	for _, input := range []string{
+		`{"price": {"origin": 1}}`,
+		`{"price": {"origin": 1, "scale": 0}}`,
+		`{"price": {"origin": 1, "scale": 1, "decay": 1}}`,
+		`{"price": {"origin": 1, "scale": 1}, "date": {"origin": 1, "scale": 1}}`,
+		`{"price": {"origin": 1, "scale": 1}, "multi_value_mode": "median"}`,
+		`{"date": {"origin": "now", "scale": "1y"}}`,
+	} {
+		if _, err := parseDecayFunction("gauss", []byte(input)); err == nil {
+			t.Fatalf("expect error of %s", input)
+		}
+	}
+}
+
+func TestFunctionScoreQuery(t *testing.T) {
+	q := NewFunctionScoreQuery()
+	err := json.Unmarshal([]byte(`{
+        "query": {"match_all": {}},
+        "boost": 5,
+        "functions": [
+            {"filter": {"match": {"test": "bar"}}, "random_score": {"seed": "abc"}, "weight": 23},
+            {"filter": {"match": {"test": "cat"}}, "weight": 42},
+            {"field_value_factor": {"field": "likes", "factor": 1.2, "modifier": "log1p", "missing": 1}}
+        ],
+        "max_boost": 42,
+        "score_mode": "max",
+        "boost_mode": "multiply",
+        "min_score": 42
+    }`), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fq := q.Query.(*functionScoreQuery)
+	if len(fq.functions) != 3 || fq.Boost() != 5 || fq.maxBoost != 42 || fq.scoreMode != "max" || *fq.minScore != 42 {
+		t.Fatalf("invalid function score query %v", fq)
+	}
+	if _, ok := fq.functions[1].function.(weightFunction); !ok || fq.functions[1].weight != 42 || fq.functions[1].filter == nil {
+		t.Fatalf("invalid weight function %v", fq.functions[1])
+	}
+	if f := fq.functions[2].function.(*fieldValueFactor); f.field != "likes" || f.factor != 1.2 || f.modifier != "log1p" || *f.missing != 1 {
+		t.Fatalf("invalid field value factor %v", f)
+	}
+
+	for _, input := range []string{
+		`{"functions": [{"filter": {"match_all": {}}}]}`,
+		`{"functions": [{"weight": 2, "random_score": {}, "field_value_factor": {"field": "likes"}}]}`,
+		`{"field_value_factor": {"field": "likes", "modifier": "cube"}}`,
+		`{"weight": 2, "score_mode": "median"}`,
+		`{"weight": 2, "boost_mode": "first"}`,
+	} {
+		if err := json.Unmarshal([]byte(input), NewFunctionScoreQuery()); err == nil {
+			t.Fatalf("expect error of %s", input)
+		}
+	}
+}
+
+func TestCombineScores(t *testing.T) {
+	scores, weights := []float64{2, 6}, []float64{1, 3}
+	for mode, expect := range map[string]float64{"multiply": 12, "sum": 8, "avg": 2, "first": 2, "max": 6, "min": 2} {
+		if score := combineScores(mode, scores, weights); score != expect {
+			t.Fatalf("score mode %s, expect %v, got %v", mode, expect, score)
+		}
+	}
+	if score := combineScores("sum", nil, nil); score != 1 {
+		t.Fatalf("expect 1 without functions, got %v", score)
+	}
+	for mode, expect := range map[string]float64{"multiply": 6, "replace": 3, "sum": 5, "avg": 2.5, "max": 3, "min": 2} {
+		if score := combineBoost(mode, 2, 3); score != expect {
+			t.Fatalf("boost mode %s, expect %v, got %v", mode, expect, score)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blevesearch/bleve/geo"
+)
+
+var ErrInvalidGeoPoint = errors.New("invalid geo point")
+
+// distanceUnitAliases maps the distance units which bleve names differently.
+var distanceUnitAliases = map[string]string{"nmi": "nm", "NM": "nm"}
+
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+/*
+ParseGeoPoint parses a geo point in any of the forms:
+
+{ "lat" : 40.73, "lon" : -74.1 }
+[ -74.1, 40.73 ]
+"40.73, -74.1"
+"dr5r9ydj2y73"
+*/
+func ParseGeoPoint(v interface{}) (lon, lat float64, err error) {
+	if s, ok := v.(string); ok {
+		return parseGeoPointString(s)
+	}
+	lon, lat, found := geo.ExtractGeoPoint(v)
+	if !found {
+		return 0, 0, ErrInvalidGeoPoint
+	}
+	return lon, lat, nil
+}
+
+func parseGeoPointString(s string) (lon, lat float64, err error) {
+	parts := strings.Split(s, ",")
+	switch len(parts) {
+	case 1:
+		return decodeGeohash(strings.TrimSpace(s))
+	case 2:
+		lat, err = strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		if err != nil {
+			return 0, 0, ErrInvalidGeoPoint
+		}
+		lon, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil {
+			return 0, 0, ErrInvalidGeoPoint
+		}
+		return lon, lat, nil
+	default:
+		return 0, 0, ErrInvalidGeoPoint
+	}
+}
+
+// decodeGeohash returns the center of the geohash cell.
+func decodeGeohash(hash string) (lon, lat float64, err error) {
+	if hash == "" {
+		return 0, 0, ErrInvalidGeoPoint
+	}
+	minLon, maxLon, minLat, maxLat := -180.0, 180.0, -90.0, 90.0
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		idx := strings.IndexRune(geohashBase32, c)
+		if idx < 0 {
+			return 0, 0, ErrInvalidGeoPoint
+		}
+		for bit := 4; bit >= 0; bit-- {
+			set := idx&(1<<uint(bit)) != 0
+			if even {
+				mid := (minLon + maxLon) / 2
+				if set {
+					minLon = mid
+				} else {
+					maxLon = mid
+				}
+			} else {
+				mid := (minLat + maxLat) / 2
+				if set {
+					minLat = mid
+				} else {
+					maxLat = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return (minLon + maxLon) / 2, (minLat + maxLat) / 2, nil
+}
+
+// ParseDistance parses a distance like "5km" or "300m" into meters, a number without unit is in meters.
+func ParseDistance(v interface{}) (float64, error) {
+	switch d := v.(type) {
+	case string:
+		d = strings.TrimSpace(d)
+		for alias, unit := range distanceUnitAliases {
+			if strings.HasSuffix(d, alias) {
+				d = strings.TrimSuffix(d, alias) + unit
+				break
+			}
+		}
+		dist, err := geo.ParseDistance(d)
+		if err != nil {
+			return 0, fmt.Errorf("invalid distance %s", v)
+		}
+		return dist, nil
+	default:
+		return toFloat(v)
+	}
+}
+
+// ParseDistanceUnit returns the bleve name of the distance unit.
+func ParseDistanceUnit(u string) (string, error) {
+	if unit, ok := distanceUnitAliases[u]; ok {
+		u = unit
+	}
+	if _, err := geo.ParseDistanceUnit(u); err != nil {
+		return "", err
+	}
+	return u, nil
+}
@@ -0,0 +1,111 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+type GeoBoundingBoxQuery struct {
+	query.Query
+}
+
+func NewGeoBoundingBoxQuery() *GeoBoundingBoxQuery {
+	return &GeoBoundingBoxQuery{}
+}
+
+/*
+{
+    "pin.location" : {
+        "top_left" : { "lat" : 40.73, "lon" : -74.1 },
+        "bottom_right" : { "lat" : 40.01, "lon" : -71.12 }
+    }
+}
+
+{ "pin.location" : { "top_right" : "40.73, -71.12", "bottom_left" : "40.01, -74.1" } }
+
+{ "pin.location" : { "top" : 40.73, "left" : -74.1, "bottom" : 40.01, "right" : -71.12 } }
+*/
+func (q *GeoBoundingBoxQuery) UnmarshalJSON(data []byte) error {
+	tmp := make(map[string]interface{})
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	var field string
+	var box map[string]interface{}
+	boost := 1.0
+	for key, val := range tmp {
+		switch key {
+		case "boost":
+			if boost, err = toFloat(val); err != nil {
+				return err
+			}
+		case "type", "validation_method", "ignore_unmapped", "_name":
+		default:
+			if field != "" {
+				return errors.New("geo_bounding_box query supports only one field")
+			}
+			var ok bool
+			if box, ok = val.(map[string]interface{}); !ok {
+				return errors.New("invalid geo_bounding_box query")
+			}
+			field = key
+		}
+	}
+	if field == "" {
+		return errors.New("geo_bounding_box query requires a field")
+	}
+
+	var top, left, bottom, right float64
+	var edges int
+	for key, val := range box {
+		switch key {
+		case "top_left", "bottom_right", "top_right", "bottom_left":
+			lon, lat, err := ParseGeoPoint(val)
+			if err != nil {
+				return err
+			}
+			if key == "top_left" || key == "top_right" {
+				top = lat
+			} else {
+				bottom = lat
+			}
+			if key == "top_left" || key == "bottom_left" {
+				left = lon
+			} else {
+				right = lon
+			}
+			edges += 2
+		case "top":
+			top, err = toFloat(val)
+			edges++
+		case "left":
+			left, err = toFloat(val)
+			edges++
+		case "bottom":
+			bottom, err = toFloat(val)
+			edges++
+		case "right":
+			right, err = toFloat(val)
+			edges++
+		default:
+			return errors.New("invalid geo_bounding_box query")
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if edges != 4 {
+		return errors.New("geo_bounding_box query requires the four edges of the box")
+	}
+	if top < bottom {
+		return errors.New("the top of geo_bounding_box is below the bottom")
+	}
+	bq := query.NewGeoBoundingBoxQuery(left, top, right, bottom)
+	bq.SetField(field)
+	bq.SetBoost(boost)
+	q.Query = bq
+	return nil
+}
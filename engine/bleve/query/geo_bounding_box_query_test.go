@@ -0,0 +1,36 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+func TestGeoBoundingBoxQuery(t *testing.T) {
+	expect := query.NewGeoBoundingBoxQuery(-74.1, 40.73, -71.12, 40.01)
+	expect.SetField("pin.location")
+	expect.SetBoost(1.0)
+	inputs := []string{
+		`{ "pin.location" : { "top_left" : { "lat" : 40.73, "lon" : -74.1 }, "bottom_right" : { "lat" : 40.01, "lon" : -71.12 } } }`,
+		`{ "pin.location" : { "top_right" : "40.73, -71.12", "bottom_left" : [-74.1, 40.01] }, "type" : "indexed" }`,
+		`{ "pin.location" : { "top" : 40.73, "left" : -74.1, "bottom" : 40.01, "right" : -71.12 } }`,
+	}
+	for _, input := range inputs {
+		q := NewGeoBoundingBoxQuery()
+		err := json.Unmarshal([]byte(input), q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(q.Query, expect) {
+			t.Fatalf("parse failed %v %v", q.Query, expect)
+		}
+	}
+
+	for _, input := range []string{`{ "pin.location" : { "top" : 40.73, "left" : -74.1 } }`, `{ "pin.location" : { "top" : 40.01, "left" : -74.1, "bottom" : 40.73, "right" : -71.12 } }`} {
+		if err := json.Unmarshal([]byte(input), NewGeoBoundingBoxQuery()); err == nil {
+			t.Fatalf("expect error of %s", input)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+type GeoDistanceQuery struct {
+	query.Query
+}
+
+func NewGeoDistanceQuery() *GeoDistanceQuery {
+	return &GeoDistanceQuery{}
+}
+
+/*
+{
+    "distance" : "200km",
+    "pin.location" : {
+        "lat" : 40,
+        "lon" : -70
+    }
+}
+*/
+func (q *GeoDistanceQuery) UnmarshalJSON(data []byte) error {
+	tmp := make(map[string]interface{})
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	var field string
+	var location interface{}
+	var distance float64
+	boost := 1.0
+	for key, val := range tmp {
+		switch key {
+		case "distance":
+			if distance, err = ParseDistance(val); err != nil {
+				return err
+			}
+		case "boost":
+			if boost, err = toFloat(val); err != nil {
+				return err
+			}
+		case "distance_type", "validation_method", "ignore_unmapped", "_name":
+		default:
+			if field != "" {
+				return errors.New("geo_distance query supports only one field")
+			}
+			field, location = key, val
+		}
+	}
+	if field == "" {
+		return errors.New("geo_distance query requires a field")
+	}
+	if distance <= 0 {
+		return errors.New("geo_distance query requires a positive distance")
+	}
+	lon, lat, err := ParseGeoPoint(location)
+	if err != nil {
+		return err
+	}
+	gq := query.NewGeoDistanceQuery(lon, lat, strconv.FormatFloat(distance, 'f', -1, 64)+"m")
+	gq.SetField(field)
+	gq.SetBoost(boost)
+	q.Query = gq
+	return nil
+}
@@ -0,0 +1,78 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+func TestGeoDistanceQuery(t *testing.T) {
+	groups := []QueryTestGroup{
+		QueryTestGroup{
+			input: `{ "distance" : "12km", "pin.location" : { "lat" : 40, "lon" : -70 } }`,
+			output: func() query.Query {
+				q := query.NewGeoDistanceQuery(-70, 40, "12000m")
+				q.SetField("pin.location")
+				q.SetBoost(1.0)
+				return q
+			}()},
+		QueryTestGroup{
+			input: `{ "distance" : "300m", "pin.location" : "40,-70", "boost" : 2.0 }`,
+			output: func() query.Query {
+				q := query.NewGeoDistanceQuery(-70, 40, "300m")
+				q.SetField("pin.location")
+				q.SetBoost(2.0)
+				return q
+			}()},
+		QueryTestGroup{
+			input: `{ "distance" : 100, "pin.location" : [-70, 40], "distance_type" : "arc" }`,
+			output: func() query.Query {
+				q := query.NewGeoDistanceQuery(-70, 40, "100m")
+				q.SetField("pin.location")
+				q.SetBoost(1.0)
+				return q
+			}()},
+	}
+	for _, group := range groups {
+		q := NewGeoDistanceQuery()
+		err := json.Unmarshal([]byte(group.input), q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(q.Query, group.output) {
+			t.Fatalf("parse failed %v %v", q.Query, group.output)
+		}
+	}
+
+	for _, input := range []string{`{ "distance" : "12km" }`, `{ "pin.location" : [-70, 40] }`, `{ "distance" : "12xx", "pin.location" : [-70, 40] }`} {
+		if err := json.Unmarshal([]byte(input), NewGeoDistanceQuery()); err == nil {
+			t.Fatalf("expect error of %s", input)
+		}
+	}
+}
+
+func TestParseGeo(t *testing.T) {
+	distances := map[string]float64{"5km": 5000, "300m": 300, "1.5mi": 2414.016, "2nmi": 3704, "10": 10}
+	for input, expect := range distances {
+		d, err := ParseDistance(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d < expect-0.001 || d > expect+0.001 {
+			t.Fatalf("parse distance %s, expect %f, got %f", input, expect, d)
+		}
+	}
+
+	lon, lat, err := ParseGeoPoint("drm3btev3e86")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lon < -71.35 || lon > -71.33 || lat < 41.11 || lat > 41.13 {
+		t.Fatalf("invalid geohash point %f, %f", lon, lat)
+	}
+	if _, _, err = ParseGeoPoint("a,b"); err == nil {
+		t.Fatal("expect error of invalid point")
+	}
+}
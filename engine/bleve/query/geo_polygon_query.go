@@ -0,0 +1,162 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/blevesearch/bleve/geo"
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/numeric"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/blevesearch/bleve/search/searcher"
+)
+
+type GeoPolygonQuery struct {
+	query.Query
+}
+
+func NewGeoPolygonQuery() *GeoPolygonQuery {
+	return &GeoPolygonQuery{}
+}
+
+/*
+{
+    "person.location" : {
+        "points" : [
+            { "lat" : 40, "lon" : -70 },
+            { "lat" : 30, "lon" : -80 },
+            { "lat" : 20, "lon" : -90 }
+        ]
+    }
+}
+*/
+func (q *GeoPolygonQuery) UnmarshalJSON(data []byte) error {
+	tmp := make(map[string]interface{})
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	pq := &geoPolygonQuery{}
+	for key, val := range tmp {
+		switch key {
+		case "boost":
+			boost, err := toFloat(val)
+			if err != nil {
+				return err
+			}
+			pq.SetBoost(boost)
+		case "validation_method", "ignore_unmapped", "_name":
+		default:
+			if pq.field != "" {
+				return errors.New("geo_polygon query supports only one field")
+			}
+			pq.field = key
+			obj, ok := val.(map[string]interface{})
+			if !ok {
+				return errors.New("invalid geo_polygon query")
+			}
+			points, ok := obj["points"].([]interface{})
+			if !ok {
+				return errors.New("geo_polygon query requires points")
+			}
+			for _, point := range points {
+				lon, lat, err := ParseGeoPoint(point)
+				if err != nil {
+					return err
+				}
+				pq.lons = append(pq.lons, lon)
+				pq.lats = append(pq.lats, lat)
+			}
+		}
+	}
+	if pq.field == "" {
+		return errors.New("geo_polygon query requires a field")
+	}
+	if len(pq.lons) < 3 {
+		return errors.New("geo_polygon query requires at least 3 points")
+	}
+	q.Query = pq
+	return nil
+}
+
+// geoPolygonQuery matches the geo points in the bounding box of the polygon, and filters out the points outside the polygon.
+type geoPolygonQuery struct {
+	field string
+	lons  []float64
+	lats  []float64
+	boost *query.Boost
+}
+
+func (q *geoPolygonQuery) SetBoost(b float64) {
+	boost := query.Boost(b)
+	q.boost = &boost
+}
+
+func (q *geoPolygonQuery) Boost() float64 {
+	return q.boost.Value()
+}
+
+func (q *geoPolygonQuery) SetField(f string) {
+	q.field = f
+}
+
+func (q *geoPolygonQuery) Field() string {
+	return q.field
+}
+
+func (q *geoPolygonQuery) Searcher(i index.IndexReader, m mapping.IndexMapping, options search.SearcherOptions) (search.Searcher, error) {
+	minLon, minLat, maxLon, maxLat := q.lons[0], q.lats[0], q.lons[0], q.lats[0]
+	for j := range q.lons {
+		if q.lons[j] < minLon {
+			minLon = q.lons[j]
+		}
+		if q.lons[j] > maxLon {
+			maxLon = q.lons[j]
+		}
+		if q.lats[j] < minLat {
+			minLat = q.lats[j]
+		}
+		if q.lats[j] > maxLat {
+			maxLat = q.lats[j]
+		}
+	}
+	s, err := searcher.NewGeoBoundingBoxSearcher(i, minLon, minLat, maxLon, maxLat, q.field, q.boost.Value(), options, true)
+	if err != nil {
+		return nil, err
+	}
+	return searcher.NewFilteringSearcher(s, func(d *search.DocumentMatch) bool {
+		var contains bool
+		err := i.DocumentVisitFieldTerms(d.IndexInternalID, []string{q.field}, func(field string, term []byte) {
+			// only the full precision terms are decoded
+			prefixCoded := numeric.PrefixCoded(term)
+			if shift, err := prefixCoded.Shift(); err != nil || shift != 0 {
+				return
+			}
+			if i64, err := prefixCoded.Int64(); err == nil && !contains {
+				contains = q.contains(geo.MortonUnhashLon(uint64(i64)), geo.MortonUnhashLat(uint64(i64)))
+			}
+		})
+		return err == nil && contains
+	}), nil
+}
+
+// contains reports whether the point is in the polygon by ray casting.
+func (q *geoPolygonQuery) contains(lon, lat float64) bool {
+	inside := false
+	for i, j := 0, len(q.lons)-1; i < len(q.lons); j, i = i, i+1 {
+		if (q.lats[i] > lat) != (q.lats[j] > lat) &&
+			lon < (q.lons[j]-q.lons[i])*(lat-q.lats[i])/(q.lats[j]-q.lats[i])+q.lons[i] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func (q *geoPolygonQuery) Validate() error {
+	if len(q.lons) < 3 {
+		return errors.New("geo_polygon query requires at least 3 points")
+	}
+	return nil
+}
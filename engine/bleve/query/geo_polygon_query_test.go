@@ -0,0 +1,29 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGeoPolygonQuery(t *testing.T) {
+	q := NewGeoPolygonQuery()
+	err := json.Unmarshal([]byte(`{ "person.location" : { "points" : [ { "lat" : 40, "lon" : -70 }, [-80, 30], "40, -90" ] }, "boost" : 2.0 }`), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pq := q.Query.(*geoPolygonQuery)
+	if pq.field != "person.location" || pq.Boost() != 2.0 {
+		t.Fatalf("parse failed %v", pq)
+	}
+	if !reflect.DeepEqual(pq.lons, []float64{-70, -80, -90}) || !reflect.DeepEqual(pq.lats, []float64{40, 30, 40}) {
+		t.Fatalf("invalid points %v %v", pq.lons, pq.lats)
+	}
+	if !pq.contains(-80, 35) || pq.contains(-80, 25) {
+		t.Fatal("invalid point in polygon")
+	}
+
+	if err = json.Unmarshal([]byte(`{ "person.location" : { "points" : [ [-70, 40], [-80, 30] ] } }`), NewGeoPolygonQuery()); err == nil {
+		t.Fatal("expect error of less than 3 points")
+	}
+}
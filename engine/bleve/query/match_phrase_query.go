@@ -0,0 +1,226 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/blevesearch/bleve/search/searcher"
+)
+
+const defaultMaxExpansions = 50
+
+type MatchPhraseQuery struct {
+	query.Query
+}
+
+func NewMatchPhraseQuery() *MatchPhraseQuery {
+	return &MatchPhraseQuery{}
+}
+
+type matchPhraseOptions struct {
+	Query         string `json:"query"`
+	Analyzer      string `json:"analyzer,omitempty"`
+	Slop          int    `json:"slop,omitempty"`
+	MaxExpansions *int   `json:"max_expansions,omitempty"`
+	Boost         *Boost `json:"boost,omitempty"`
+}
+
+// parseMatchPhrase parses { "field" : "text" } or { "field" : { "query" : "text", ... } }.
+func parseMatchPhrase(data []byte) (string, *matchPhraseOptions, error) {
+	tmp := make(map[string]json.RawMessage)
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(tmp) != 1 {
+		return "", nil, errors.New("match phrase query requires exactly one field")
+	}
+	for field, raw := range tmp {
+		opts := &matchPhraseOptions{}
+		if err = json.Unmarshal(raw, &opts.Query); err != nil {
+			if err = json.Unmarshal(raw, opts); err != nil {
+				return "", nil, err
+			}
+		}
+		if opts.Slop < 0 {
+			return "", nil, fmt.Errorf("invalid slop %d", opts.Slop)
+		}
+		return field, opts, nil
+	}
+	return "", nil, nil
+}
+
+/*
+{ "message" : "this is a test" }
+
+{ "message" : { "query" : "this is a test", "analyzer" : "standard", "slop" : 0, "boost" : 2.0 } }
+*/
+func (m *MatchPhraseQuery) UnmarshalJSON(data []byte) error {
+	field, opts, err := parseMatchPhrase(data)
+	if err != nil {
+		return err
+	}
+	q := newPhraseQuery(field, opts.Query, opts.Analyzer, opts.Slop)
+	if opts.Boost != nil {
+		q.(query.BoostableQuery).SetBoost(opts.Boost.Value())
+	}
+	m.Query = q
+	return nil
+}
+
+// newPhraseQuery creates a phrase query of the analyzed text. The phrase searcher has no slop,
+// so a sloppy phrase is approximated by requiring all of the terms.
+func newPhraseQuery(field, text, analyzer string, slop int) query.Query {
+	if slop > 0 {
+		q := query.NewMatchQuery(text)
+		q.SetField(field)
+		q.Analyzer = analyzer
+		q.SetOperator(query.MatchQueryOperatorAnd)
+		return q
+	}
+	q := query.NewMatchPhraseQuery(text)
+	q.SetField(field)
+	q.Analyzer = analyzer
+	return q
+}
+
+type MatchPhrasePrefixQuery struct {
+	query.Query
+}
+
+func NewMatchPhrasePrefixQuery() *MatchPhrasePrefixQuery {
+	return &MatchPhrasePrefixQuery{}
+}
+
+/*
+{ "message" : "quick brown f" }
+
+{ "message" : { "query" : "quick brown f", "max_expansions" : 10 } }
+*/
+func (m *MatchPhrasePrefixQuery) UnmarshalJSON(data []byte) error {
+	field, opts, err := parseMatchPhrase(data)
+	if err != nil {
+		return err
+	}
+	q := newPhrasePrefixQuery(field, opts.Query, opts.Analyzer)
+	if opts.MaxExpansions != nil {
+		if *opts.MaxExpansions <= 0 {
+			return fmt.Errorf("invalid max_expansions %d", *opts.MaxExpansions)
+		}
+		q.maxExpansions = *opts.MaxExpansions
+	}
+	if opts.Boost != nil {
+		q.SetBoost(opts.Boost.Value())
+	}
+	m.Query = q
+	return nil
+}
+
+// phrasePrefixQuery matches the phrase of the analyzed text, the last term is a prefix
+// which is expanded to at most maxExpansions terms of the field.
+type phrasePrefixQuery struct {
+	field         string
+	text          string
+	analyzer      string
+	maxExpansions int
+	boost         *query.Boost
+}
+
+func newPhrasePrefixQuery(field, text, analyzer string) *phrasePrefixQuery {
+	return &phrasePrefixQuery{field: field, text: text, analyzer: analyzer, maxExpansions: defaultMaxExpansions}
+}
+
+func (q *phrasePrefixQuery) SetBoost(b float64) {
+	boost := query.Boost(b)
+	q.boost = &boost
+}
+
+func (q *phrasePrefixQuery) Boost() float64 {
+	return q.boost.Value()
+}
+
+func (q *phrasePrefixQuery) SetField(f string) {
+	q.field = f
+}
+
+func (q *phrasePrefixQuery) Field() string {
+	return q.field
+}
+
+func (q *phrasePrefixQuery) Searcher(i index.IndexReader, m mapping.IndexMapping, options search.SearcherOptions) (search.Searcher, error) {
+	field := q.field
+	if field == "" {
+		field = m.DefaultSearchField()
+	}
+	analyzerName := q.analyzer
+	if analyzerName == "" {
+		analyzerName = m.AnalyzerNameForPath(field)
+	}
+	analyzer := m.AnalyzerNamed(analyzerName)
+	if analyzer == nil {
+		return nil, fmt.Errorf("no analyzer named '%s' registered", analyzerName)
+	}
+	tokens := analyzer.Analyze([]byte(q.text))
+	if len(tokens) == 0 {
+		return searcher.NewMatchNoneSearcher(i)
+	}
+
+	first, last := tokens[0].Position, tokens[0].Position
+	for _, token := range tokens {
+		if token.Position < first {
+			first = token.Position
+		}
+		if token.Position > last {
+			last = token.Position
+		}
+	}
+	phrase := make([][]string, last-first+1)
+	var prefix string
+	for _, token := range tokens {
+		if token.Position == last {
+			prefix = string(token.Term)
+			continue
+		}
+		pos := token.Position - first
+		phrase[pos] = append(phrase[pos], string(token.Term))
+	}
+
+	expansions, err := expandPrefix(i, field, prefix, q.maxExpansions)
+	if err != nil {
+		return nil, err
+	}
+	if len(expansions) == 0 {
+		return searcher.NewMatchNoneSearcher(i)
+	}
+	if len(phrase) == 1 {
+		return searcher.NewMultiTermSearcher(i, expansions, field, q.boost.Value(), options, true)
+	}
+	phrase[len(phrase)-1] = expansions
+	return searcher.NewMultiPhraseSearcher(i, phrase, field, options)
+}
+
+// expandPrefix returns at most max terms of the field starting with prefix.
+func expandPrefix(i index.IndexReader, field, prefix string, max int) ([]string, error) {
+	dict, err := i.FieldDictPrefix(field, []byte(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer dict.Close()
+	var terms []string
+	for len(terms) < max {
+		entry, err := dict.Next()
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			break
+		}
+		terms = append(terms, entry.Term)
+	}
+	return terms, nil
+}
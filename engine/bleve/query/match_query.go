@@ -41,10 +41,19 @@ func (m *MatchQuery)unmarshalJSON(data []byte) error {
 	for field, fv := range tmp {
 		switch fv.Type {
 		case "phrase_prefix":
-			return ErrNotSupportPhrasePrefix
+			q := newPhrasePrefixQuery(field, fv.Query, fv.Analyzer)
+			if fv.MaxExpansions != nil {
+				q.maxExpansions = *fv.MaxExpansions
+			}
+			if fv.Boost != nil {
+				q.SetBoost(fv.Boost.Value())
+			}
+			m.Query = q
+			return nil
 		case "phrase":
 			q := query.NewMatchPhraseQuery(fv.Query)
 			q.SetField(field)
+			q.Analyzer = fv.Analyzer
 			if fv.Boost != nil {
 				q.SetBoost(fv.Boost.Value())
 			}
@@ -1,3 +1,39 @@
 package query
 
-// TODO
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+type MissingQuery struct {
+	query.Query
+}
+
+func NewMissingQuery() *MissingQuery {
+	return &MissingQuery{}
+}
+
+/*
+{ "field" : "user" }
+*/
+func (m *MissingQuery) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Field string `json:"field"`
+		Boost *Boost `json:"boost,omitempty"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	if tmp.Field == "" {
+		return errors.New("missing query requires a field")
+	}
+	q := query.NewBooleanQuery(nil, nil, []query.Query{newExistsQuery(tmp.Field)})
+	if tmp.Boost != nil {
+		q.SetBoost(tmp.Boost.Value())
+	}
+	m.Query = q
+	return nil
+}
@@ -139,5 +139,113 @@ func ParseQuery(data []byte) (query.Query, error) {
 		}
 		return multiMatch, nil
 	}
+	rawMessage, hasGeoDistance := tmp["geo_distance"]
+	if hasGeoDistance {
+		geoDistance := NewGeoDistanceQuery()
+		err = json.Unmarshal(rawMessage, geoDistance)
+		if err != nil {
+			return nil, err
+		}
+		return geoDistance, nil
+	}
+	rawMessage, hasGeoBoundingBox := tmp["geo_bounding_box"]
+	if hasGeoBoundingBox {
+		geoBoundingBox := NewGeoBoundingBoxQuery()
+		err = json.Unmarshal(rawMessage, geoBoundingBox)
+		if err != nil {
+			return nil, err
+		}
+		return geoBoundingBox, nil
+	}
+	rawMessage, hasGeoPolygon := tmp["geo_polygon"]
+	if hasGeoPolygon {
+		geoPolygon := NewGeoPolygonQuery()
+		err = json.Unmarshal(rawMessage, geoPolygon)
+		if err != nil {
+			return nil, err
+		}
+		return geoPolygon, nil
+	}
+	rawMessage, hasIds := tmp["ids"]
+	if hasIds {
+		ids := NewIdsQuery()
+		err = json.Unmarshal(rawMessage, ids)
+		if err != nil {
+			return nil, err
+		}
+		return ids, nil
+	}
+	rawMessage, hasExists := tmp["exists"]
+	if hasExists {
+		exists := NewExistsQuery()
+		err = json.Unmarshal(rawMessage, exists)
+		if err != nil {
+			return nil, err
+		}
+		return exists, nil
+	}
+	rawMessage, hasMissing := tmp["missing"]
+	if hasMissing {
+		missing := NewMissingQuery()
+		err = json.Unmarshal(rawMessage, missing)
+		if err != nil {
+			return nil, err
+		}
+		return missing, nil
+	}
+	rawMessage, hasMatchPhrase := tmp["match_phrase"]
+	if hasMatchPhrase {
+		matchPhrase := NewMatchPhraseQuery()
+		err = json.Unmarshal(rawMessage, matchPhrase)
+		if err != nil {
+			return nil, err
+		}
+		return matchPhrase, nil
+	}
+	rawMessage, hasMatchPhrasePrefix := tmp["match_phrase_prefix"]
+	if hasMatchPhrasePrefix {
+		matchPhrasePrefix := NewMatchPhrasePrefixQuery()
+		err = json.Unmarshal(rawMessage, matchPhrasePrefix)
+		if err != nil {
+			return nil, err
+		}
+		return matchPhrasePrefix, nil
+	}
+	rawMessage, hasQueryString := tmp["query_string"]
+	if hasQueryString {
+		queryString := NewQueryStringQuery()
+		err = json.Unmarshal(rawMessage, queryString)
+		if err != nil {
+			return nil, err
+		}
+		return queryString, nil
+	}
+	rawMessage, hasSimpleQueryString := tmp["simple_query_string"]
+	if hasSimpleQueryString {
+		simpleQueryString := NewSimpleQueryStringQuery()
+		err = json.Unmarshal(rawMessage, simpleQueryString)
+		if err != nil {
+			return nil, err
+		}
+		return simpleQueryString, nil
+	}
+	rawMessage, hasFunctionScore := tmp["function_score"]
+	if hasFunctionScore {
+		functionScore := NewFunctionScoreQuery()
+		err = json.Unmarshal(rawMessage, functionScore)
+		if err != nil {
+			return nil, err
+		}
+		return functionScore, nil
+	}
+	rawMessage, hasBoosting := tmp["boosting"]
+	if hasBoosting {
+		boosting := NewBoostingQuery()
+		err = json.Unmarshal(rawMessage, boosting)
+		if err != nil {
+			return nil, err
+		}
+		return boosting, nil
+	}
 	return nil, errors.New("invalid query")
 }
@@ -0,0 +1,441 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+type qsTokenKind int
+
+const (
+	qsEOF qsTokenKind = iota
+	qsTerm
+	qsPhrase
+	qsRegexp
+	qsLParen
+	qsRParen
+	qsColon
+	qsPlus
+	qsMinus
+	qsAnd
+	qsOr
+	qsNot
+	qsRangeStart
+	qsRangeEnd
+	qsCaret
+	qsTilde
+	qsCompare
+)
+
+type qsToken struct {
+	kind qsTokenKind
+	// the unescaped text of terms, phrases and regexps, the number following ^ or ~, or the compare operator
+	text string
+	// the term has unescaped wildcards
+	wildcard bool
+	// the range bracket is [ or ]
+	inclusive bool
+}
+
+// the characters which end a term unless they are escaped
+const qsTermEnd = `()[]{}:^~"`
+
+// lexQueryString splits the lucene query syntax into tokens.
+func lexQueryString(input string) ([]qsToken, error) {
+	runes := []rune(input)
+	var tokens []qsToken
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		if unicode.IsSpace(c) {
+			i++
+			continue
+		}
+		next := rune(0)
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+		switch {
+		case c == '(':
+			tokens = append(tokens, qsToken{kind: qsLParen})
+			i++
+		case c == ')':
+			tokens = append(tokens, qsToken{kind: qsRParen})
+			i++
+		case c == ':':
+			tokens = append(tokens, qsToken{kind: qsColon})
+			i++
+		case c == '+':
+			tokens = append(tokens, qsToken{kind: qsPlus})
+			i++
+		case c == '-':
+			tokens = append(tokens, qsToken{kind: qsMinus})
+			i++
+		case c == '!':
+			tokens = append(tokens, qsToken{kind: qsNot, text: "!"})
+			i++
+		case c == '&' && next == '&':
+			tokens = append(tokens, qsToken{kind: qsAnd, text: "&&"})
+			i += 2
+		case c == '|' && next == '|':
+			tokens = append(tokens, qsToken{kind: qsOr, text: "||"})
+			i += 2
+		case c == '[' || c == '{':
+			tokens = append(tokens, qsToken{kind: qsRangeStart, inclusive: c == '['})
+			i++
+		case c == ']' || c == '}':
+			tokens = append(tokens, qsToken{kind: qsRangeEnd, inclusive: c == ']'})
+			i++
+		case c == '>' || c == '<':
+			op := string(c)
+			i++
+			if i < len(runes) && runes[i] == '=' {
+				op += "="
+				i++
+			}
+			tokens = append(tokens, qsToken{kind: qsCompare, text: op})
+		case c == '^' || c == '~':
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			kind := qsCaret
+			if c == '~' {
+				kind = qsTilde
+			}
+			tokens = append(tokens, qsToken{kind: kind, text: string(runes[i+1 : j])})
+			i = j
+		case c == '"' || c == '/':
+			text, j, err := lexQuoted(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			kind := qsPhrase
+			if c == '/' {
+				kind = qsRegexp
+			}
+			tokens = append(tokens, qsToken{kind: kind, text: text})
+			i = j
+		default:
+			tok, j := lexTerm(runes, i)
+			switch tok.text {
+			case "AND":
+				tok.kind = qsAnd
+			case "OR":
+				tok.kind = qsOr
+			case "NOT":
+				tok.kind = qsNot
+			}
+			tokens = append(tokens, tok)
+			i = j
+		}
+	}
+	return append(tokens, qsToken{kind: qsEOF}), nil
+}
+
+// lexQuoted reads the text enclosed by the quote at runes[start], and returns the position after the closing quote.
+func lexQuoted(runes []rune, start int) (string, int, error) {
+	quote := runes[start]
+	var text []rune
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				if quote == '/' {
+					// the escape is kept for the regexp
+					text = append(text, '\\')
+				}
+				text = append(text, runes[i])
+			}
+		case quote:
+			return string(text), i + 1, nil
+		default:
+			text = append(text, runes[i])
+		}
+	}
+	return "", 0, fmt.Errorf("missing closing %c in query string", quote)
+}
+
+func lexTerm(runes []rune, start int) (qsToken, int) {
+	tok := qsToken{kind: qsTerm}
+	var text []rune
+	i := start
+	for ; i < len(runes); i++ {
+		c := runes[i]
+		if c == '\\' {
+			if i+1 < len(runes) {
+				i++
+				text = append(text, runes[i])
+			}
+			continue
+		}
+		if unicode.IsSpace(c) || strings.ContainsRune(qsTermEnd, c) {
+			break
+		}
+		if c == '*' || c == '?' {
+			tok.wildcard = true
+		}
+		text = append(text, c)
+	}
+	tok.text = string(text)
+	return tok, i
+}
+
+const (
+	occurShould = iota
+	occurMust
+	occurMustNot
+)
+
+type qsClause struct {
+	query query.Query
+	occur int
+}
+
+type qsParser struct {
+	tokens []qsToken
+	pos    int
+	opts   *stringQueryOptions
+}
+
+// parseQueryString parses the lucene query syntax into a query.
+func parseQueryString(input string, opts *stringQueryOptions) (query.Query, error) {
+	tokens, err := lexQueryString(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &qsParser{tokens: tokens, opts: opts}
+	q, err := p.parseQuery("")
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.next(); tok.kind != qsEOF {
+		return nil, errors.New("unexpected ) in query string")
+	}
+	return q, nil
+}
+
+func (p *qsParser) peek() qsToken {
+	return p.tokens[p.pos]
+}
+
+func (p *qsParser) next() qsToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != qsEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// parseQuery parses clauses until the end of the query or group, field is the field of the enclosing group.
+func (p *qsParser) parseQuery(field string) (query.Query, error) {
+	var clauses []*qsClause
+	for {
+		tok := p.peek()
+		if tok.kind == qsEOF || tok.kind == qsRParen {
+			break
+		}
+		conj := qsEOF
+		if tok.kind == qsAnd || tok.kind == qsOr {
+			if len(clauses) == 0 {
+				return nil, fmt.Errorf("unexpected %s at the beginning of query string", tok.text)
+			}
+			conj = p.next().kind
+		}
+		mod := qsEOF
+		switch p.peek().kind {
+		case qsPlus, qsMinus, qsNot:
+			mod = p.next().kind
+		}
+		q, err := p.parseClause(field)
+		if err != nil {
+			return nil, err
+		}
+		clauses = p.addClause(clauses, conj, mod, q)
+	}
+	return buildClauses(clauses), nil
+}
+
+// addClause sets the occurrence of the clause the way the lucene query parser does:
+// AND makes both sides required, and OR makes both sides optional with the AND default operator.
+func (p *qsParser) addClause(clauses []*qsClause, conj, mod qsTokenKind, q query.Query) []*qsClause {
+	andOperator := p.opts.operator == query.MatchQueryOperatorAnd
+	if n := len(clauses); n > 0 {
+		last := clauses[n-1]
+		if conj == qsAnd && last.occur == occurShould {
+			last.occur = occurMust
+		}
+		if conj == qsOr && andOperator && last.occur == occurMust {
+			last.occur = occurShould
+		}
+	}
+	occur := occurShould
+	switch {
+	case mod == qsMinus || mod == qsNot:
+		occur = occurMustNot
+	case mod == qsPlus || conj == qsAnd:
+		occur = occurMust
+	case andOperator && conj != qsOr:
+		occur = occurMust
+	}
+	return append(clauses, &qsClause{query: q, occur: occur})
+}
+
+func buildClauses(clauses []*qsClause) query.Query {
+	if len(clauses) == 0 {
+		return query.NewMatchNoneQuery()
+	}
+	if len(clauses) == 1 && clauses[0].occur != occurMustNot {
+		return clauses[0].query
+	}
+	var must, should, mustNot []query.Query
+	for _, c := range clauses {
+		switch c.occur {
+		case occurMust:
+			must = append(must, c.query)
+		case occurMustNot:
+			mustNot = append(mustNot, c.query)
+		default:
+			should = append(should, c.query)
+		}
+	}
+	return query.NewBooleanQuery(must, should, mustNot)
+}
+
+func (p *qsParser) parseClause(field string) (query.Query, error) {
+	if tok := p.peek(); tok.kind == qsTerm && p.tokens[p.pos+1].kind == qsColon {
+		p.pos += 2
+		field = tok.text
+		if field == "_exists_" {
+			value := p.next()
+			if value.kind != qsTerm && value.kind != qsPhrase {
+				return nil, errors.New("_exists_ requires a field name")
+			}
+			return p.parseBoost(newExistsQuery(value.text))
+		}
+	}
+
+	if p.peek().kind == qsLParen {
+		p.next()
+		q, err := p.parseQuery(field)
+		if err != nil {
+			return nil, err
+		}
+		if p.next().kind != qsRParen {
+			return nil, errors.New("missing ) in query string")
+		}
+		return p.parseBoost(q)
+	}
+
+	q, err := p.parseTerm(field)
+	if err != nil {
+		return nil, err
+	}
+	return p.parseBoost(q)
+}
+
+func (p *qsParser) parseBoost(q query.Query) (query.Query, error) {
+	if p.peek().kind != qsCaret {
+		return q, nil
+	}
+	tok := p.next()
+	boost, err := strconv.ParseFloat(tok.text, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid boost ^%s", tok.text)
+	}
+	if bq, ok := q.(query.BoostableQuery); ok {
+		bq.SetBoost(boost)
+	}
+	return q, nil
+}
+
+func (p *qsParser) parseTerm(field string) (query.Query, error) {
+	tok := p.next()
+	switch tok.kind {
+	case qsTerm:
+		if p.peek().kind == qsTilde {
+			fuzziness, err := parseFuzziness(p.next().text, tok.text)
+			if err != nil {
+				return nil, err
+			}
+			return p.opts.fuzzyQuery(field, tok.text, fuzziness), nil
+		}
+		if tok.wildcard {
+			return p.opts.wildcardQuery(field, tok.text)
+		}
+		return p.opts.matchQuery(field, tok.text), nil
+
+	case qsPhrase:
+		slop := p.opts.phraseSlop
+		if p.peek().kind == qsTilde {
+			t := p.next()
+			if t.text != "" {
+				var err error
+				if slop, err = strconv.Atoi(t.text); err != nil {
+					return nil, fmt.Errorf("invalid phrase slop ~%s", t.text)
+				}
+			}
+		}
+		return p.opts.phraseQuery(field, tok.text, slop), nil
+
+	case qsRegexp:
+		return p.opts.fieldQuery(field, func(field string) query.Query {
+			q := query.NewRegexpQuery(tok.text)
+			q.SetField(field)
+			return q
+		}), nil
+
+	case qsRangeStart:
+		min := p.rangeBound(p.next())
+		if to := p.next(); to.kind != qsTerm || to.text != "TO" {
+			return nil, errors.New("missing TO in range of query string")
+		}
+		max := p.rangeBound(p.next())
+		end := p.next()
+		if end.kind != qsRangeEnd {
+			return nil, errors.New("missing ] or } in range of query string")
+		}
+		return p.rangeQuery(field, min, max, tok.inclusive, end.inclusive), nil
+
+	case qsCompare:
+		value := p.next()
+		if value.kind != qsTerm && value.kind != qsPhrase {
+			return nil, fmt.Errorf("missing value after %s in query string", tok.text)
+		}
+		bound := p.rangeBound(value)
+		inclusive := strings.HasSuffix(tok.text, "=")
+		if strings.HasPrefix(tok.text, ">") {
+			return p.rangeQuery(field, bound, nil, inclusive, false), nil
+		}
+		return p.rangeQuery(field, nil, bound, false, inclusive), nil
+
+	case qsEOF:
+		return nil, errors.New("unexpected end of query string")
+	default:
+		return nil, errors.New("invalid query string syntax")
+	}
+}
+
+// rangeBound returns the bound of a range, * is unbounded.
+func (p *qsParser) rangeBound(tok qsToken) *string {
+	if tok.kind == qsTerm && tok.wildcard && tok.text == "*" {
+		return nil
+	}
+	return &tok.text
+}
+
+func (p *qsParser) rangeQuery(field string, min, max *string, minInclusive, maxInclusive bool) query.Query {
+	if min == nil && max == nil {
+		return p.opts.fieldQuery(field, func(field string) query.Query {
+			return newExistsQuery(field)
+		})
+	}
+	return p.opts.fieldQuery(field, func(field string) query.Query {
+		return newStringRangeQuery(field, min, max, minInclusive, maxInclusive)
+	})
+}
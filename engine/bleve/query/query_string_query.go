@@ -0,0 +1,284 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+type QueryStringQuery struct {
+	query.Query
+}
+
+func NewQueryStringQuery() *QueryStringQuery {
+	return &QueryStringQuery{}
+}
+
+/*
+{
+    "query" : "title:\"foo bar\" AND price:[10 TO 20]",
+    "default_field" : "content",
+    "default_operator" : "AND"
+}
+
+{
+    "query" : "(content:this OR name:this) AND (content:that OR name:that)",
+    "fields" : ["content", "name^5"]
+}
+*/
+func (q *QueryStringQuery) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Query                string   `json:"query"`
+		DefaultField         string   `json:"default_field,omitempty"`
+		Fields               []string `json:"fields,omitempty"`
+		DefaultOperator      string   `json:"default_operator,omitempty"`
+		Analyzer             string   `json:"analyzer,omitempty"`
+		AllowLeadingWildcard *bool    `json:"allow_leading_wildcard,omitempty"`
+		PhraseSlop           int      `json:"phrase_slop,omitempty"`
+		Boost                *Boost   `json:"boost,omitempty"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	fields := tmp.Fields
+	if tmp.DefaultField != "" {
+		fields = append(fields, tmp.DefaultField)
+	}
+	opts, err := newStringQueryOptions(fields, tmp.DefaultOperator, tmp.Analyzer)
+	if err != nil {
+		return err
+	}
+	if tmp.AllowLeadingWildcard != nil {
+		opts.allowLeadingWildcard = *tmp.AllowLeadingWildcard
+	}
+	opts.phraseSlop = tmp.PhraseSlop
+
+	sq, err := parseQueryString(tmp.Query, opts)
+	if err != nil {
+		return err
+	}
+	if tmp.Boost != nil {
+		if bq, ok := sq.(query.BoostableQuery); ok {
+			bq.SetBoost(tmp.Boost.Value())
+		}
+	}
+	q.Query = sq
+	return nil
+}
+
+// stringQueryOptions are the options shared by query_string and simple_query_string.
+type stringQueryOptions struct {
+	// the fields searched by the terms without field, the default search field of the mapping is searched if it is empty
+	fields               []boostedField
+	operator             query.MatchQueryOperator
+	analyzer             string
+	allowLeadingWildcard bool
+	phraseSlop           int
+}
+
+type boostedField struct {
+	name  string
+	boost float64
+}
+
+func newStringQueryOptions(fields []string, operator, analyzer string) (*stringQueryOptions, error) {
+	opts := &stringQueryOptions{analyzer: analyzer, allowLeadingWildcard: true}
+	switch strings.ToLower(operator) {
+	case "", "or":
+		opts.operator = query.MatchQueryOperatorOr
+	case "and":
+		opts.operator = query.MatchQueryOperatorAnd
+	default:
+		return nil, fmt.Errorf("invalid default_operator %s", operator)
+	}
+	for _, field := range fields {
+		f := boostedField{name: field, boost: 1.0}
+		if i := strings.LastIndex(field, "^"); i >= 0 {
+			boost, err := strconv.ParseFloat(field[i+1:], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid boost of field %s", field)
+			}
+			f.name, f.boost = field[:i], boost
+		}
+		if f.name == "*" {
+			// all fields are searched through the default search field
+			continue
+		}
+		opts.fields = append(opts.fields, f)
+	}
+	return opts, nil
+}
+
+// fieldQuery creates the query of a term on the field, a term without field is searched on every default field.
+func (o *stringQueryOptions) fieldQuery(field string, newQuery func(field string) query.Query) query.Query {
+	if field != "" && field != "*" {
+		return newQuery(field)
+	}
+	if len(o.fields) == 0 {
+		return newQuery("")
+	}
+	queries := make([]query.Query, 0, len(o.fields))
+	for _, f := range o.fields {
+		q := newQuery(f.name)
+		if bq, ok := q.(query.BoostableQuery); ok && f.boost != 1.0 {
+			bq.SetBoost(bq.Boost() * f.boost)
+		}
+		queries = append(queries, q)
+	}
+	if len(queries) == 1 {
+		return queries[0]
+	}
+	return query.NewDisjunctionQuery(queries)
+}
+
+func (o *stringQueryOptions) matchQuery(field, text string) query.Query {
+	return o.fieldQuery(field, func(field string) query.Query {
+		q := query.NewMatchQuery(text)
+		q.SetField(field)
+		q.Analyzer = o.analyzer
+		q.SetOperator(o.operator)
+		return q
+	})
+}
+
+func (o *stringQueryOptions) phraseQuery(field, text string, slop int) query.Query {
+	return o.fieldQuery(field, func(field string) query.Query {
+		return newPhraseQuery(field, text, o.analyzer, slop)
+	})
+}
+
+func (o *stringQueryOptions) prefixQuery(field, prefix string) query.Query {
+	return o.fieldQuery(field, func(field string) query.Query {
+		q := query.NewPrefixQuery(prefix)
+		q.SetField(field)
+		return q
+	})
+}
+
+func (o *stringQueryOptions) fuzzyQuery(field, term string, fuzziness int) query.Query {
+	return o.fieldQuery(field, func(field string) query.Query {
+		q := query.NewFuzzyQuery(term)
+		q.SetField(field)
+		q.SetFuzziness(fuzziness)
+		return q
+	})
+}
+
+// wildcardQuery creates the query of a term with unescaped wildcards, a single * matches the documents having the field.
+func (o *stringQueryOptions) wildcardQuery(field, term string) (query.Query, error) {
+	if term == "*" {
+		if field == "" || field == "*" {
+			return query.NewMatchAllQuery(), nil
+		}
+		return newExistsQuery(field), nil
+	}
+	if !o.allowLeadingWildcard && strings.IndexAny(term[:1], "*?") == 0 {
+		return nil, fmt.Errorf("leading wildcard is not allowed: %s", term)
+	}
+	if strings.IndexAny(term, "*?") == len(term)-1 && term[len(term)-1] == '*' {
+		return o.prefixQuery(field, term[:len(term)-1]), nil
+	}
+	return o.fieldQuery(field, func(field string) query.Query {
+		q := query.NewWildcardQuery(term)
+		q.SetField(field)
+		return q
+	}), nil
+}
+
+// autoFuzziness is the number of edits allowed for the term by the AUTO fuzziness.
+func autoFuzziness(term string) int {
+	switch n := utf8.RuneCountInString(term); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// parseFuzziness parses the edit distance following ~, it is AUTO if there is no number.
+func parseFuzziness(s, term string) (int, error) {
+	if s == "" {
+		return autoFuzziness(term), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid fuzziness %s", s)
+	}
+	if f < 1 {
+		// the legacy similarity fuzziness
+		return autoFuzziness(term), nil
+	}
+	if f > 2 {
+		return 0, fmt.Errorf("fuzziness %s exceeds the max edit distance 2", s)
+	}
+	return int(f), nil
+}
+
+var rangeDateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+// newStringRangeQuery creates a numeric range if the bounds are numbers, a date range if they are dates,
+// or a term range otherwise. A nil bound is unbounded.
+func newStringRangeQuery(field string, min, max *string, minInclusive, maxInclusive bool) query.Query {
+	bounds := []*string{min, max}
+
+	numbers := make([]*float64, 2)
+	isNumber := true
+	for i, b := range bounds {
+		if b == nil {
+			continue
+		}
+		n, err := strconv.ParseFloat(*b, 64)
+		if err != nil {
+			isNumber = false
+			break
+		}
+		numbers[i] = &n
+	}
+	if isNumber {
+		q := query.NewNumericRangeInclusiveQuery(numbers[0], numbers[1], &minInclusive, &maxInclusive)
+		q.SetField(field)
+		return q
+	}
+
+	dates := make([]time.Time, 2)
+	isDate := true
+	for i, b := range bounds {
+		if b == nil {
+			continue
+		}
+		isDate = false
+		for _, layout := range rangeDateLayouts {
+			if t, err := time.Parse(layout, *b); err == nil {
+				dates[i], isDate = t, true
+				break
+			}
+		}
+		if !isDate {
+			break
+		}
+	}
+	if isDate {
+		q := query.NewDateRangeInclusiveQuery(dates[0], dates[1], &minInclusive, &maxInclusive)
+		q.SetField(field)
+		return q
+	}
+
+	var minTerm, maxTerm string
+	if min != nil {
+		minTerm = *min
+	}
+	if max != nil {
+		maxTerm = *max
+	}
+	q := query.NewTermRangeInclusiveQuery(minTerm, maxTerm, &minInclusive, &maxInclusive)
+	q.SetField(field)
+	return q
+}
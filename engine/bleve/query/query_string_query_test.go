@@ -0,0 +1,136 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+func newTestMatchQuery(field, text string, operator query.MatchQueryOperator) query.Query {
+	q := query.NewMatchQuery(text)
+	q.SetField(field)
+	q.SetOperator(operator)
+	return q
+}
+
+var time20180101 = time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestQueryStringQuery(t *testing.T) {
+	var or, and query.MatchQueryOperator = query.MatchQueryOperatorOr, query.MatchQueryOperatorAnd
+	min, max, inclusive, exclusive := 10.0, 20.0, true, false
+	groups := []QueryTestGroup{
+		{input: `{"query": "title:\"foo bar\" AND price:[10 TO 20}"}`,
+			output: func() query.Query {
+				phrase := query.NewMatchPhraseQuery("foo bar")
+				phrase.SetField("title")
+				price := query.NewNumericRangeInclusiveQuery(&min, &max, &inclusive, &exclusive)
+				price.SetField("price")
+				return &QueryStringQuery{query.NewBooleanQuery([]query.Query{phrase, price}, nil, nil)}
+			}()},
+		{input: `{"query": "foo -bar +baz", "default_field": "content"}`,
+			output: &QueryStringQuery{query.NewBooleanQuery(
+				[]query.Query{newTestMatchQuery("content", "baz", or)},
+				[]query.Query{newTestMatchQuery("content", "foo", or)},
+				[]query.Query{newTestMatchQuery("content", "bar", or)})}},
+		{input: `{"query": "foo OR bar", "default_operator": "AND"}`,
+			output: &QueryStringQuery{query.NewBooleanQuery(nil,
+				[]query.Query{newTestMatchQuery("", "foo", and), newTestMatchQuery("", "bar", and)}, nil)}},
+		{input: `{"query": "this", "fields": ["content", "name^5"]}`,
+			output: func() query.Query {
+				name := query.NewMatchQuery("this")
+				name.SetField("name")
+				name.SetBoost(5)
+				return &QueryStringQuery{query.NewDisjunctionQuery([]query.Query{newTestMatchQuery("content", "this", or), name})}
+			}()},
+		{input: `{"query": "user:ki* AND name:k?mchy AND _exists_:title"}`,
+			output: func() query.Query {
+				prefix := query.NewPrefixQuery("ki")
+				prefix.SetField("user")
+				wildcard := query.NewWildcardQuery("k?mchy")
+				wildcard.SetField("name")
+				return &QueryStringQuery{query.NewBooleanQuery([]query.Query{prefix, wildcard, newExistsQuery("title")}, nil, nil)}
+			}()},
+		{input: `{"query": "name:/joh?n(ath[oa]n)/^2"}`,
+			output: func() query.Query {
+				regexp := query.NewRegexpQuery("joh?n(ath[oa]n)")
+				regexp.SetField("name")
+				regexp.SetBoost(2)
+				return &QueryStringQuery{regexp}
+			}()},
+		{input: `{"query": "quikc~1 *:*"}`,
+			output: func() query.Query {
+				fuzzy := query.NewFuzzyQuery("quikc")
+				fuzzy.SetFuzziness(1)
+				return &QueryStringQuery{query.NewBooleanQuery(nil, []query.Query{fuzzy, query.NewMatchAllQuery()}, nil)}
+			}()},
+		{input: `{"query": "age:>=10 date:[2018-01-01 TO *] tag:[* TO *]"}`,
+			output: func() query.Query {
+				age := query.NewNumericRangeInclusiveQuery(&min, nil, &inclusive, &exclusive)
+				age.SetField("age")
+				date := query.NewDateRangeInclusiveQuery(time20180101, time.Time{}, &inclusive, &inclusive)
+				date.SetField("date")
+				return &QueryStringQuery{query.NewBooleanQuery(nil, []query.Query{age, date, newExistsQuery("tag")}, nil)}
+			}()},
+	}
+	for _, group := range groups {
+		q := NewQueryStringQuery()
+		if err := json.Unmarshal([]byte(group.input), q); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(q, group.output) {
+			t.Fatalf("parse failed %s %v %v", group.input, q, group.output)
+		}
+	}
+
+	for _, input := range []string{
+		`{"query": "title:(foo"}`,
+		`{"query": "foo)"}`,
+		`{"query": "AND foo"}`,
+		`{"query": "price:[10 20]"}`,
+		`{"query": "\"foo"}`,
+		`{"query": "*foo", "allow_leading_wildcard": false}`,
+		`{"query": "foo", "default_operator": "xor"}`,
+	} {
+		if err := json.Unmarshal([]byte(input), NewQueryStringQuery()); err == nil {
+			t.Fatalf("expect error of %s", input)
+		}
+	}
+}
+
+func TestSimpleQueryStringQuery(t *testing.T) {
+	var or query.MatchQueryOperator = query.MatchQueryOperatorOr
+	groups := []QueryTestGroup{
+		{input: `{"query": "\"fried eggs\" +(eggplant | potato) -frittata", "fields": ["body"]}`,
+			output: func() query.Query {
+				phrase := query.NewMatchPhraseQuery("fried eggs")
+				phrase.SetField("body")
+				group := query.NewDisjunctionQuery([]query.Query{newTestMatchQuery("body", "eggplant", or), newTestMatchQuery("body", "potato", or)})
+				not := query.NewBooleanQuery([]query.Query{query.NewMatchAllQuery()}, nil, []query.Query{newTestMatchQuery("body", "frittata", or)})
+				return &SimpleQueryStringQuery{query.NewDisjunctionQuery([]query.Query{query.NewConjunctionQuery([]query.Query{phrase, group}), not})}
+			}()},
+		{input: `{"query": "ki* quikc~1", "flags": "PREFIX|WHITESPACE"}`,
+			output: func() query.Query {
+				prefix := query.NewPrefixQuery("ki")
+				return &SimpleQueryStringQuery{query.NewDisjunctionQuery([]query.Query{prefix, newTestMatchQuery("", "quikc~1", or)})}
+			}()},
+		{input: `{"query": "foo\\+bar (baz", "flags": "ALL"}`,
+			output: &SimpleQueryStringQuery{query.NewDisjunctionQuery([]query.Query{newTestMatchQuery("", "foo+bar", or), newTestMatchQuery("", "baz", or)})}},
+		{input: `{"query": ""}`,
+			output: &SimpleQueryStringQuery{query.NewMatchNoneQuery()}},
+	}
+	for _, group := range groups {
+		q := NewSimpleQueryStringQuery()
+		if err := json.Unmarshal([]byte(group.input), q); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(q, group.output) {
+			t.Fatalf("parse failed %s %v %v", group.input, q, group.output)
+		}
+	}
+	if err := json.Unmarshal([]byte(`{"query": "foo", "flags": "UNKNOWN"}`), NewSimpleQueryStringQuery()); err == nil {
+		t.Fatal("expect error of unknown flag")
+	}
+}
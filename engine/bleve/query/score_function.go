@@ -0,0 +1,446 @@
+package query
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve/geo"
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/numeric"
+	"github.com/blevesearch/bleve/search"
+)
+
+// scoreFunction computes the score of a document for function_score.
+type scoreFunction interface {
+	score(i index.IndexReader, d *search.DocumentMatch) (float64, error)
+}
+
+// fieldTerms returns the full precision values of a numeric, date or geo point field of the document.
+// The values are the raw int64 which are floats for numeric fields, nanoseconds for dates and morton hashes for geo points.
+func fieldTerms(i index.IndexReader, id index.IndexInternalID, field string) ([]int64, error) {
+	var values []int64
+	err := i.DocumentVisitFieldTerms(id, []string{field}, func(field string, term []byte) {
+		prefixCoded := numeric.PrefixCoded(term)
+		if shift, err := prefixCoded.Shift(); err != nil || shift != 0 {
+			return
+		}
+		if i64, err := prefixCoded.Int64(); err == nil {
+			values = append(values, i64)
+		}
+	})
+	return values, err
+}
+
+var fieldValueModifiers = map[string]func(float64) float64{
+	"none":       func(v float64) float64 { return v },
+	"log":        math.Log10,
+	"log1p":      func(v float64) float64 { return math.Log10(v + 1) },
+	"log2p":      func(v float64) float64 { return math.Log10(v + 2) },
+	"ln":         math.Log,
+	"ln1p":       math.Log1p,
+	"ln2p":       func(v float64) float64 { return math.Log(v + 2) },
+	"square":     func(v float64) float64 { return v * v },
+	"sqrt":       math.Sqrt,
+	"reciprocal": func(v float64) float64 { return 1 / v },
+}
+
+// fieldValueFactor scores by modifier(factor * value) of a numeric field.
+type fieldValueFactor struct {
+	field    string
+	factor   float64
+	modifier string
+	missing  *float64
+}
+
+/*
+{
+    "field": "likes",
+    "factor": 1.2,
+    "modifier": "sqrt",
+    "missing": 1
+}
+*/
+func parseFieldValueFactor(data []byte) (*fieldValueFactor, error) {
+	tmp := struct {
+		Field    string   `json:"field"`
+		Factor   *float64 `json:"factor,omitempty"`
+		Modifier string   `json:"modifier,omitempty"`
+		Missing  *float64 `json:"missing,omitempty"`
+	}{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return nil, err
+	}
+	if tmp.Field == "" {
+		return nil, errors.New("field_value_factor requires a field")
+	}
+	f := &fieldValueFactor{field: tmp.Field, factor: 1.0, modifier: strings.ToLower(tmp.Modifier), missing: tmp.Missing}
+	if tmp.Factor != nil {
+		f.factor = *tmp.Factor
+	}
+	if f.modifier == "" {
+		f.modifier = "none"
+	}
+	if _, ok := fieldValueModifiers[f.modifier]; !ok {
+		return nil, fmt.Errorf("invalid field_value_factor modifier %s", tmp.Modifier)
+	}
+	return f, nil
+}
+
+func (f *fieldValueFactor) score(i index.IndexReader, d *search.DocumentMatch) (float64, error) {
+	values, err := fieldTerms(i, d.IndexInternalID, f.field)
+	if err != nil {
+		return 0, err
+	}
+	var value float64
+	switch {
+	case len(values) > 0:
+		value = numeric.Int64ToFloat64(values[0])
+	case f.missing != nil:
+		value = *f.missing
+	default:
+		return 0, fmt.Errorf("missing field %s for field_value_factor", f.field)
+	}
+	score := fieldValueModifiers[f.modifier](f.factor * value)
+	if score < 0 || math.IsNaN(score) || math.IsInf(score, 0) {
+		return 0, fmt.Errorf("field_value_factor of field %s results in the invalid score %v", f.field, score)
+	}
+	return score, nil
+}
+
+// randomScore scores documents uniformly in [0, 1), the scores are reproducible with the same seed.
+type randomScore struct {
+	seed  uint64
+	field string
+}
+
+/*
+{ "seed": 10, "field": "_seq_no" }
+*/
+func parseRandomScore(data []byte) (*randomScore, error) {
+	tmp := struct {
+		Seed  interface{} `json:"seed,omitempty"`
+		Field string      `json:"field,omitempty"`
+	}{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return nil, err
+	}
+	r := &randomScore{field: tmp.Field}
+	if tmp.Seed == nil {
+		r.seed = uint64(time.Now().UnixNano())
+		return r, nil
+	}
+	seed, err := toInt(tmp.Seed)
+	if err != nil {
+		// any string is a valid seed
+		h := fnv.New64a()
+		h.Write([]byte(fmt.Sprint(tmp.Seed)))
+		seed = int64(h.Sum64())
+	}
+	r.seed = uint64(seed)
+	return r, nil
+}
+
+func (r *randomScore) score(i index.IndexReader, d *search.DocumentMatch) (float64, error) {
+	h := fnv.New64a()
+	var seed [8]byte
+	binary.BigEndian.PutUint64(seed[:], r.seed)
+	h.Write(seed[:])
+	if r.field == "" {
+		h.Write(d.IndexInternalID)
+	} else {
+		values, err := fieldTerms(i, d.IndexInternalID, r.field)
+		if err != nil {
+			return 0, err
+		}
+		for _, v := range values {
+			binary.BigEndian.PutUint64(seed[:], uint64(v))
+			h.Write(seed[:])
+		}
+	}
+	// the fnv hash is mixed by the murmur3 finalizer to spread the close inputs
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return float64(x>>11) / (1 << 53), nil
+}
+
+const (
+	decayNumeric = iota
+	decayDate
+	decayGeo
+)
+
+// decayFunction scores by the distance of the field value from the origin,
+// the score is decay at distance offset+scale and 1 within the offset.
+type decayFunction struct {
+	field     string
+	kind      int
+	origin    float64
+	originLon float64
+	originLat float64
+	offset    float64
+	mode      string
+	// decay maps the distance beyond the offset to the score
+	decay func(distance float64) float64
+}
+
+/*
+{
+    "date": {
+        "origin": "2013-09-17",
+        "scale": "10d",
+        "offset": "5d",
+        "decay" : 0.5
+    },
+    "multi_value_mode": "avg"
+}
+*/
+func parseDecayFunction(name string, data []byte) (*decayFunction, error) {
+	tmp := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return nil, err
+	}
+	f := &decayFunction{mode: "min"}
+	if raw, ok := tmp["multi_value_mode"]; ok {
+		if err := json.Unmarshal(raw, &f.mode); err != nil {
+			return nil, err
+		}
+		f.mode = strings.ToLower(f.mode)
+		switch f.mode {
+		case "min", "max", "avg", "sum":
+		default:
+			return nil, fmt.Errorf("invalid multi_value_mode %s", f.mode)
+		}
+		delete(tmp, "multi_value_mode")
+	}
+	if len(tmp) != 1 {
+		return nil, fmt.Errorf("%s function requires exactly one field", name)
+	}
+	var params struct {
+		Origin interface{} `json:"origin,omitempty"`
+		Scale  interface{} `json:"scale"`
+		Offset interface{} `json:"offset,omitempty"`
+		Decay  *float64    `json:"decay,omitempty"`
+	}
+	for field, raw := range tmp {
+		f.field = field
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return nil, err
+		}
+	}
+	if params.Scale == nil {
+		return nil, fmt.Errorf("%s function requires a scale", name)
+	}
+	decay := 0.5
+	if params.Decay != nil {
+		decay = *params.Decay
+	}
+	if decay <= 0 || decay >= 1 {
+		return nil, fmt.Errorf("decay of %s function must be in (0, 1)", name)
+	}
+
+	var scale float64
+	var err error
+	f.kind = decayKind(params.Origin)
+	switch f.kind {
+	case decayNumeric:
+		if f.origin, err = toFloat(params.Origin); err != nil {
+			return nil, fmt.Errorf("invalid origin %v of %s function", params.Origin, name)
+		}
+		if scale, err = toFloat(params.Scale); err != nil {
+			return nil, fmt.Errorf("invalid scale %v of %s function", params.Scale, name)
+		}
+		if params.Offset != nil {
+			if f.offset, err = toFloat(params.Offset); err != nil {
+				return nil, fmt.Errorf("invalid offset %v of %s function", params.Offset, name)
+			}
+		}
+	case decayDate:
+		origin := time.Now()
+		if params.Origin != nil {
+			if origin, err = parseDateOrigin(params.Origin.(string)); err != nil {
+				return nil, err
+			}
+		}
+		f.origin = float64(origin.UnixNano())
+		d, err := parseTimeValue(params.Scale)
+		if err != nil {
+			return nil, err
+		}
+		scale = float64(d)
+		if params.Offset != nil {
+			if d, err = parseTimeValue(params.Offset); err != nil {
+				return nil, err
+			}
+			f.offset = float64(d)
+		}
+	case decayGeo:
+		if f.originLon, f.originLat, err = ParseGeoPoint(params.Origin); err != nil {
+			return nil, err
+		}
+		if scale, err = ParseDistance(params.Scale); err != nil {
+			return nil, err
+		}
+		if params.Offset != nil {
+			if f.offset, err = ParseDistance(params.Offset); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if scale <= 0 {
+		return nil, fmt.Errorf("scale of %s function must be positive", name)
+	}
+	if f.offset < 0 {
+		return nil, fmt.Errorf("offset of %s function must not be negative", name)
+	}
+
+	switch name {
+	case "gauss":
+		sigma2 := -scale * scale / (2 * math.Log(decay))
+		f.decay = func(d float64) float64 { return math.Exp(-d * d / (2 * sigma2)) }
+	case "exp":
+		lambda := math.Log(decay) / scale
+		f.decay = func(d float64) float64 { return math.Exp(lambda * d) }
+	case "linear":
+		s := scale / (1 - decay)
+		f.decay = func(d float64) float64 { return math.Max(0, (s-d)/s) }
+	default:
+		return nil, fmt.Errorf("unknown decay function %s", name)
+	}
+	return f, nil
+}
+
+// decayKind decides the field type by the origin: numbers are numeric, dates or no origin are dates,
+// and the other points are geo points.
+func decayKind(origin interface{}) int {
+	switch o := origin.(type) {
+	case nil:
+		return decayDate
+	case float64:
+		return decayNumeric
+	case string:
+		if _, err := parseDateOrigin(o); err == nil {
+			return decayDate
+		}
+		if _, err := strconv.ParseFloat(o, 64); err == nil {
+			return decayNumeric
+		}
+	}
+	return decayGeo
+}
+
+// parseDateOrigin parses a date or now with an optional offset like now-1d.
+func parseDateOrigin(s string) (time.Time, error) {
+	if strings.HasPrefix(s, "now") {
+		now := time.Now()
+		offset := strings.TrimPrefix(s, "now")
+		if offset == "" {
+			return now, nil
+		}
+		d, err := parseTimeValue(offset[1:])
+		if err != nil || (offset[0] != '+' && offset[0] != '-') {
+			return time.Time{}, fmt.Errorf("invalid date %s", s)
+		}
+		if offset[0] == '-' {
+			d = -d
+		}
+		return now.Add(d), nil
+	}
+	for _, layout := range rangeDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %s", s)
+}
+
+var timeUnits = []struct {
+	suffix string
+	unit   time.Duration
+}{
+	{"ms", time.Millisecond},
+	{"s", time.Second},
+	{"m", time.Minute},
+	{"h", time.Hour},
+	{"d", 24 * time.Hour},
+	{"w", 7 * 24 * time.Hour},
+}
+
+// parseTimeValue parses a time value like "10d" or "1.5h", a number is in milliseconds.
+func parseTimeValue(v interface{}) (time.Duration, error) {
+	s, ok := v.(string)
+	if !ok {
+		ms, err := toFloat(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid time value %v", v)
+		}
+		return time.Duration(ms * float64(time.Millisecond)), nil
+	}
+	for _, u := range timeUnits {
+		if !strings.HasSuffix(s, u.suffix) {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.TrimSuffix(s, u.suffix), 64)
+		if err != nil {
+			break
+		}
+		return time.Duration(n * float64(u.unit)), nil
+	}
+	return 0, fmt.Errorf("invalid time value %s", s)
+}
+
+func (f *decayFunction) distance(v int64) float64 {
+	var d float64
+	switch f.kind {
+	case decayNumeric:
+		d = math.Abs(numeric.Int64ToFloat64(v) - f.origin)
+	case decayDate:
+		d = math.Abs(float64(v) - f.origin)
+	case decayGeo:
+		d = geo.Haversin(f.originLon, f.originLat, geo.MortonUnhashLon(uint64(v)), geo.MortonUnhashLat(uint64(v))) * 1000
+	}
+	return math.Max(0, d-f.offset)
+}
+
+func (f *decayFunction) score(i index.IndexReader, d *search.DocumentMatch) (float64, error) {
+	values, err := fieldTerms(i, d.IndexInternalID, f.field)
+	if err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		// the documents without the field are not decayed
+		return 1, nil
+	}
+	distance := f.distance(values[0])
+	for _, v := range values[1:] {
+		switch dist := f.distance(v); f.mode {
+		case "min":
+			distance = math.Min(distance, dist)
+		case "max":
+			distance = math.Max(distance, dist)
+		default:
+			distance += dist
+		}
+	}
+	if f.mode == "avg" {
+		distance /= float64(len(values))
+	}
+	return f.decay(distance), nil
+}
+
+// weightFunction is the constant score of the functions with only a weight.
+type weightFunction struct{}
+
+func (weightFunction) score(i index.IndexReader, d *search.DocumentMatch) (float64, error) {
+	return 1, nil
+}
@@ -0,0 +1,300 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/blevesearch/bleve/search/query"
+)
+
+// the syntax features of simple_query_string enabled by flags
+const (
+	simpleFlagAnd = 1 << iota
+	simpleFlagNot
+	simpleFlagOr
+	simpleFlagPrefix
+	simpleFlagPhrase
+	simpleFlagPrecedence
+	simpleFlagEscape
+	simpleFlagWhitespace
+	simpleFlagFuzzy
+	simpleFlagNear
+
+	simpleFlagAll  = -1
+	simpleFlagNone = 0
+)
+
+var simpleFlags = map[string]int{
+	"ALL":        simpleFlagAll,
+	"NONE":       simpleFlagNone,
+	"AND":        simpleFlagAnd,
+	"NOT":        simpleFlagNot,
+	"OR":         simpleFlagOr,
+	"PREFIX":     simpleFlagPrefix,
+	"PHRASE":     simpleFlagPhrase,
+	"PRECEDENCE": simpleFlagPrecedence,
+	"ESCAPE":     simpleFlagEscape,
+	"WHITESPACE": simpleFlagWhitespace,
+	"FUZZY":      simpleFlagFuzzy,
+	"NEAR":       simpleFlagNear,
+	"SLOP":       simpleFlagNear,
+}
+
+// parseSimpleFlags parses flags like "OR|AND|PREFIX".
+func parseSimpleFlags(s string) (int, error) {
+	if s == "" {
+		return simpleFlagAll, nil
+	}
+	flags := 0
+	for _, name := range strings.Split(s, "|") {
+		flag, ok := simpleFlags[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return 0, fmt.Errorf("unknown simple_query_string flag %s", name)
+		}
+		flags |= flag
+	}
+	return flags, nil
+}
+
+type SimpleQueryStringQuery struct {
+	query.Query
+}
+
+func NewSimpleQueryStringQuery() *SimpleQueryStringQuery {
+	return &SimpleQueryStringQuery{}
+}
+
+/*
+{
+    "query": "\"fried eggs\" +(eggplant | potato) -frittata",
+    "fields": ["title^5", "body"],
+    "default_operator": "and",
+    "flags" : "OR|AND|PREFIX"
+}
+*/
+func (q *SimpleQueryStringQuery) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Query           string   `json:"query"`
+		Fields          []string `json:"fields,omitempty"`
+		DefaultOperator string   `json:"default_operator,omitempty"`
+		Analyzer        string   `json:"analyzer,omitempty"`
+		Flags           string   `json:"flags,omitempty"`
+		Boost           *Boost   `json:"boost,omitempty"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	opts, err := newStringQueryOptions(tmp.Fields, tmp.DefaultOperator, tmp.Analyzer)
+	if err != nil {
+		return err
+	}
+	flags, err := parseSimpleFlags(tmp.Flags)
+	if err != nil {
+		return err
+	}
+
+	sq := parseSimpleQueryString(tmp.Query, flags, opts)
+	if tmp.Boost != nil {
+		if bq, ok := sq.(query.BoostableQuery); ok {
+			bq.SetBoost(tmp.Boost.Value())
+		}
+	}
+	q.Query = sq
+	return nil
+}
+
+type simpleParser struct {
+	runes []rune
+	pos   int
+	flags int
+	opts  *stringQueryOptions
+}
+
+// parseSimpleQueryString parses the simple query string syntax, it never fails and the invalid syntax is searched as text.
+func parseSimpleQueryString(input string, flags int, opts *stringQueryOptions) query.Query {
+	p := &simpleParser{runes: []rune(input), flags: flags, opts: opts}
+	q := p.parse(0)
+	if q == nil {
+		return query.NewMatchNoneQuery()
+	}
+	return q
+}
+
+func (p *simpleParser) enabled(flag int) bool {
+	return p.flags&flag != 0
+}
+
+// parse combines the clauses from left to right until the end of the input or the group,
+// the operator between two clauses is the default operator unless + or | is given.
+func (p *simpleParser) parse(depth int) query.Query {
+	var current query.Query
+	and := p.opts.operator == query.MatchQueryOperatorAnd
+	not := false
+	for p.pos < len(p.runes) {
+		c := p.runes[p.pos]
+		var q query.Query
+		switch {
+		case p.enabled(simpleFlagWhitespace) && unicode.IsSpace(c):
+			p.pos++
+			continue
+		case p.enabled(simpleFlagAnd) && c == '+':
+			p.pos++
+			and = true
+			continue
+		case p.enabled(simpleFlagOr) && c == '|':
+			p.pos++
+			and = false
+			continue
+		case p.enabled(simpleFlagNot) && c == '-':
+			p.pos++
+			not = !not
+			continue
+		case p.enabled(simpleFlagPrecedence) && c == '(':
+			p.pos++
+			if q = p.parse(depth + 1); q == nil {
+				continue
+			}
+		case p.enabled(simpleFlagPrecedence) && c == ')':
+			p.pos++
+			if depth > 0 {
+				return current
+			}
+			continue
+		case p.enabled(simpleFlagPhrase) && c == '"':
+			q = p.parsePhrase()
+		default:
+			q = p.parseTerm()
+		}
+		if q == nil {
+			continue
+		}
+		if not {
+			q = query.NewBooleanQuery([]query.Query{query.NewMatchAllQuery()}, nil, []query.Query{q})
+		}
+		current = combineSimple(current, q, and)
+		and = p.opts.operator == query.MatchQueryOperatorAnd
+		not = false
+	}
+	return current
+}
+
+func combineSimple(current, q query.Query, and bool) query.Query {
+	if current == nil {
+		return q
+	}
+	if and {
+		if cq, ok := current.(*query.ConjunctionQuery); ok {
+			cq.AddQuery(q)
+			return cq
+		}
+		return query.NewConjunctionQuery([]query.Query{current, q})
+	}
+	if dq, ok := current.(*query.DisjunctionQuery); ok {
+		dq.AddQuery(q)
+		return dq
+	}
+	return query.NewDisjunctionQuery([]query.Query{current, q})
+}
+
+func (p *simpleParser) parsePhrase() query.Query {
+	p.pos++
+	var text []rune
+	for p.pos < len(p.runes) {
+		c := p.runes[p.pos]
+		p.pos++
+		if p.enabled(simpleFlagEscape) && c == '\\' && p.pos < len(p.runes) {
+			text = append(text, p.runes[p.pos])
+			p.pos++
+			continue
+		}
+		if c == '"' {
+			break
+		}
+		text = append(text, c)
+	}
+	slop := 0
+	if p.enabled(simpleFlagNear) {
+		if n, ok := p.parseTilde(); ok {
+			slop = n
+		}
+	}
+	if len(text) == 0 {
+		return nil
+	}
+	return p.opts.phraseQuery("", string(text), slop)
+}
+
+// parseTilde parses the number following ~, ok is false if there is no ~.
+func (p *simpleParser) parseTilde() (int, bool) {
+	if p.pos >= len(p.runes) || p.runes[p.pos] != '~' {
+		return 0, false
+	}
+	p.pos++
+	start := p.pos
+	for p.pos < len(p.runes) && unicode.IsDigit(p.runes[p.pos]) {
+		p.pos++
+	}
+	n, err := strconv.Atoi(string(p.runes[start:p.pos]))
+	if err != nil {
+		return -1, true
+	}
+	return n, true
+}
+
+// isTermEnd reports whether the character ends a term, the operators do it only if they are enabled.
+func (p *simpleParser) isTermEnd(c rune) bool {
+	switch {
+	case p.enabled(simpleFlagWhitespace) && unicode.IsSpace(c):
+		return true
+	case p.enabled(simpleFlagAnd) && c == '+',
+		p.enabled(simpleFlagOr) && c == '|',
+		p.enabled(simpleFlagPrecedence) && (c == '(' || c == ')'),
+		p.enabled(simpleFlagPhrase) && c == '"',
+		(p.enabled(simpleFlagFuzzy) || p.enabled(simpleFlagNear)) && c == '~':
+		return true
+	}
+	return false
+}
+
+func (p *simpleParser) parseTerm() query.Query {
+	var text []rune
+	for p.pos < len(p.runes) {
+		c := p.runes[p.pos]
+		if p.enabled(simpleFlagEscape) && c == '\\' && p.pos+1 < len(p.runes) {
+			text = append(text, p.runes[p.pos+1])
+			p.pos += 2
+			continue
+		}
+		if p.isTermEnd(c) {
+			break
+		}
+		text = append(text, c)
+		p.pos++
+	}
+	if p.enabled(simpleFlagFuzzy) {
+		if n, ok := p.parseTilde(); ok && len(text) > 0 {
+			term := string(text)
+			if n < 0 || n > 2 {
+				n = autoFuzziness(term)
+			}
+			return p.opts.fuzzyQuery("", term, n)
+		}
+	} else if p.enabled(simpleFlagNear) {
+		p.parseTilde()
+	}
+	if len(text) == 0 {
+		if p.pos < len(p.runes) && p.runes[p.pos] == '~' {
+			p.pos++
+		}
+		return nil
+	}
+	term := string(text)
+	if p.enabled(simpleFlagPrefix) && len(term) > 1 && strings.HasSuffix(term, "*") {
+		return p.opts.prefixQuery("", strings.TrimSuffix(term, "*"))
+	}
+	return p.opts.matchQuery("", term)
+}
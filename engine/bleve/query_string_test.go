@@ -0,0 +1,57 @@
+package bleve
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestQueryStringQuery(t *testing.T) {
+	b := memBleve(t, map[string]interface{}{
+		"a": map[string]interface{}{"title": "quick brown fox", "price": 10.0},
+		"b": map[string]interface{}{"title": "brown quick fox", "price": 15.0},
+		"c": map[string]interface{}{"title": "lazy brown dog", "price": 20.0},
+		"d": map[string]interface{}{"title": "quick rabbit", "price": 25.0},
+		"e": map[string]interface{}{"price": 30.0},
+	})
+	defer b.Close()
+
+	groups := []struct {
+		query string
+		ids   []string
+	}{
+		{`{"query_string": {"query": "title:\"quick brown\" AND price:[10 TO 20]"}}`, []string{"a"}},
+		{`{"query_string": {"query": "title:quick -title:fox"}}`, []string{"d"}},
+		{`{"query_string": {"query": "quick fox", "default_field": "title", "default_operator": "AND"}}`, []string{"a", "b"}},
+		{`{"query_string": {"query": "title:qu* OR price:>=30"}}`, []string{"a", "b", "d", "e"}},
+		{`{"query_string": {"query": "title:(lazy OR rabbit) AND price:{20 TO *]"}}`, []string{"d"}},
+		{`{"query_string": {"query": "_exists_:title AND NOT title:brown"}}`, []string{"d"}},
+		{`{"query_string": {"query": "title:quck~"}}`, []string{"a", "b", "d"}},
+		{`{"simple_query_string": {"query": "\"brown fox\" | rabbit", "fields": ["title"]}}`, []string{"a", "d"}},
+		{`{"simple_query_string": {"query": "brown -fox", "fields": ["title"], "default_operator": "and"}}`, []string{"c"}},
+		{`{"simple_query_string": {"query": "bro* +(dog | rabbit)", "fields": ["title"]}}`, []string{"c"}},
+		{`{"match_phrase": {"title": "brown fox"}}`, []string{"a"}},
+		{`{"match_phrase_prefix": {"title": "quick bro"}}`, []string{"a"}},
+		{`{"exists": {"field": "title"}}`, []string{"a", "b", "c", "d"}},
+		{`{"missing": {"field": "title"}}`, []string{"e"}},
+		{`{"ids": {"values": ["c", "e"]}}`, []string{"c", "e"}},
+	}
+	for _, group := range groups {
+		req := engine.NewSearchQuery("db", "space")
+		req.SetQuery([]byte(group.query))
+		req.SetSort([]byte(`"_id"`))
+		res, err := b.Search(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, hit := range res.Hits.Hits {
+			ids = append(ids, hit.Id)
+		}
+		if !reflect.DeepEqual(ids, group.ids) {
+			t.Fatalf("query %s, expect %v, got %v", group.query, group.ids, ids)
+		}
+	}
+}
@@ -3,17 +3,20 @@ package bleve
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 	"encoding/binary"
 
-	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/collector"
 	"github.com/tiglabs/baudengine/engine"
 	"github.com/tiglabs/baudengine/engine/bleve/query"
 	"github.com/blevesearch/bleve"
 )
 
 func(r *Bleve)GetApplyID() (uint64, error) {
+	r.indexLock.RLock()
+	defer r.indexLock.RUnlock()
 	v, err := r.index.GetInternal(RAFT_APPLY_ID)
 	if err != nil {
 		return 0, err
@@ -27,75 +30,142 @@ func(r *Bleve)GetApplyID() (uint64, error) {
 	return binary.BigEndian.Uint64(v), nil
 }
 
-func(r *Bleve)GetDocument(ctx context.Context, docID engine.DOC_ID) (engine.DOCUMENT, bool) {
+func(r *Bleve)GetDocument(ctx context.Context, docID engine.DOC_ID, req *engine.GetRequest) (*engine.GetResult, bool) {
+	r.indexLock.RLock()
+	defer r.indexLock.RUnlock()
 	_doc, err := r.index.Document(docID.ToString())
-	if err != nil {
+	if err != nil || _doc == nil {
 		// todo panic ???
 		return nil, false
 	}
-	doc := make(engine.DOCUMENT)
-	for _, field := range _doc.Fields {
-		switch f := field.(type) {
-		case *document.TextField:
-			doc[f.Name()] = string(f.Value())
-		case *document.BooleanField:
-			b, err := f.Boolean()
-			if err != nil {
-				// TODO panic ??
-				return nil, false
-			}
-			doc[f.Name()] = b
-		case *document.DateTimeField:
-			t, err := f.DateTime()
-			if err != nil {
-				return nil, false
-			}
-			doc[f.Name()] = t
-		case *document.GeoPointField:
-			lat, err := f.Lat()
-			if err != nil {
-				return nil, false
-			}
-			lon, err := f.Lon()
-			if err != nil {
-				return nil, false
-			}
-			doc[f.Name()] = fmt.Sprintf("%f, %f", lat, lon)
-		case *document.NumericField:
-			num, err := f.Number()
-			if err != nil {
-				return nil, false
-			}
-			doc[f.Name()] = num
-		default:
-			// todo panic ??
-			return nil, false
-		}
+	if req == nil {
+		req = &engine.GetRequest{}
+	}
+	doc := &engine.GetResult{Id: _doc.ID, DocVersion: docVersion(_doc)}
+	doc.Source, doc.Fields, err = loadDocument(_doc, req.Source, req.StoredFields)
+	if err != nil {
+		return nil, false
 	}
 	return doc, true
 }
 
 func(r *Bleve)Search(ctx context.Context, req *engine.SearchRequest)(*engine.SearchResult, error) {
+	r.indexLock.RLock()
+	defer r.indexLock.RUnlock()
+	i, _, err := r.index.Advanced()
+	if err != nil {
+		return nil, err
+	}
+	reader, err := i.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return r.search(ctx, reader, req)
+}
+
+// search runs the request on the view of the index given by reader.
+func (r *Bleve) search(ctx context.Context, reader index.IndexReader, req *engine.SearchRequest) (*engine.SearchResult, error) {
 	q, err := query.ParseQuery(req.Query)
 	if err != nil {
 		return nil, err
 	}
-	searchReq := bleve.NewSearchRequestOptions(q, req.Size, req.From, req.Explain)
-	if req.Explain {
-		searchReq.Explain = true
+	m := r.Mapping()
+	order := search.SortOrder{&search.SortScore{Desc: true}}
+	searchQuery := q
+	if len(req.Sort) > 0 {
+		order, err = ParseSort(req.Sort, fieldTypeFunc(m, req.Type))
+		if err != nil {
+			return nil, err
+		}
 	}
-	result, err := r.index.SearchInContext(ctx, searchReq)
+	if len(req.SearchAfter) > 0 {
+		if len(req.Sort) == 0 {
+			return nil, errors.New("search_after requires sort")
+		}
+		if req.From > 0 {
+			return nil, errors.New("from must be 0 when search_after is used")
+		}
+		searchQuery, err = newSearchAfterQuery(q, order, req.SearchAfter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	searcher, err := searchQuery.Searcher(reader, m, search.SearcherOptions{
+		Explain:            req.Explain,
+		IncludeTermVectors: req.Highlight != nil,
+	})
 	if err != nil {
 		return nil, err
 	}
+	defer searcher.Close()
+	coll := collector.NewTopNCollector(req.Size, req.From, order)
+	if err = coll.Collect(ctx, searcher, reader); err != nil {
+		return nil, err
+	}
+	result := &bleve.SearchResult{
+		Status:   &bleve.SearchStatus{Total: 1, Successful: 1},
+		Hits:     coll.Results(),
+		Total:    coll.Total(),
+		MaxScore: coll.MaxScore(),
+		Took:     coll.Took(),
+	}
+
 	res := bleveResultToBaudResult(req.Index, req.Type, result)
-	if int64(req.Timeout/time.Millisecond) < res.Took {
+	loadFields := fetchSource(req.Source, req.StoredFields) || len(req.StoredFields) > 0
+	for i, dm := range result.Hits {
+		hit := &res.Hits.Hits[i]
+		if len(req.Sort) > 0 {
+			hit.Sort = sortValues(order, dm)
+		}
+		if !loadFields && req.Highlight == nil && !req.Version && !req.SeqNoPrimaryTerm {
+			continue
+		}
+		doc, err := reader.Document(dm.ID)
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			continue
+		}
+		source, fields, err := loadDocument(doc, req.Source, req.StoredFields)
+		if err != nil {
+			return nil, err
+		}
+		if source != nil {
+			hit.Source = source
+		}
+		hit.Fields = fields
+		if req.Version || req.SeqNoPrimaryTerm {
+			version := docVersion(doc)
+			if req.Version {
+				hit.Version = version.Version
+			}
+			if req.SeqNoPrimaryTerm {
+				hit.SeqNo, hit.PrimaryTerm = version.SeqNo, version.PrimaryTerm
+			}
+		}
+		if req.Highlight != nil {
+			highlight(hit, dm, doc, req.Highlight)
+		}
+	}
+	if len(req.Aggregations) > 0 {
+		res.Aggregations, err = r.aggregate(ctx, reader, q, req.Type, req.Aggregations)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// a zero timeout means the search has no time limit
+	if req.Timeout > 0 && int64(req.Timeout/time.Millisecond) < res.Took {
 		res.TimeOut = true
 	}
 	return res, nil
 }
 
 func (r *Bleve)Close() error {
+	r.indexLock.Lock()
+	defer r.indexLock.Unlock()
 	return r.index.Close()
 }
 
@@ -115,7 +185,6 @@ func bleveResultToBaudResult(_index, _type string, r *bleve.SearchResult) *engin
 			Type: _type,
 			Id: doc.ID,
 			Score: doc.Score,
-			Source: doc.Fields,
 		}
 		hits =  append(hits, hit)
 	}
@@ -0,0 +1,27 @@
+package bleve
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestSearchTimeOut(t *testing.T) {
+	b := memBleve(t, map[string]interface{}{"a": map[string]interface{}{"price": 1.0}})
+	defer b.Close()
+
+	for _, timeout := range []time.Duration{0, time.Minute} {
+		req := engine.NewSearchQuery("db", "space")
+		req.SetQuery([]byte(`{"match_all": {}}`))
+		req.SetTimeout(timeout)
+		res, err := b.Search(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.TimeOut {
+			t.Fatalf("the search of timeout %v is timed out in %dms", timeout, res.Took)
+		}
+	}
+}
@@ -1,19 +1,18 @@
-package badgerdb
+package rocksdb
 
 import (
 	"bytes"
 	"sync"
 
-	"github.com/dgraph-io/badger"
 	"github.com/blevesearch/bleve/index/store"
+	"github.com/rubenv/gorocksdb"
 )
 
-var _ store.KVIterator  = &Iterator{}
+var _ store.KVIterator = &Iterator{}
 
 type Iterator struct {
 	close  sync.Once
-	tx     *badger.Txn
-	iter   *badger.Iterator
+	iter   *gorocksdb.Iterator
 	prefix []byte
 	start  []byte
 	end    []byte
@@ -33,6 +32,21 @@ func (i *Iterator) updateValid() {
 	}
 }
 
+// load copies the current key and value, the slices of rocksdb are freed when the iterator moves.
+func (i *Iterator) load() {
+	if i.iter.Valid() {
+		k, v := i.iter.Key(), i.iter.Value()
+		i.key = append([]byte(nil), k.Data()...)
+		i.val = append([]byte(nil), v.Data()...)
+		k.Free()
+		v.Free()
+	} else {
+		i.key = nil
+		i.val = nil
+	}
+	i.updateValid()
+}
+
 func (i *Iterator) Seek(k []byte) {
 	if i == nil {
 		return
@@ -48,42 +62,20 @@ func (i *Iterator) Seek(k []byte) {
 			return
 		}
 	}
-	var err error
-	i.iter.Seek(k)
-	if i.iter.Valid() {
-		item := i.iter.Item()
-		i.key = item.Key()
-		i.val, err = item.Value()
-		if err != nil {
-			i.key = nil
-			i.val = nil
-		}
+	if k == nil {
+		i.iter.SeekToFirst()
 	} else {
-		i.key = nil
-		i.val = nil
+		i.iter.Seek(k)
 	}
-	i.updateValid()
+	i.load()
 }
 
 func (i *Iterator) Next() {
 	if i == nil {
 		return
 	}
-	var err error
 	i.iter.Next()
-	if i.iter.Valid() {
-		item := i.iter.Item()
-		i.key = item.Key()
-		i.val, err = item.Value()
-		if err != nil {
-			i.key = nil
-			i.val = nil
-		}
-	} else {
-		i.key = nil
-		i.val = nil
-	}
-	i.updateValid()
+	i.load()
 }
 
 func (i *Iterator) Current() ([]byte, []byte, bool) {
@@ -122,10 +114,6 @@ func (i *Iterator) Close() error {
 		if i.iter != nil {
 			i.iter.Close()
 		}
-		if i.tx != nil {
-			i.tx.Discard()
-		}
 	})
-
 	return nil
 }
@@ -1,81 +1,61 @@
-package badgerdb
+package rocksdb
 
 import (
 	"github.com/blevesearch/bleve/index/store"
-	"github.com/dgraph-io/badger"
 	"github.com/rubenv/gorocksdb"
 )
 
 var _ store.KVReader = &Reader{}
 
+// Reader reads a snapshot of the db.
 type Reader struct {
-	tx   *gorocksdb.Snapshot
+	db       *gorocksdb.DB
+	snapshot *gorocksdb.Snapshot
+	ro       *gorocksdb.ReadOptions
 }
 
-func NewReader(tx *gorocksdb.Snapshot) *Reader {
-	return &Reader{tx: tx}
+func NewReader(db *gorocksdb.DB) *Reader {
+	snapshot := db.NewSnapshot()
+	ro := gorocksdb.NewDefaultReadOptions()
+	ro.SetSnapshot(snapshot)
+	return &Reader{db: db, snapshot: snapshot, ro: ro}
 }
 
-func (r *Reader)Get(key []byte) ([]byte, error) {
-	gorocksdb.
-	v, err := r.tx.(key)
-	if err == badger.ErrKeyNotFound {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, err
-	}
-	return v.ValueCopy([]byte(nil))
+func (r *Reader) Get(key []byte) ([]byte, error) {
+	return r.db.GetBytes(r.ro, key)
 }
 
 // MultiGet retrieves multiple values in one call.
-func (r *Reader)MultiGet(keys [][]byte) ([][]byte, error) {
-	var vs [][]byte = make([][]byte, 0, len(keys))
-	for _, key := range keys {
-		v, err := r.Get(key)
-		if err != nil {
-			return nil, err
-		}
-		vs = append(vs, v)
-	}
-	return vs, nil
+func (r *Reader) MultiGet(keys [][]byte) ([][]byte, error) {
+	return store.MultiGet(r, keys)
 }
 
 // PrefixIterator returns a KVIterator that will
 // visit all K/V pairs with the provided prefix
-func (r *Reader)PrefixIterator(prefix []byte) store.KVIterator {
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchSize = 10
-	it := r.tx.NewIterator(opts)
+func (r *Reader) PrefixIterator(prefix []byte) store.KVIterator {
 	rv := &Iterator{
-		tx:     r.tx,
-		iter:   it,
+		iter:   r.db.NewIterator(r.ro),
 		prefix: prefix,
 	}
-
 	rv.Seek(prefix)
 	return rv
 }
 
 // RangeIterator returns a KVIterator that will
 // visit all K/V pairs >= start AND < end
-func (r *Reader)RangeIterator(start, end []byte) store.KVIterator {
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchSize = 10
-	it := r.tx.NewIterator(opts)
+func (r *Reader) RangeIterator(start, end []byte) store.KVIterator {
 	rv := &Iterator{
-		tx:    r.tx,
-		iter:  it,
+		iter:  r.db.NewIterator(r.ro),
 		start: start,
 		end:   end,
 	}
-
 	rv.Seek(start)
 	return rv
 }
 
-// Close closes the iterator
-func (r *Reader)Close() error {
-	r.tx.Discard()
+// Close releases the snapshot
+func (r *Reader) Close() error {
+	r.ro.Destroy()
+	r.db.ReleaseSnapshot(r.snapshot)
 	return nil
-}
\ No newline at end of file
+}
@@ -1,16 +1,17 @@
-package badgerdb
+package rocksdb
 
 import (
-	"os"
 	"fmt"
+	"os"
 
 	"github.com/blevesearch/bleve/index/store"
 	"github.com/blevesearch/bleve/registry"
+	"github.com/tiglabs/baudengine/util"
 	"github.com/rubenv/gorocksdb"
 )
 
 const (
-	Name                    = "rocksdb"
+	Name = "rocksdb"
 )
 
 var _ store.KVStore = &Store{}
@@ -18,7 +19,9 @@ var _ store.KVStore = &Store{}
 type Store struct {
 	path string
 	db   *gorocksdb.DB
-	mo store.MergeOperator
+	opts *gorocksdb.Options
+	wo   *gorocksdb.WriteOptions
+	mo   store.MergeOperator
 }
 
 func New(mo store.MergeOperator, config map[string]interface{}) (store.KVStore, error) {
@@ -30,44 +33,60 @@ func New(mo store.MergeOperator, config map[string]interface{}) (store.KVStore,
 		return nil, os.ErrInvalid
 	}
 
-    ops := gorocksdb.NewDefaultOptions()
-    ops.SetCreateIfMissing(true)
-    var db *gorocksdb.DB
-    var err error
-    if config["read_only"].(bool) {
-    	db, err = gorocksdb.OpenDbForReadOnly(ops, path, true)
-    } else {
-	    db, err = gorocksdb.OpenDb(ops, path)
-    }
+	opts := gorocksdb.NewDefaultOptions()
+	opts.SetCreateIfMissing(true)
+	if size, ok := util.ConfigInt(config, "write_buffer_size"); ok {
+		opts.SetWriteBufferSize(int(size))
+	}
+	if size, ok := util.ConfigInt(config, "block_cache_size"); ok {
+		bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
+		bbto.SetBlockCache(gorocksdb.NewLRUCache(int(size)))
+		opts.SetBlockBasedTableFactory(bbto)
+	}
+
+	var db *gorocksdb.DB
+	var err error
+	if ro, _ := config["read_only"].(bool); ro {
+		db, err = gorocksdb.OpenDbForReadOnly(opts, path, false)
+	} else {
+		db, err = gorocksdb.OpenDb(opts, path)
+	}
 	if err != nil {
+		opts.Destroy()
 		return nil, err
 	}
+	wo := gorocksdb.NewDefaultWriteOptions()
+	sync, _ := config["sync"].(bool)
+	wo.SetSync(sync)
 	rv := Store{
 		path: path,
 		db:   db,
-		mo: mo,
+		opts: opts,
+		wo:   wo,
+		mo:   mo,
 	}
 	return &rv, nil
 }
 
-
 func (s *Store) Writer() (store.KVWriter, error) {
-	return NewWriter(s.db, s.mo), nil
+	return NewWriter(s.db, s.wo, s.mo), nil
 }
 
 // Reader returns a KVReader which can be used to
 // read data from the KVStore.  If a reader cannot
 // be obtained a non-nil error is returned.
-func(s *Store) Reader() (store.KVReader, error) {
-	tx := s.db.NewSnapshot()
-	return NewReader(tx), nil
+func (s *Store) Reader() (store.KVReader, error) {
+	return NewReader(s.db), nil
 }
 
-func (bs *Store) Close() error {
-	if bs == nil {
+func (s *Store) Close() error {
+	if s == nil {
 		return nil
 	}
-	return bs.db.Close()
+	s.db.Close()
+	s.wo.Destroy()
+	s.opts.Destroy()
+	return nil
 }
 
 func init() {
@@ -1,10 +1,9 @@
-package badgerdb
+package rocksdb
 
 import (
 	"fmt"
 
 	"github.com/blevesearch/bleve/index/store"
-	"github.com/dgraph-io/badger"
 	"github.com/rubenv/gorocksdb"
 )
 
@@ -12,11 +11,12 @@ var _ store.KVWriter = &Writer{}
 
 type Writer struct {
 	db *gorocksdb.DB
+	wo *gorocksdb.WriteOptions
 	mo store.MergeOperator
 }
 
-func NewWriter(db *gorocksdb.DB, mo store.MergeOperator) *Writer {
-	return &Writer{db: db, mo: mo}
+func NewWriter(db *gorocksdb.DB, wo *gorocksdb.WriteOptions, mo store.MergeOperator) *Writer {
+	return &Writer{db: db, wo: wo, mo: mo}
 }
 
 func (w *Writer) NewBatch() store.KVBatch {
@@ -27,73 +27,42 @@ func (w *Writer) NewBatchEx(options store.KVBatchOptions) ([]byte, store.KVBatch
 	return make([]byte, options.TotalBytes), w.NewBatch(), nil
 }
 
-func (w *Writer) ExecuteBatch(batch store.KVBatch) (err error) {
-
+// ExecuteBatch writes the batch atomically by a rocksdb write batch.
+func (w *Writer) ExecuteBatch(batch store.KVBatch) error {
 	emulatedBatch, ok := batch.(*store.EmulatedBatch)
 	if !ok {
 		return fmt.Errorf("wrong type of batch")
 	}
 
-	tx := w.db.Write(true)
-	// defer function to ensure that once started,
-	// we either Commit tx or Rollback
-	defer func() {
-		// if nothing went wrong, commit
-		if err == nil {
-			// careful to catch error here too
-			err = tx.Commit(nil)
-		} else {
-			// caller should see error that caused abort,
-			// not success or failure of Rollback itself
-			tx.Discard()
-		}
-	}()
-
-	for k, mergeOps := range emulatedBatch.Merger.Merges {
-		var existingVal []byte
-		kb := []byte(k)
-		existingVal, err = w.get(tx, kb)
-		if err != nil {
-			return
-		}
-		mergedVal, fullMergeOk := w.mo.FullMerge(kb, existingVal, mergeOps)
-		if !fullMergeOk {
-			err = fmt.Errorf("merge operator returned failure")
-			return
-		}
-		err = tx.Set(kb, mergedVal)
-		if err != nil {
-			return
+	wb := gorocksdb.NewWriteBatch()
+	defer wb.Destroy()
+	if len(emulatedBatch.Merger.Merges) > 0 {
+		ro := gorocksdb.NewDefaultReadOptions()
+		defer ro.Destroy()
+		for k, mergeOps := range emulatedBatch.Merger.Merges {
+			kb := []byte(k)
+			existingVal, err := w.db.GetBytes(ro, kb)
+			if err != nil {
+				return err
+			}
+			mergedVal, fullMergeOk := w.mo.FullMerge(kb, existingVal, mergeOps)
+			if !fullMergeOk {
+				return fmt.Errorf("merge operator returned failure")
+			}
+			wb.Put(kb, mergedVal)
 		}
 	}
 
 	for _, op := range emulatedBatch.Ops {
 		if op.V != nil {
-			err = tx.Set(op.K, op.V)
-			if err != nil {
-				return
-			}
+			wb.Put(op.K, op.V)
 		} else {
-			err = tx.Delete(op.K)
-			if err != nil {
-				return
-			}
+			wb.Delete(op.K)
 		}
 	}
-	return
-}
-
-func (w *Writer) get(tx *badger.Txn, key []byte) ([]byte, error) {
-	v, err := tx.Get(key)
-	if err == badger.ErrKeyNotFound {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, err
-	}
-	return v.ValueCopy([]byte(nil))
+	return w.db.Write(w.wo, wb)
 }
 
 func (w *Writer) Close() error {
 	return nil
-}
\ No newline at end of file
+}
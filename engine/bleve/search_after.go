@@ -0,0 +1,210 @@
+package bleve
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/numeric"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+)
+
+// searchAfterQuery wraps a query so that only the documents sorted after a cursor are matched.
+type searchAfterQuery struct {
+	query.Query
+	order search.SortOrder
+	after *search.DocumentMatch
+}
+
+// newSearchAfterQuery parses the sort values returned by a previous search into a cursor of order.
+func newSearchAfterQuery(q query.Query, order search.SortOrder, data []byte) (*searchAfterQuery, error) {
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	if len(values) != len(order) {
+		return nil, fmt.Errorf("search_after has %d values, but sort has %d keys", len(values), len(order))
+	}
+	after := &search.DocumentMatch{Sort: make([]string, len(order))}
+	for i, s := range order {
+		switch s := s.(type) {
+		case *search.SortScore:
+			score, ok := values[i].(float64)
+			if !ok {
+				return nil, errors.New("search_after value of _score must be a number")
+			}
+			after.Score = score
+			after.Sort[i] = "_score"
+		case *search.SortDocID:
+			id, ok := values[i].(string)
+			if !ok {
+				return nil, errors.New("search_after value of _id must be a string")
+			}
+			after.ID = id
+			after.Sort[i] = id
+		case *search.SortField:
+			term, err := sortFieldTerm(s, values[i])
+			if err != nil {
+				return nil, err
+			}
+			after.Sort[i] = term
+		case *search.SortGeoDistance:
+			switch v := values[i].(type) {
+			case nil:
+				after.Sort[i] = string(numeric.MustNewPrefixCodedInt64(math.MaxInt64, 0))
+			case float64:
+				after.Sort[i] = string(numeric.MustNewPrefixCodedInt64(numeric.Float64ToInt64(v), 0))
+			default:
+				return nil, errors.New("search_after value of _geo_distance must be a number")
+			}
+		default:
+			return nil, errors.New("search_after is not supported by the sort")
+		}
+	}
+	return &searchAfterQuery{Query: q, order: order, after: after}, nil
+}
+
+// sortFieldTerm is the reverse of sortFieldValue.
+func sortFieldTerm(s *search.SortField, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		if (s.Missing == search.SortFieldMissingLast) != s.Desc {
+			return search.HighTerm, nil
+		}
+		return search.LowTerm, nil
+	case string:
+		if s.Type != search.SortFieldAsDate {
+			return v, nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return "", fmt.Errorf("invalid search_after date %s", v)
+		}
+		return string(numeric.MustNewPrefixCodedInt64(t.UnixNano(), 0)), nil
+	case float64:
+		switch s.Type {
+		case search.SortFieldAsString:
+			return "", fmt.Errorf("search_after value of %s must be a string", s.Field)
+		case search.SortFieldAsDate:
+			return string(numeric.MustNewPrefixCodedInt64(int64(v)*int64(time.Millisecond), 0)), nil
+		}
+		return string(numeric.MustNewPrefixCodedInt64(numeric.Float64ToInt64(v), 0)), nil
+	case bool:
+		if v {
+			return "T", nil
+		}
+		return "F", nil
+	default:
+		return "", fmt.Errorf("invalid search_after value %v", value)
+	}
+}
+
+func (q *searchAfterQuery) Searcher(i index.IndexReader, m mapping.IndexMapping, options search.SearcherOptions) (search.Searcher, error) {
+	s, err := q.Query.Searcher(i, m, options)
+	if err != nil {
+		return nil, err
+	}
+	order := q.order.Copy()
+	scoring := make([]bool, len(order))
+	for x, so := range order {
+		scoring[x] = so.RequiresScoring()
+	}
+	return &searchAfterSearcher{
+		Searcher: s,
+		reader:   i,
+		order:    order,
+		scoring:  scoring,
+		fields:   order.RequiredFields(),
+		after:    q.after,
+	}, nil
+}
+
+func (q *searchAfterQuery) Validate() error {
+	if vq, ok := q.Query.(query.ValidatableQuery); ok {
+		return vq.Validate()
+	}
+	return nil
+}
+
+// searchAfterSearcher skips the documents which are not sorted after the cursor.
+// The sort keys are computed with a copy of the sort order, the collector computes them again for the kept documents.
+type searchAfterSearcher struct {
+	search.Searcher
+	reader  index.IndexReader
+	order   search.SortOrder
+	scoring []bool
+	fields  []string
+	after   *search.DocumentMatch
+}
+
+func (s *searchAfterSearcher) Next(ctx *search.SearchContext) (*search.DocumentMatch, error) {
+	for {
+		dm, err := s.Searcher.Next(ctx)
+		if err != nil || dm == nil {
+			return dm, err
+		}
+		if ok, err := s.isAfter(dm); err != nil || ok {
+			return dm, err
+		}
+		ctx.DocumentMatchPool.Put(dm)
+	}
+}
+
+func (s *searchAfterSearcher) Advance(ctx *search.SearchContext, ID index.IndexInternalID) (*search.DocumentMatch, error) {
+	dm, err := s.Searcher.Advance(ctx, ID)
+	if err != nil || dm == nil {
+		return dm, err
+	}
+	if ok, err := s.isAfter(dm); err != nil || ok {
+		return dm, err
+	}
+	ctx.DocumentMatchPool.Put(dm)
+	return s.Next(ctx)
+}
+
+func (s *searchAfterSearcher) isAfter(dm *search.DocumentMatch) (bool, error) {
+	if s.order.RequiresDocID() && dm.ID == "" {
+		id, err := s.reader.ExternalID(dm.IndexInternalID)
+		if err != nil {
+			return false, err
+		}
+		dm.ID = id
+	}
+	if len(s.fields) > 0 {
+		err := s.reader.DocumentVisitFieldTerms(dm.IndexInternalID, s.fields, s.order.UpdateVisitor)
+		if err != nil {
+			return false, err
+		}
+	}
+	keys := make([]string, len(s.order))
+	for x, so := range s.order {
+		keys[x] = so.Value(dm)
+	}
+	// documents equal to the cursor on all keys were returned by the previous page
+	for x, so := range s.order {
+		c := 0
+		if s.scoring[x] {
+			if dm.Score < s.after.Score {
+				c = -1
+			} else if dm.Score > s.after.Score {
+				c = 1
+			}
+		} else {
+			c = strings.Compare(keys[x], s.after.Sort[x])
+		}
+		if c == 0 {
+			continue
+		}
+		if so.Descending() {
+			c = -c
+		}
+		return c > 0, nil
+	}
+	return false, nil
+}
@@ -1,10 +1,13 @@
 package bleve
 
 import (
+	"context"
 	"errors"
 	"encoding/binary"
 
+	"github.com/blevesearch/bleve/index"
 	"github.com/blevesearch/bleve/index/store"
+	"github.com/blevesearch/bleve/index/upsidedown"
 	"github.com/tiglabs/baudengine/engine"
 )
 
@@ -12,13 +15,21 @@ var _ engine.Snapshot = &Snapshot{}
 
 type Snapshot struct {
 	reader     store.KVReader
+	// indexReader is the point in time view searched by Search
+	indexReader index.IndexReader
+	bleve      *Bleve
 }
 
+// GetApplyID reads the apply ID of the snapshot, it is kept in the internal keys of the index by the write batches.
+// It is read by the kv reader of the pairs streamed by NewIterator, so that the apply ID and the data are of one point in time.
 func (ds *Snapshot)GetApplyID() (uint64, error) {
-	v, err := ds.reader.Get(RAFT_APPLY_ID)
+	v, err := ds.reader.Get(upsidedown.NewInternalRow(RAFT_APPLY_ID, nil).Key())
 	if err != nil {
 		return 0, err
 	}
+	if len(v) == 0 {
+		return 0, nil
+	}
 	if len(v) != 8 {
 		return 0, errors.New("invalid raft apply ID value")
 	}
@@ -31,8 +42,16 @@ func (ds *Snapshot)NewIterator() engine.Iterator {
 	return &Iterator{iter: iter, filters: []Filter{&RaftFilter{}}}
 }
 
+func (ds *Snapshot)Search(ctx context.Context, req *engine.SearchRequest) (*engine.SearchResult, error) {
+	return ds.bleve.search(ctx, ds.indexReader, req)
+}
+
 func (ds *Snapshot)Close() error {
-	return ds.reader.Close()
+	err := ds.indexReader.Close()
+	if rerr := ds.reader.Close(); err == nil {
+		err = rerr
+	}
+	return err
 }
 
 type RaftFilter struct {}
@@ -0,0 +1,114 @@
+package bleve
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestSnapshotSearch(t *testing.T) {
+	b := memBleve(t, nil)
+	defer b.Close()
+
+	ctx := context.Background()
+	for _, id := range []string{"a", "b"} {
+		if err := b.AddDocument(ctx, engine.DOC_ID(id), []byte(`{"price": 1}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	snap, err := b.NewSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snap.Close()
+	if err = b.AddDocument(ctx, engine.DOC_ID("c"), []byte(`{"price": 1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = b.DeleteDocument(ctx, engine.DOC_ID("a")); err != nil {
+		t.Fatal(err)
+	}
+
+	req := engine.NewSearchQuery("db", "space")
+	req.SetQuery([]byte(`{"match_all": {}}`))
+	req.SetSize(1)
+	if err = req.AddSortTieBreaker(); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for {
+		res, err := snap.Search(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Hits.Hits) == 0 {
+			break
+		}
+		hit := res.Hits.Hits[0]
+		ids = append(ids, hit.Id)
+		after, _ := json.Marshal(hit.Sort)
+		req.SetSearchAfter(after)
+	}
+	if len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Fatalf("snapshot should not see later writes, got %v", ids)
+	}
+}
+
+func TestSnapshotApplyID(t *testing.T) {
+	schema := `{"mappings": {"baud": {"properties": {"name": {"type": "string"}}}}}`
+	newMemory := func() engine.Engine {
+		e, err := New(engine.EngineConfig{Schema: schema, ExtraOptions: `{"store": "memory"}`})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	src, dst := newMemory(), newMemory()
+	defer src.Close()
+	defer dst.Close()
+
+	ctx := context.Background()
+	if err := src.AddDocument(ctx, engine.DOC_ID("doc1"), map[string]interface{}{"name": "baud"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.SetApplyID(10); err != nil {
+		t.Fatal(err)
+	}
+	// a batch is committed after the search reader of the snapshot is opened but before its kv reader
+	defer func(f func()) { snapshotReaderOpened = f }(snapshotReaderOpened)
+	snapshotReaderOpened = func() {
+		batch := src.NewWriteBatch()
+		if err := batch.AddDocument(ctx, engine.DOC_ID("doc2"), map[string]interface{}{"name": "baud"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := batch.SetApplyID(11); err != nil {
+			t.Fatal(err)
+		}
+		if err := batch.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	snap, err := src.NewSnapshot()
+	snapshotReaderOpened = func() {}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snap.Close()
+
+	if id, err := snap.GetApplyID(); err != nil || id != 11 {
+		t.Fatalf("invalid apply id %d of the snapshot: %v", id, err)
+	}
+	iter := snap.NewIterator()
+	err = dst.ApplySnapshot(ctx, iter)
+	iter.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := dst.GetDocument(ctx, engine.DOC_ID("doc2"), nil); !found {
+		t.Fatal("the document of the apply id is not in the snapshot")
+	}
+	if id, err := dst.GetApplyID(); err != nil || id != 11 {
+		t.Fatalf("invalid apply id %d after applying the snapshot: %v", id, err)
+	}
+}
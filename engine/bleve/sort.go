@@ -0,0 +1,248 @@
+package bleve
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/blevesearch/bleve/numeric"
+	"github.com/blevesearch/bleve/search"
+	"github.com/tiglabs/baudengine/engine/bleve/aggregation"
+	"github.com/tiglabs/baudengine/engine/bleve/query"
+)
+
+/*
+"sort" : [
+    { "post_date" : { "order" : "asc", "missing" : "_first", "mode" : "min" } },
+    { "price" : "desc" },
+    "user",
+    "_score",
+    "_id",
+    { "_geo_distance" : { "pin.location" : [-70, 40], "order" : "asc", "unit" : "km" } }
+]
+*/
+func ParseSort(data []byte, fieldType aggregation.FieldTypeFunc) (search.SortOrder, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		// a single sort key is allowed without array
+		items = []json.RawMessage{data}
+	}
+	order := make(search.SortOrder, 0, len(items))
+	for _, item := range items {
+		var field string
+		if err := json.Unmarshal(item, &field); err == nil {
+			order = append(order, newSearchSort(field, field == "_score", fieldType))
+			continue
+		}
+		tmp := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(item, &tmp); err != nil {
+			return nil, fmt.Errorf("invalid sort %s", item)
+		}
+		for field, data := range tmp {
+			s, err := parseSortField(field, data, fieldType)
+			if err != nil {
+				return nil, err
+			}
+			order = append(order, s)
+		}
+	}
+	if len(order) == 0 {
+		return nil, errors.New("empty sort")
+	}
+	return order, nil
+}
+
+func parseSortField(field string, data []byte, fieldType aggregation.FieldTypeFunc) (search.SearchSort, error) {
+	if field == "_geo_distance" {
+		return parseGeoDistanceSort(data)
+	}
+	opts := struct {
+		Order   string `json:"order"`
+		Missing string `json:"missing"`
+		Mode    string `json:"mode"`
+	}{}
+	if err := json.Unmarshal(data, &opts.Order); err != nil {
+		if err = json.Unmarshal(data, &opts); err != nil {
+			return nil, fmt.Errorf("invalid sort of field %s", field)
+		}
+	}
+	var desc bool
+	switch opts.Order {
+	case "asc":
+	case "desc":
+		desc = true
+	case "":
+		desc = field == "_score"
+	default:
+		return nil, fmt.Errorf("invalid sort order %s", opts.Order)
+	}
+	s := newSearchSort(field, desc, fieldType)
+	sf, ok := s.(*search.SortField)
+	if !ok {
+		return s, nil
+	}
+	switch opts.Missing {
+	case "", "_last":
+	case "_first":
+		sf.Missing = search.SortFieldMissingFirst
+	default:
+		return nil, fmt.Errorf("invalid missing %s, only _first and _last are supported", opts.Missing)
+	}
+	switch opts.Mode {
+	case "":
+	case "min":
+		sf.Mode = search.SortFieldMin
+	case "max":
+		sf.Mode = search.SortFieldMax
+	default:
+		return nil, fmt.Errorf("invalid sort mode %s", opts.Mode)
+	}
+	return sf, nil
+}
+
+// parseGeoDistanceSort parses the sort by the distance of a geo point field from a location, the distance is in meters by default.
+func parseGeoDistanceSort(data []byte) (search.SearchSort, error) {
+	tmp := make(map[string]interface{})
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return nil, errors.New("invalid _geo_distance sort")
+	}
+	var field, order, unit string
+	var location interface{}
+	for key, val := range tmp {
+		switch key {
+		case "order", "unit", "mode":
+			s, ok := val.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid _geo_distance sort %s", key)
+			}
+			switch key {
+			case "order":
+				order = s
+			case "unit":
+				unit = s
+			case "mode":
+				// the distance of a document is computed from its first point
+				if s != "min" {
+					return nil, fmt.Errorf("unsupported _geo_distance sort mode %s", s)
+				}
+			}
+		case "distance_type", "ignore_unmapped":
+		default:
+			if field != "" {
+				return nil, errors.New("_geo_distance sort supports only one field")
+			}
+			field, location = key, val
+		}
+	}
+	if field == "" {
+		return nil, errors.New("_geo_distance sort requires a field")
+	}
+	// an array of points is a single location unless its items are points themselves
+	if points, ok := location.([]interface{}); ok && len(points) > 0 {
+		switch points[0].(type) {
+		case string, map[string]interface{}, []interface{}:
+			if len(points) > 1 {
+				return nil, errors.New("_geo_distance sort supports only one location")
+			}
+			location = points[0]
+		}
+	}
+	lon, lat, err := query.ParseGeoPoint(location)
+	if err != nil {
+		return nil, err
+	}
+	if unit != "" {
+		if unit, err = query.ParseDistanceUnit(unit); err != nil {
+			return nil, err
+		}
+	}
+	var desc bool
+	switch order {
+	case "", "asc":
+	case "desc":
+		desc = true
+	default:
+		return nil, fmt.Errorf("invalid sort order %s", order)
+	}
+	return search.NewSortGeoDistance(field, unit, lon, lat, desc)
+}
+
+// newSearchSort creates the sort of a field, multi valued fields are sorted by the min value in ascending order
+// and by the max value in descending order.
+func newSearchSort(field string, desc bool, fieldType aggregation.FieldTypeFunc) search.SearchSort {
+	switch field {
+	case "_score":
+		return &search.SortScore{Desc: desc}
+	case "_id", "_uid":
+		return &search.SortDocID{Desc: desc}
+	}
+	sf := &search.SortField{Field: field, Desc: desc, Mode: search.SortFieldMin, Missing: search.SortFieldMissingLast}
+	if desc {
+		sf.Mode = search.SortFieldMax
+	}
+	switch fieldType(field) {
+	case aggregation.FieldNumber:
+		sf.Type = search.SortFieldAsNumber
+	case aggregation.FieldDateTime:
+		sf.Type = search.SortFieldAsDate
+	case aggregation.FieldText, aggregation.FieldBoolean:
+		sf.Type = search.SortFieldAsString
+	}
+	return sf
+}
+
+// sortValues converts the sort keys of a hit into json values, numbers are decoded,
+// dates are given in epoch milliseconds and missing values are nil.
+func sortValues(order search.SortOrder, doc *search.DocumentMatch) []interface{} {
+	values := make([]interface{}, len(order))
+	for i, s := range order {
+		if i >= len(doc.Sort) {
+			break
+		}
+		switch s := s.(type) {
+		case *search.SortScore:
+			values[i] = doc.Score
+		case *search.SortDocID:
+			values[i] = doc.ID
+		case *search.SortField:
+			values[i] = sortFieldValue(s, doc.Sort[i])
+		case *search.SortGeoDistance:
+			values[i] = geoDistanceValue(doc.Sort[i])
+		default:
+			values[i] = doc.Sort[i]
+		}
+	}
+	return values
+}
+
+func sortFieldValue(s *search.SortField, term string) interface{} {
+	if term == search.HighTerm || term == search.LowTerm {
+		return nil
+	}
+	if s.Type == search.SortFieldAsString {
+		return term
+	}
+	valid, shift := numeric.ValidPrefixCodedTerm(term)
+	if !valid || shift != 0 {
+		return term
+	}
+	i64, err := numeric.PrefixCoded(term).Int64()
+	if err != nil {
+		return term
+	}
+	if s.Type == search.SortFieldAsDate {
+		return i64 / int64(time.Millisecond)
+	}
+	return numeric.Int64ToFloat64(i64)
+}
+
+// geoDistanceValue decodes the sort key of a geo distance, the documents without a geo point have no distance.
+func geoDistanceValue(term string) interface{} {
+	i64, err := numeric.PrefixCoded(term).Int64()
+	if err != nil || i64 == math.MaxInt64 {
+		return nil
+	}
+	return numeric.Int64ToFloat64(i64)
+}
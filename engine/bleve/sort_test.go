@@ -0,0 +1,112 @@
+package bleve
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func memBleve(t *testing.T, docs map[string]interface{}) *Bleve {
+	dm := mapping.NewDocumentStaticMapping()
+	dm.AddFieldMappingsAt("price", mapping.NewNumericFieldMapping())
+	dm.AddFieldMappingsAt("date", mapping.NewDateTimeFieldMapping())
+	dm.AddFieldMappingsAt("location", mapping.NewGeoPointFieldMapping())
+	dm.AddFieldMappingsAt("title", mapping.NewTextFieldMapping())
+	im := bleve.NewIndexMapping()
+	im.DefaultMapping = dm
+	index, err := bleve.NewMemOnly(im)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, doc := range docs {
+		if err = index.Index(id, doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &Bleve{mapping: im, index: index}
+}
+
+func searchIDs(t *testing.T, b *Bleve, sort, after string, size int) ([]string, []engine.HitDoc) {
+	req := engine.NewSearchQuery("db", "space")
+	req.SetQuery([]byte(`{"match_all": {}}`))
+	req.SetSize(size)
+	req.SetSort([]byte(sort))
+	if after != "" {
+		req.SetSearchAfter([]byte(after))
+	}
+	res, err := b.Search(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, hit := range res.Hits.Hits {
+		ids = append(ids, hit.Id)
+	}
+	return ids, res.Hits.Hits
+}
+
+func TestSortAndSearchAfter(t *testing.T) {
+	b := memBleve(t, map[string]interface{}{
+		"a": map[string]interface{}{"price": 30.0, "date": "2018-01-01T00:00:00Z"},
+		"b": map[string]interface{}{"price": 10.0, "date": "2018-01-03T00:00:00Z"},
+		"c": map[string]interface{}{"price": 20.0, "date": "2018-01-02T00:00:00Z"},
+		"d": map[string]interface{}{"price": 20.0},
+	})
+	defer b.Close()
+
+	ids, hits := searchIDs(t, b, `[{"price": "desc"}, "_id"]`, "", 10)
+	if !reflect.DeepEqual(ids, []string{"a", "c", "d", "b"}) {
+		t.Fatalf("invalid order %v", ids)
+	}
+	if !reflect.DeepEqual(hits[1].Sort, []interface{}{20.0, "c"}) {
+		t.Fatalf("invalid sort values %v", hits[1].Sort)
+	}
+
+	ids, hits = searchIDs(t, b, `{"date": {"order": "asc", "missing": "_first"}}`, "", 2)
+	if !reflect.DeepEqual(ids, []string{"d", "a"}) || hits[0].Sort[0] != nil {
+		t.Fatalf("invalid order %v %v", ids, hits[0].Sort)
+	}
+	after, _ := json.Marshal(hits[1].Sort)
+	ids, _ = searchIDs(t, b, `{"date": {"order": "asc", "missing": "_first"}}`, string(after), 2)
+	if !reflect.DeepEqual(ids, []string{"c", "b"}) {
+		t.Fatalf("invalid page after %s: %v", after, ids)
+	}
+
+	// paging through ties relies on the _id tie breaker
+	var pages []string
+	after = nil
+	for i := 0; i < 4; i++ {
+		ids, hits = searchIDs(t, b, `["price", "_id"]`, string(after), 1)
+		if len(ids) != 1 {
+			t.Fatalf("page %d is empty", i)
+		}
+		pages = append(pages, ids[0])
+		after, _ = json.Marshal(hits[0].Sort)
+	}
+	if !reflect.DeepEqual(pages, []string{"b", "c", "d", "a"}) {
+		t.Fatalf("invalid pages %v", pages)
+	}
+	if ids, _ = searchIDs(t, b, `["price", "_id"]`, string(after), 1); len(ids) != 0 {
+		t.Fatalf("expect no more hits, got %v", ids)
+	}
+}
+
+func TestParseSortError(t *testing.T) {
+	inputs := []string{
+		`[{"price": "up"}]`,
+		`[{"price": {"missing": 0}}]`,
+		`[{"price": {"mode": "avg"}}]`,
+		`[]`,
+	}
+	fieldType := fieldTypeFunc(nil, "")
+	for _, input := range inputs {
+		if _, err := ParseSort([]byte(input), fieldType); err == nil {
+			t.Fatalf("expect error for %s", input)
+		}
+	}
+}
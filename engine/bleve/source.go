@@ -0,0 +1,160 @@
+package bleve
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/blevesearch/bleve/document"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+// SourceField is the stored field holding the original json of a document.
+const SourceField = "_source"
+
+// the stored fields holding the version of a document
+const (
+	VersionField     = "_version"
+	SeqNoField       = "_seq_no"
+	PrimaryTermField = "_primary_term"
+)
+
+// docSource returns the original json of doc and the decoded value to be mapped,
+// doc is either raw json or a value which is marshaled into json.
+func docSource(doc interface{}) ([]byte, interface{}, error) {
+	var source []byte
+	switch d := doc.(type) {
+	case []byte:
+		source = d
+	case json.RawMessage:
+		source = d
+	case *engine.VersionedDocument:
+		source = d.Source
+	default:
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		return data, doc, nil
+	}
+	var data interface{}
+	if err := json.Unmarshal(source, &data); err != nil {
+		return nil, nil, err
+	}
+	return source, data, nil
+}
+
+// fetchSource reports whether _source is returned, it is not returned by default if stored fields are selected.
+func fetchSource(source *engine.FetchSource, storedFields []string) bool {
+	if source != nil {
+		return source.Fetch
+	}
+	return len(storedFields) == 0
+}
+
+// matchFields reports whether the stored field is selected by the stored_fields patterns.
+func matchFields(patterns []string, field string) bool {
+	for _, pattern := range patterns {
+		if pattern != "_none_" && engine.WildcardMatch(pattern, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadDocument returns the filtered _source and the selected stored fields of doc, every stored field is an array of values.
+func loadDocument(doc *document.Document, source *engine.FetchSource, storedFields []string) (json.RawMessage, engine.DOCUMENT, error) {
+	var src json.RawMessage
+	var fields engine.DOCUMENT
+	for _, field := range doc.Fields {
+		switch field.Name() {
+		case VersionField, SeqNoField, PrimaryTermField:
+			continue
+		}
+		if field.Name() == SourceField {
+			if fetchSource(source, storedFields) {
+				filtered, err := source.Filter(field.Value())
+				if err != nil {
+					return nil, nil, err
+				}
+				src = filtered
+			}
+			continue
+		}
+		if !matchFields(storedFields, field.Name()) {
+			continue
+		}
+		value, err := storedFieldValue(field)
+		if err != nil {
+			return nil, nil, err
+		}
+		if value == nil {
+			continue
+		}
+		if fields == nil {
+			fields = make(engine.DOCUMENT)
+		}
+		values, _ := fields[field.Name()].([]interface{})
+		fields[field.Name()] = append(values, value)
+	}
+	return src, fields, nil
+}
+
+// addVersionFields stores the version of the document, the fields are not indexed.
+func addVersionFields(doc *document.Document, version engine.DocVersion) {
+	for _, f := range []struct {
+		name  string
+		value uint64
+	}{{VersionField, version.Version}, {SeqNoField, version.SeqNo}, {PrimaryTermField, version.PrimaryTerm}} {
+		if f.value > 0 {
+			doc.AddField(document.NewNumericFieldWithIndexingOptions(f.name, nil, float64(f.value), document.StoreField))
+		}
+	}
+}
+
+// docVersion returns the stored version of the document, it is zero for documents written without a version.
+func docVersion(doc *document.Document) (version engine.DocVersion) {
+	for _, field := range doc.Fields {
+		var value *uint64
+		switch field.Name() {
+		case VersionField:
+			value = &version.Version
+		case SeqNoField:
+			value = &version.SeqNo
+		case PrimaryTermField:
+			value = &version.PrimaryTerm
+		default:
+			continue
+		}
+		if f, ok := field.(*document.NumericField); ok {
+			if n, err := f.Number(); err == nil {
+				*value = uint64(n)
+			}
+		}
+	}
+	return
+}
+
+func storedFieldValue(field document.Field) (interface{}, error) {
+	switch f := field.(type) {
+	case *document.TextField:
+		return string(f.Value()), nil
+	case *document.BooleanField:
+		return f.Boolean()
+	case *document.DateTimeField:
+		return f.DateTime()
+	case *document.GeoPointField:
+		lat, err := f.Lat()
+		if err != nil {
+			return nil, err
+		}
+		lon, err := f.Lon()
+		if err != nil {
+			return nil, err
+		}
+		return fmt.Sprintf("%f, %f", lat, lon), nil
+	case *document.NumericField:
+		return f.Number()
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,95 @@
+package bleve
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestSource(t *testing.T) {
+	dm := mapping.NewDocumentStaticMapping()
+	name := mapping.NewTextFieldMapping()
+	name.Store = true
+	dm.AddFieldMappingsAt("name", name)
+	im := bleve.NewIndexMapping()
+	im.DefaultMapping = dm
+	index, err := bleve.NewMemOnly(im)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &Bleve{mapping: im, index: index}
+	defer b.Close()
+
+	source := `{"name": ["baud", "engine"], "location": {"lat": 1.5, "lon": 2.5}, "big": 12345678901234567890}`
+	if err = b.AddDocument(context.Background(), engine.DOC_ID("1"), []byte(source)); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, found := b.GetDocument(context.Background(), engine.DOC_ID("1"), nil)
+	if !found || string(doc.Source) != source {
+		t.Fatalf("source is not returned verbatim: %s", doc.Source)
+	}
+	fetch, _ := engine.ParseFetchSource([]byte(`"location.lat"`))
+	doc, found = b.GetDocument(context.Background(), engine.DOC_ID("1"), &engine.GetRequest{Source: fetch, StoredFields: []string{"name"}})
+	if !found || string(doc.Source) != `{"location":{"lat":1.5}}` {
+		t.Fatalf("invalid filtered source: %s", doc.Source)
+	}
+	if !reflect.DeepEqual(doc.Fields["name"], []interface{}{"baud", "engine"}) {
+		t.Fatalf("invalid stored fields: %v", doc.Fields)
+	}
+
+	req := engine.NewSearchQuery("db", "space")
+	req.SetQuery([]byte(`{"match_all": {}}`))
+	res, err := b.Search(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw, ok := res.Hits.Hits[0].Source.(json.RawMessage); !ok || string(raw) != source {
+		t.Fatalf("invalid hit source: %v", res.Hits.Hits[0].Source)
+	}
+
+	req.SetStoredFields([]string{"na*"})
+	res, err = b.Search(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hit := res.Hits.Hits[0]
+	if hit.Source != nil || !reflect.DeepEqual(hit.Fields["name"], []interface{}{"baud", "engine"}) {
+		t.Fatalf("invalid hit fields: %v %v", hit.Source, hit.Fields)
+	}
+}
+
+func TestDocumentVersion(t *testing.T) {
+	b := memBleve(t, nil)
+	defer b.Close()
+
+	version := engine.DocVersion{Version: 3, SeqNo: 42, PrimaryTerm: 2}
+	doc := &engine.VersionedDocument{Source: []byte(`{"price": 1}`), DocVersion: version}
+	if err := b.AddDocument(context.Background(), engine.DOC_ID("1"), doc); err != nil {
+		t.Fatal(err)
+	}
+
+	res, found := b.GetDocument(context.Background(), engine.DOC_ID("1"), &engine.GetRequest{StoredFields: []string{"*"}})
+	if !found || res.DocVersion != version {
+		t.Fatalf("invalid document version: %v", res)
+	}
+	if _, ok := res.Fields[VersionField]; ok {
+		t.Fatalf("version is returned as a stored field: %v", res.Fields)
+	}
+
+	req := engine.NewSearchQuery("db", "space")
+	req.SetQuery([]byte(`{"match_all": {}}`))
+	req.SetSeqNoPrimaryTerm(true)
+	result, err := b.Search(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hit := result.Hits.Hits[0]; hit.Version != 0 || hit.SeqNo != 42 || hit.PrimaryTerm != 2 {
+		t.Fatalf("invalid hit version: %v", hit.DocVersion)
+	}
+}
@@ -0,0 +1,6 @@
+// +build rocksdb
+
+package bleve
+
+// the rocksdb store needs cgo and the rocksdb library, so it is only built with -tags rocksdb
+import _ "github.com/tiglabs/baudengine/engine/bleve/rocksdb"
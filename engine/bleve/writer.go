@@ -3,15 +3,17 @@ package bleve
 import (
 	"context"
 	"encoding/binary"
+	"encoding/json"
 
 	"github.com/blevesearch/bleve"
 	"github.com/blevesearch/bleve/index"
 	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/mapping"
 	"github.com/tiglabs/baudengine/engine"
 )
 
 func(w *Bleve) SetApplyID(applyID uint64) (err error) {
-	batch := NewBatch(w.index)
+	batch := NewBatch(w)
 	defer func() {
 		if err == nil {
 			err = batch.Commit()
@@ -25,7 +27,7 @@ func(w *Bleve) SetApplyID(applyID uint64) (err error) {
 }
 
 func (w *Bleve)AddDocument(ctx context.Context, docID engine.DOC_ID, doc interface{}) (err error) {
-	batch := NewBatch(w.index)
+	batch := NewBatch(w)
 	defer func() {
 		if err == nil {
 			err = batch.Commit()
@@ -38,7 +40,7 @@ func (w *Bleve)AddDocument(ctx context.Context, docID engine.DOC_ID, doc interfa
 }
 
 func(w *Bleve) UpdateDocument(ctx context.Context, docID engine.DOC_ID, doc interface{}, upsert bool) (found bool, err error) {
-	batch := NewBatch(w.index)
+	batch := NewBatch(w)
 	defer func() {
 		if err == nil {
 			err = batch.Commit()
@@ -50,7 +52,7 @@ func(w *Bleve) UpdateDocument(ctx context.Context, docID engine.DOC_ID, doc inte
 }
 
 func(w *Bleve) DeleteDocument(ctx context.Context, docID engine.DOC_ID) (count int, err error) {
-	batch := NewBatch(w.index)
+	batch := NewBatch(w)
 	defer func() {
 		if err == nil {
 			err = batch.Commit()
@@ -63,13 +65,48 @@ func(w *Bleve) DeleteDocument(ctx context.Context, docID engine.DOC_ID) (count i
 
 var _ engine.Batch = &Batch{}
 
+// Batch reads and commits by the current index of the engine, so that it is not left with an index replaced by a snapshot.
 type Batch struct {
-	index bleve.Index
+	db    *Bleve
 	batch *bleve.Batch
+	// mapping has the fields added by the dynamic templates in the batch, it becomes the engine mapping on commit.
+	mapping *IndexMapping
 }
 
-func NewBatch(index bleve.Index) *Batch {
-	return &Batch{index: index, batch: index.NewBatch()}
+func NewBatch(db *Bleve) *Batch {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+	return &Batch{db: db, batch: db.index.NewBatch()}
+}
+
+// Mapping returns the mapping of the documents in the batch.
+func (b *Batch) Mapping() mapping.IndexMapping {
+	if b.mapping != nil {
+		return b.mapping
+	}
+	return b.db.Mapping()
+}
+
+// addDynamicFields adds the fields the dynamic templates create for the document to the batch mapping.
+func (b *Batch) addDynamicFields(data interface{}) error {
+	m, ok := b.Mapping().(*IndexMapping)
+	if !ok {
+		return nil
+	}
+	typeName, fields, err := m.templateFields(data)
+	if err != nil || len(fields) == 0 {
+		return err
+	}
+	if m, err = m.withDynamicFields(map[string]map[string][]*mapping.FieldMapping{typeName: fields}); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(m.dynamicFields)
+	if err != nil {
+		return err
+	}
+	b.batch.SetInternal(DYNAMIC_MAPPING_KEY, raw)
+	b.mapping = m
+	return nil
 }
 
 func(b *Batch) SetApplyID(applyID uint64) error {
@@ -82,14 +119,36 @@ func(b *Batch) SetApplyID(applyID uint64) error {
 }
 
 func (b *Batch)AddDocument(ctx context.Context, docID engine.DOC_ID, doc interface{}) error {
-	return b.batch.Index(docID.ToString(), doc)
+	return b.indexDocument(docID, doc)
+}
+
+// indexDocument maps the document and keeps its original json in the stored _source field.
+func (b *Batch) indexDocument(docID engine.DOC_ID, doc interface{}) error {
+	source, data, err := docSource(doc)
+	if err != nil {
+		return err
+	}
+	_doc := document.NewDocument(docID.ToString())
+	if err = b.addDynamicFields(data); err != nil {
+		return err
+	}
+	if err = b.Mapping().MapDocument(_doc, data); err != nil {
+		return err
+	}
+	_doc.AddField(document.NewTextFieldWithIndexingOptions(SourceField, nil, source, document.StoreField))
+	if d, ok := doc.(*engine.VersionedDocument); ok {
+		addVersionFields(_doc, d.DocVersion)
+	}
+	return b.batch.IndexAdvanced(_doc)
 }
 
 func(b *Batch) UpdateDocument(ctx context.Context, docID engine.DOC_ID, doc interface{}, upsert bool) (found bool, err error) {
 	var _doc *document.Document
 	var _index index.Index
 	var reader index.IndexReader
-	_index, _, err = b.index.Advanced()
+	b.db.indexLock.RLock()
+	defer b.db.indexLock.RUnlock()
+	_index, _, err = b.db.index.Advanced()
 	if err != nil {
 		return
 	}
@@ -97,6 +156,7 @@ func(b *Batch) UpdateDocument(ctx context.Context, docID engine.DOC_ID, doc inte
 	if err != nil {
 		return
 	}
+	defer reader.Close()
 	_doc, err = reader.Document(docID.ToString())
 	if err != nil {
 		return false, err
@@ -107,7 +167,7 @@ func(b *Batch) UpdateDocument(ctx context.Context, docID engine.DOC_ID, doc inte
 	if !upsert && !found {
 		return
 	}
-	err =  b.batch.Index(docID.ToString(), doc)
+	err = b.indexDocument(docID, doc)
 	return
 }
 
@@ -116,7 +176,9 @@ func(b *Batch) DeleteDocument(ctx context.Context, docID engine.DOC_ID) (int, er
 	var _index index.Index
 	var reader index.IndexReader
 	var err error
-	_index, _, err = b.index.Advanced()
+	b.db.indexLock.RLock()
+	defer b.db.indexLock.RUnlock()
+	_index, _, err = b.db.index.Advanced()
 	if err != nil {
 		return 0, err
 	}
@@ -124,22 +186,34 @@ func(b *Batch) DeleteDocument(ctx context.Context, docID engine.DOC_ID) (int, er
 	if err != nil {
 		return 0, err
 	}
+	defer reader.Close()
 	_doc, err = reader.Document(docID.ToString())
 	if err != nil {
 		return 0, err
 	}
+	// the document may be indexed earlier in this batch
+	b.batch.Delete(docID.ToString())
 	if _doc == nil {
 		return 0, nil
 	}
-	b.batch.Delete(docID.ToString())
 	return 1, nil
 }
 
 func (b *Batch) Commit() error {
-	return b.index.Batch(b.batch)
+	b.db.indexLock.RLock()
+	err := b.db.index.Batch(b.batch)
+	b.db.indexLock.RUnlock()
+	if err != nil {
+		return err
+	}
+	if b.mapping != nil {
+		return b.db.commitMapping(b.mapping)
+	}
+	return nil
 }
 
 func (b *Batch) Rollback() error {
 	b.batch.Reset()
+	b.mapping = nil
 	return nil
 }
\ No newline at end of file
@@ -22,6 +22,8 @@ type Snapshot interface {
 	io.Closer
 	GetApplyID() (uint64, error)
 	NewIterator() Iterator
+	// Search runs the request on the data of the snapshot, later writes are not visible.
+	Search(ctx context.Context, req *SearchRequest) (*SearchResult, error)
 }
 
 // Iterator is an interface for iterating over key/value pairs in an engine.
@@ -37,7 +39,7 @@ type Iterator interface {
 type Reader interface {
 	io.Closer
 	GetApplyID() (uint64, error)
-	GetDocument(ctx context.Context, docID DOC_ID) (DOCUMENT, bool)
+	GetDocument(ctx context.Context, docID DOC_ID, req *GetRequest) (*GetResult, bool)
 	Search(ctx context.Context, req *SearchRequest)(*SearchResult, error)
 }
 
@@ -69,3 +71,14 @@ type Engine interface {
 	NewSnapshot() (Snapshot, error)
 	ApplySnapshot(ctx context.Context, iter Iterator) error
 }
+
+// SchemaUpdater is implemented by the engines whose schema can be changed without reopening them.
+type SchemaUpdater interface {
+	// UpdateSchema replaces the schema if version is newer than the current one.
+	UpdateSchema(schema string, version uint64) error
+}
+
+// Analyzer is implemented by the engines which can show the tokens produced by their analyzers.
+type Analyzer interface {
+	Analyze(ctx context.Context, req *AnalyzeRequest) (*AnalyzeResult, error)
+}
@@ -29,6 +29,8 @@ type EngineConfig struct {
 
 	// Schema
 	Schema  string
+	// SchemaVersion is the version of the schema, it increases when the schema changes.
+	SchemaVersion uint64
 }
 
 // Register is used to register the engine implementers in the initialization phase.
@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const (
+	defaultFragmentSize      = 100
+	defaultNumberOfFragments = 5
+	defaultPreTag            = "<em>"
+	defaultPostTag           = "</em>"
+)
+
+// HighlightOptions are the options of a highlight section, they can be overridden per field.
+type HighlightOptions struct {
+	PreTags           []string `json:"pre_tags,omitempty"`
+	PostTags          []string `json:"post_tags,omitempty"`
+	FragmentSize      *int     `json:"fragment_size,omitempty"`
+	NumberOfFragments *int     `json:"number_of_fragments,omitempty"`
+}
+
+/*
+"highlight" : {
+    "pre_tags" : ["<tag1>"],
+    "post_tags" : ["</tag1>"],
+    "fragment_size" : 150,
+    "number_of_fragments" : 3,
+    "fields" : {
+        "content" : {},
+        "title" : { "number_of_fragments" : 0 }
+    }
+}
+*/
+type Highlight struct {
+	HighlightOptions
+	// Fields are field names or wildcard patterns
+	Fields map[string]*HighlightOptions `json:"fields"`
+}
+
+func (h *Highlight) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		HighlightOptions
+		Fields json.RawMessage `json:"fields"`
+	}{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	h.HighlightOptions = tmp.HighlightOptions
+	h.Fields = make(map[string]*HighlightOptions)
+	// fields is either an object or an array of objects to keep the order
+	if err := json.Unmarshal(tmp.Fields, &h.Fields); err != nil {
+		var fields []map[string]*HighlightOptions
+		if err = json.Unmarshal(tmp.Fields, &fields); err != nil {
+			return errors.New("invalid highlight fields")
+		}
+		for _, f := range fields {
+			for name, opts := range f {
+				h.Fields[name] = opts
+			}
+		}
+	}
+	if len(h.Fields) == 0 {
+		return errors.New("highlight requires fields")
+	}
+	for name, opts := range h.Fields {
+		if opts == nil {
+			h.Fields[name] = &HighlightOptions{}
+		}
+	}
+	return nil
+}
+
+// FieldOptions returns the options of a field pattern, merged with the global options and the defaults.
+// Zero fragments means the whole field value is highlighted as one fragment.
+func (h *Highlight) FieldOptions(pattern string) (fragmentSize, fragments int, preTag, postTag string) {
+	fragmentSize, fragments = defaultFragmentSize, defaultNumberOfFragments
+	preTag, postTag = defaultPreTag, defaultPostTag
+	for _, opts := range []*HighlightOptions{&h.HighlightOptions, h.Fields[pattern]} {
+		if opts == nil {
+			continue
+		}
+		if opts.FragmentSize != nil {
+			fragmentSize = *opts.FragmentSize
+		}
+		if opts.NumberOfFragments != nil {
+			fragments = *opts.NumberOfFragments
+		}
+		if len(opts.PreTags) > 0 {
+			preTag = opts.PreTags[0]
+		}
+		if len(opts.PostTags) > 0 {
+			postTag = opts.PostTags[0]
+		}
+	}
+	return
+}
@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/spaolacci/murmur3"
+)
+
+const (
+	hllPrecision = 11
+	hllRegisters = 1 << hllPrecision
+)
+
+// HyperLogLog is a cardinality estimator whose state can be merged across partitions.
+type HyperLogLog struct {
+	registers []uint8
+}
+
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{registers: make([]uint8, hllRegisters)}
+}
+
+// HyperLogLogFromBytes restores a HyperLogLog serialized by Bytes, empty data gives an empty estimator.
+func HyperLogLogFromBytes(data []byte) (*HyperLogLog, error) {
+	if len(data) == 0 {
+		return NewHyperLogLog(), nil
+	}
+	if len(data) != hllRegisters {
+		return nil, errors.New("invalid hyperloglog sketch")
+	}
+	h := &HyperLogLog{registers: make([]uint8, hllRegisters)}
+	copy(h.registers, data)
+	return h, nil
+}
+
+func (h *HyperLogLog) Add(value []byte) {
+	x := murmur3.Sum64(value)
+	idx := x >> (64 - hllPrecision)
+	rho := uint8(bits.LeadingZeros64(x<<hllPrecision|1<<(hllPrecision-1))) + 1
+	if rho > h.registers[idx] {
+		h.registers[idx] = rho
+	}
+}
+
+func (h *HyperLogLog) Merge(other *HyperLogLog) {
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+}
+
+// Count returns the estimated number of distinct values added.
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(hllRegisters)
+	var sum float64
+	var zeros int
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+func (h *HyperLogLog) Bytes() []byte {
+	return append([]byte(nil), h.registers...)
+}
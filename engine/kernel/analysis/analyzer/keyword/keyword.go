@@ -1,9 +1,9 @@
 package keyword
 
 import (
-	"github.com/tiglabs/baudengine/kernel/analysis"
-	"github.com/tiglabs/baudengine/kernel/registry"
-	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/keyword"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/registry"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/tokenizer/keyword"
 )
 
 const Name = "keyword"
@@ -1,11 +1,11 @@
 package standard
 
 import (
-	"github.com/tiglabs/baudengine/kernel/analysis"
-	"github.com/tiglabs/baudengine/kernel/registry"
-	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/unicode"
-	"github.com/tiglabs/baudengine/kernel/analysis/filter/stop"
-	"github.com/tiglabs/baudengine/kernel/analysis/filter/lower"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/registry"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/tokenizer/unicode"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/filter/stop"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/filter/lower"
 )
 
 const Name = "standard"
@@ -1,9 +1,9 @@
 package whitspace
 
 import (
-	"github.com/tiglabs/baudengine/kernel/analysis"
-	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/character"
-	"github.com/tiglabs/baudengine/kernel/registry"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/tokenizer/character"
+	"github.com/tiglabs/baudengine/engine/kernel/registry"
 )
 
 var _ analysis.Analyzer = &Analyzer{}
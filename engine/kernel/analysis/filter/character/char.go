@@ -1,7 +1,7 @@
 package character
 
 import (
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 )
 
 var _ analysis.CharFilter = &CharacterFilter{}
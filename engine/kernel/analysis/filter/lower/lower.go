@@ -1,7 +1,7 @@
 package lower
 
 import (
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 	"unicode/utf8"
 	"unicode"
 )
@@ -2,7 +2,7 @@ package lower
 
 import (
 	"testing"
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 )
 
 func TestLower(t *testing.T) {
@@ -3,13 +3,14 @@ package stop
 import (
 	"bufio"
 	"io"
-	"fmt"
 	"os"
+	"strings"
+	"sync"
 
 	"github.com/heidawei/gotrie/trie"
-	"github.com/tiglabs/baudengine/kernel/analysis"
-	"github.com/tiglabs/baudengine/kernel/registry"
-	"github.com/tiglabs/baudengine/kernel/config"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/registry"
+	"github.com/tiglabs/baudengine/engine/kernel/config"
 )
 
 var _ analysis.TokenFilter = &StopFilter{}
@@ -17,43 +18,58 @@ var _ analysis.TokenFilter = &StopFilter{}
 const Name = "stop"
 
 type StopFilter struct {
+	// the dict of New is loaded from the config on the first filter, it is empty if the dict can not be loaded
+	once   sync.Once
 	dict   *trie.Trie
 }
 
 func New() *StopFilter {
-	sf := &StopFilter{dict: trie.NewTrie()}
-	sf.loadDict()
 	return &StopFilter{}
 }
 
-func (sf *StopFilter) loadDict() error {
-	sf.dict = trie.NewTrie()
-	f, err := os.Open(config.GetWordDictPath("stop_word.dict"))
+// NewWithDict creates the stop filter of the stop words in the dict, one word a line.
+// The dict is loaded at once, so that a missing dict fails the creation rather than the filtering.
+func NewWithDict(path string) (*StopFilter, error) {
+	dict, err := loadDict(path)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	sf := &StopFilter{dict: dict}
+	sf.once.Do(func() {})
+	return sf, nil
+}
+
+func loadDict(path string) (*trie.Trie, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
 	}
 	defer f.Close()
+	dict := trie.NewTrie()
 	reader := bufio.NewReader(f)
 	for {
 		line, err := reader.ReadString('\n')
+		if word := strings.TrimRight(line, "\r\n"); word != "" {
+			dict.ReplaceOrInsert([]byte(word), nil)
+		}
 		if err != nil {
 			if err == io.EOF {
-				return nil
+				return dict, nil
 			}
-			return err
+			return nil, err
 		}
-		sf.dict.ReplaceOrInsert([]byte(line), nil)
 	}
-	return nil
 }
 
 func (sf *StopFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
-	if sf.dict == nil {
-		err := sf.loadDict()
-		if err != nil {
-			panic(fmt.Sprintf("load dict failed, err %v", err))
+	sf.once.Do(func() {
+		if path, ok := config.LookupWordDictPath("stop_word.dict"); ok {
+			sf.dict, _ = loadDict(path)
 		}
-	}
+		if sf.dict == nil {
+			sf.dict = trie.NewTrie()
+		}
+	})
 	index := 0
 	for _, token := range input {
 		_, isStopToken := sf.dict.Find(token.Term)
@@ -5,7 +5,7 @@ import (
 	"unicode"
 	"fmt"
 	"strconv"
-	"github.com/tiglabs/baudengine/kernel/analysis/dict/symbol"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/dict/symbol"
 )
 
 type SegmentType int
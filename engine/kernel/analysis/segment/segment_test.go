@@ -115,7 +115,7 @@ func TestNumber(t *testing.T) {
 }
 
 func TestEn(t *testing.T) {
-	text := []byte("abcdefgz Α 我们")
+	text := []byte("abcdefgzΑ我们")
 	for i := 0; i < len(text); {
 		r, size := utf8.DecodeRune(text[i:])
 		if r == utf8.RuneError {
@@ -3,8 +3,8 @@ package character
 import (
 	"unicode/utf8"
 
-	"github.com/tiglabs/baudengine/kernel/analysis"
-	"github.com/tiglabs/baudengine/kernel/analysis/filter/character"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/filter/character"
 	"github.com/tiglabs/baudengine/util/bytes"
 )
 
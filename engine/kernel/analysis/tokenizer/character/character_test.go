@@ -13,13 +13,13 @@ func TestTokenize(t *testing.T) {
 	if len(sets) != 3 {
 		t.Fatalf("test failed sets size %d", len(sets))
 	}
-	if string(sets[0].Term) != "abcd我们" || sets[0].Position != 0{
+	if string(sets[0].Term) != "abcd我们" || sets[0].Position != 1{
 		t.Fatal("test failed")
 	}
-	if string(sets[1].Term) != "bb" || sets[1].Position != 1{
+	if string(sets[1].Term) != "bb" || sets[1].Position != 2{
 		t.Fatal("test failed")
 	}
-	if string(sets[2].Term) != "哈哈哈" || sets[2].Position != 2{
+	if string(sets[2].Term) != "哈哈哈" || sets[2].Position != 3{
 		t.Fatal("test failed")
 	}
 }
@@ -1,9 +1,9 @@
 package chinese
 
 import (
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 	"github.com/yanyiwu/gojieba"
-	"github.com/tiglabs/baudengine/kernel/config"
+	"github.com/tiglabs/baudengine/engine/kernel/config"
 )
 
 type ZhTokenizer struct {
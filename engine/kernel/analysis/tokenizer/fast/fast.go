@@ -8,7 +8,7 @@ import (
 	"strings"
 	"strconv"
 
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 	acdat "github.com/heidawei/AhoCorasickDoubleArrayTrie/ACDAT"
 	"github.com/tiglabs/baudengine/util/bytes"
 )
@@ -1,11 +1,11 @@
 package unicode
 
 import (
-	"github.com/tiglabs/baudengine/kernel/analysis"
-	"github.com/tiglabs/baudengine/kernel/registry"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/registry"
 	"github.com/tiglabs/baudengine/util/bytes"
-	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/chinese"
-	"github.com/tiglabs/baudengine/kernel/analysis/segment"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/tokenizer/chinese"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis/segment"
 )
 
 const Name = "unicode"
@@ -20,6 +20,11 @@ func NewUnicodeTokenizer() *UnicodeTokenizer {
 	return &UnicodeTokenizer{}
 }
 
+// NewUnicodeTokenizerWith creates the tokenizer which tokenizes the Han segments by the zh tokenizer.
+func NewUnicodeTokenizerWith(zh analysis.Tokenizer) *UnicodeTokenizer {
+	return &UnicodeTokenizer{zhTokneizer: zh}
+}
+
 func (ut *UnicodeTokenizer) Tokenize(input []byte) analysis.TokenSet {
 	if ut.zhTokneizer == nil {
 		ut.zhTokneizer = chinese.NewZh()
@@ -50,7 +55,7 @@ func (ut *UnicodeTokenizer) Tokenize(input []byte) analysis.TokenSet {
 		}
 	}
 
-	return nil
+	return sets
 }
 
 
@@ -21,6 +21,12 @@ func GetWordDictPath(name string) string {
 	panic("invalid word dict name")
 }
 
+// LookupWordDictPath returns the path of the dict and whether it is set.
+func LookupWordDictPath(name string) (string, bool) {
+	path, ok := config.dictPath[name]
+	return path, ok
+}
+
 
 func init() {
 	config = New()
@@ -1,6 +1,6 @@
 package document
 
-import "github.com/tiglabs/baudengine/kernel/analysis"
+import "github.com/tiglabs/baudengine/engine/kernel/analysis"
 
 type Field interface {
 	// Name returns the path of the field from the root DocumentMapping.
@@ -6,7 +6,7 @@ import (
 	"time"
 	"errors"
 
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 	"github.com/tiglabs/baudengine/util"
 )
 
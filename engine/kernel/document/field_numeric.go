@@ -3,7 +3,7 @@ package document
 import (
 	"fmt"
 
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 	"github.com/tiglabs/baudengine/util"
 )
 
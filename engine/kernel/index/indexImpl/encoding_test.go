@@ -2,7 +2,7 @@ package indexImpl
 
 import (
 	"testing"
-	"github.com/tiglabs/baudengine/kernel/document"
+	"github.com/tiglabs/baudengine/engine/kernel/document"
 )
 
 func TestDecodeEncodeFileName(t *testing.T) {
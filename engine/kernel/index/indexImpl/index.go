@@ -3,9 +3,9 @@ package indexImpl
 import (
 	"context"
 
-	"github.com/tiglabs/baudengine/kernel"
-	"github.com/tiglabs/baudengine/kernel/mapping"
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel"
+	"github.com/tiglabs/baudengine/engine/kernel/mapping"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
 )
 
 var RAFT_APPLY_ID []byte = []byte("Raft_apply_id")
@@ -4,9 +4,9 @@ import (
 	"testing"
 	"os"
 
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
-	"github.com/tiglabs/baudengine/kernel/store/kvstore/boltdb"
-	"github.com/tiglabs/baudengine/kernel/document"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore/boltdb"
+	"github.com/tiglabs/baudengine/engine/kernel/document"
 	"golang.org/x/net/context"
 )
 
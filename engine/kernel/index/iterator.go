@@ -2,7 +2,7 @@ package index
 
 import (
 	"github.com/tiglabs/baudengine/proto/metapb"
-	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/engine/kernel"
 	"github.com/blevesearch/bleve/index/store"
 )
 
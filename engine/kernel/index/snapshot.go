@@ -1,11 +1,12 @@
 package index
 
 import (
+	"context"
 	"errors"
 	"encoding/binary"
 
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
-	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel"
 )
 
 var _ engine.Snapshot = &Snapshot{}
@@ -31,6 +32,10 @@ func (ds *Snapshot)NewIterator() engine.Iterator {
 	return &Iterator{iter: iter, filters: []Filter{&RaftFilter{}}}
 }
 
+func (ds *Snapshot)Search(ctx context.Context, req *engine.SearchRequest) (*engine.SearchResult, error) {
+	return nil, errors.New("search on snapshot is not supported")
+}
+
 func (ds *Snapshot)Close() error {
 	return ds.snap.Close()
 }
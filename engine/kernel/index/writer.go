@@ -8,11 +8,11 @@ import (
 
 	"github.com/tiglabs/baudengine/proto/pspb"
 	"github.com/tiglabs/baudengine/proto/metapb"
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
-	"github.com/tiglabs/baudengine/kernel"
-	"github.com/tiglabs/baudengine/kernel/registry"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel"
+	"github.com/tiglabs/baudengine/engine/kernel/registry"
 	"github.com/tiglabs/baudengine/util/encoding"
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 )
 
 func (w *IndexDriver) SetApplyID(applyID uint64) error {
@@ -10,7 +10,7 @@ import (
 	"sort"
 	"sync/atomic"
 
-	"github.com/tiglabs/baudengine/kernel/document"
+	"github.com/tiglabs/baudengine/engine/kernel/document"
 )
 
 type DocumentMapping struct {
@@ -8,7 +8,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/tiglabs/baudengine/kernel/document"
+	"github.com/tiglabs/baudengine/engine/kernel/document"
 )
 
 type FieldMapping interface {
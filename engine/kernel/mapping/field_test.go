@@ -2,8 +2,8 @@ package mapping
 
 import (
 	"testing"
-	"github.com/tiglabs/baudengine/kernel/document"
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/document"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 	"time"
 	"github.com/tiglabs/baudengine/util"
 	"encoding/json"
@@ -1,8 +1,8 @@
 package mapping
 
 import (
-	"github.com/tiglabs/baudengine/kernel/document"
-	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/engine/kernel/document"
+	"github.com/tiglabs/baudengine/engine/kernel/analysis"
 )
 
 type IndexMapping interface {
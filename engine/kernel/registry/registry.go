@@ -1,6 +1,6 @@
 package registry
 
-import "github.com/tiglabs/baudengine/kernel/analysis"
+import "github.com/tiglabs/baudengine/engine/kernel/analysis"
 
 var analyzers *Registry
 
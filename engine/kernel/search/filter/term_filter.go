@@ -1,8 +1,8 @@
 package filter
 
 import (
-	"github.com/tiglabs/baudengine/kernel/index"
-	"github.com/tiglabs/baudengine/kernel/search/result"
+	"github.com/tiglabs/baudengine/engine/kernel/index"
+	"github.com/tiglabs/baudengine/engine/kernel/search/result"
 )
 
 type TermFilter struct {
@@ -5,7 +5,7 @@ import (
 	"sync"
 
 	"github.com/dgraph-io/badger"
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
 )
 
 var _ kvstore.KVIterator = &Iterator{}
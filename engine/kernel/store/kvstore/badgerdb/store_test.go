@@ -4,8 +4,8 @@ import (
 	"os"
 	"testing"
 
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
-	"github.com/tiglabs/baudengine/kernel/store/kvstore/test"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore/test"
 )
 
 func open(t *testing.T) kvstore.KVStore {
@@ -2,7 +2,7 @@ package badgerdb
 
 import (
 	"github.com/dgraph-io/badger"
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
 )
 
 type Transaction struct {
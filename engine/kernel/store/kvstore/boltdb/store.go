@@ -5,7 +5,7 @@ import (
 	"os"
 
 	"github.com/boltdb/bolt"
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
 )
 
 var _ kvstore.KVStore = &Store{}
@@ -2,7 +2,7 @@ package boltdb
 
 import (
 	"github.com/boltdb/bolt"
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
 )
 
 type Transaction struct {
@@ -1,7 +1,7 @@
 package null
 
 import (
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
 )
 
 var _ kvstore.KVStore = &Store{}
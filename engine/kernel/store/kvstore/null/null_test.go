@@ -3,7 +3,7 @@ package null
 import (
 	"testing"
 
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
 )
 
 func TestStore(t *testing.T) {
@@ -3,7 +3,7 @@ package test
 import (
 	"testing"
 
-	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/kvstore"
 )
 
 // basic crud tests
@@ -5,7 +5,7 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/tiglabs/baudengine/kernel/store/memstore"
+	"github.com/tiglabs/baudengine/engine/kernel/store/memstore"
 )
 
 func CommonTestReaderOwnsGetValue(t *testing.T, s memstore.MemStore) {
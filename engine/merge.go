@@ -0,0 +1,141 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SortKey is a key of the sort section, it compares the sort values of the hits from different partitions.
+type SortKey struct {
+	Field        string
+	Desc         bool
+	MissingFirst bool
+}
+
+// SortKeys is the order of the hits, hits are sorted by score if there are no sort keys.
+type SortKeys []SortKey
+
+// ParseSortKeys parses the sort section of a search request, see bleve.ParseSort.
+func ParseSortKeys(data []byte) (SortKeys, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		items = []json.RawMessage{data}
+	}
+	keys := make(SortKeys, 0, len(items))
+	for _, item := range items {
+		var field string
+		if err := json.Unmarshal(item, &field); err == nil {
+			keys = append(keys, SortKey{Field: field, Desc: field == "_score"})
+			continue
+		}
+		tmp := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(item, &tmp); err != nil {
+			return nil, fmt.Errorf("invalid sort %s", item)
+		}
+		for field, data := range tmp {
+			opts := struct {
+				Order   string `json:"order"`
+				Missing string `json:"missing"`
+			}{}
+			if err := json.Unmarshal(data, &opts.Order); err != nil {
+				if err = json.Unmarshal(data, &opts); err != nil {
+					return nil, fmt.Errorf("invalid sort of field %s", field)
+				}
+			}
+			key := SortKey{Field: field, Desc: opts.Order == "desc", MissingFirst: opts.Missing == "_first"}
+			if opts.Order == "" {
+				key.Desc = field == "_score"
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// Compare returns -1 if hit a is before hit b, 1 if it is after b and 0 if their order is not defined.
+func (keys SortKeys) Compare(a, b *HitDoc) int {
+	if len(keys) == 0 {
+		return compareScore(a.Score, b.Score)
+	}
+	for i, key := range keys {
+		var c int
+		if key.Field == "_score" && (i >= len(a.Sort) || i >= len(b.Sort)) {
+			c = compareScore(a.Score, b.Score)
+		} else {
+			c = key.compare(sortValue(a, i), sortValue(b, i))
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func sortValue(hit *HitDoc, i int) interface{} {
+	if i < len(hit.Sort) {
+		return hit.Sort[i]
+	}
+	return nil
+}
+
+// compareScore sorts the higher scores first
+func compareScore(a, b float64) int {
+	switch {
+	case a > b:
+		return -1
+	case a < b:
+		return 1
+	}
+	return 0
+}
+
+// compare puts the missing values first or last regardless of the order, numbers are before strings.
+func (key SortKey) compare(a, b interface{}) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case (a == nil) == key.MissingFirst:
+			return -1
+		}
+		return 1
+	}
+	var c int
+	switch x := a.(type) {
+	case float64:
+		y, ok := b.(float64)
+		switch {
+		case !ok:
+			c = -1
+		case x < y:
+			c = -1
+		case x > y:
+			c = 1
+		}
+	case string:
+		y, ok := b.(string)
+		switch {
+		case !ok:
+			c = 1
+		case x < y:
+			c = -1
+		case x > y:
+			c = 1
+		}
+	default:
+		x2, y2 := fmt.Sprint(a), fmt.Sprint(b)
+		switch {
+		case x2 < y2:
+			c = -1
+		case x2 > y2:
+			c = 1
+		}
+	}
+	if key.Desc {
+		return -c
+	}
+	return c
+}
@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// MergePatch applies the RFC 7396 json merge patch to the target document and returns the merged document.
+// A nil target is patched as an empty object, numbers keep their original precision.
+func MergePatch(target, patch []byte) ([]byte, error) {
+	p, err := decodeJSON(patch)
+	if err != nil {
+		return nil, err
+	}
+	var t interface{}
+	if len(target) > 0 {
+		if t, err = decodeJSON(target); err != nil {
+			return nil, err
+		}
+	}
+	merged := mergeValue(t, p)
+	if _, ok := merged.(map[string]interface{}); !ok {
+		return nil, errors.New("the merged document must be an object")
+	}
+	return json.Marshal(merged)
+}
+
+func mergeValue(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{}, len(p))
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+		} else {
+			t[k] = mergeValue(t[k], v)
+		}
+	}
+	return t
+}
+
+func decodeJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
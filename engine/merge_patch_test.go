@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	groups := []struct {
+		target string
+		patch  string
+		output string
+	}{
+		{`{"a": "b"}`, `{"a": "c"}`, `{"a": "c"}`},
+		{`{"a": "b"}`, `{"b": "c"}`, `{"a": "b", "b": "c"}`},
+		{`{"a": "b", "b": "c"}`, `{"a": null}`, `{"b": "c"}`},
+		{`{"a": {"b": "c", "d": 1}}`, `{"a": {"b": "d", "d": null}}`, `{"a": {"b": "d"}}`},
+		{`{"a": [{"b": "c"}]}`, `{"a": [1]}`, `{"a": [1]}`},
+		{`{"a": "foo"}`, `{"a": {"b": null, "c": 12345678901234567}}`, `{"a": {"c": 12345678901234567}}`},
+		{``, `{"a": "b", "c": null}`, `{"a": "b"}`},
+	}
+	for _, group := range groups {
+		merged, err := MergePatch([]byte(group.target), []byte(group.patch))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expect, actual interface{}
+		json.Unmarshal([]byte(group.output), &expect)
+		json.Unmarshal(merged, &actual)
+		if !reflect.DeepEqual(expect, actual) {
+			t.Fatalf("merge %s into %s, expect %s, got %s", group.patch, group.target, group.output, merged)
+		}
+	}
+
+	if merged, _ := MergePatch([]byte(`{"price": 12345678901234567}`), []byte(`{}`)); string(merged) != `{"price":12345678901234567}` {
+		t.Fatalf("number precision is lost: %s", merged)
+	}
+	if _, err := MergePatch([]byte(`{"a": 1}`), []byte(`[1]`)); err == nil {
+		t.Fatal("expect error when the merged document is not an object")
+	}
+}
@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestParseSortKeys(t *testing.T) {
+	keys, err := ParseSortKeys([]byte(`["_score", {"age": "desc"}, {"name": {"order": "asc", "missing": "_first"}}, "date"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := SortKeys{
+		{Field: "_score", Desc: true},
+		{Field: "age", Desc: true},
+		{Field: "name", MissingFirst: true},
+		{Field: "date"},
+	}
+	if !reflect.DeepEqual(keys, expect) {
+		t.Fatalf("sort keys %v, expect %v", keys, expect)
+	}
+	if _, err = ParseSortKeys([]byte(`[1]`)); err == nil {
+		t.Fatal("expect error of invalid sort")
+	}
+}
+
+func TestSortKeysCompare(t *testing.T) {
+	ids := func(keys SortKeys, hits []HitDoc) []string {
+		sort.SliceStable(hits, func(i, j int) bool {
+			return keys.Compare(&hits[i], &hits[j]) < 0
+		})
+		var result []string
+		for _, hit := range hits {
+			result = append(result, hit.Id)
+		}
+		return result
+	}
+
+	hits := []HitDoc{{Id: "1", Score: 0.5}, {Id: "2", Score: 2}, {Id: "3", Score: 0.5}, {Id: "4", Score: 1}}
+	if result := ids(nil, hits); !reflect.DeepEqual(result, []string{"2", "4", "1", "3"}) {
+		t.Fatalf("hits sorted by score %v", result)
+	}
+
+	keys := SortKeys{{Field: "age", Desc: true}, {Field: "name"}}
+	hits = []HitDoc{
+		{Id: "1", Sort: []interface{}{float64(20), "b"}},
+		{Id: "2", Sort: []interface{}{nil, "a"}},
+		{Id: "3", Sort: []interface{}{float64(30), "c"}},
+		{Id: "4", Sort: []interface{}{float64(20), "a"}},
+	}
+	if result := ids(keys, hits); !reflect.DeepEqual(result, []string{"3", "4", "1", "2"}) {
+		t.Fatalf("hits sorted by values %v", result)
+	}
+
+	keys = SortKeys{{Field: "age", MissingFirst: true}}
+	hits = []HitDoc{{Id: "1", Sort: []interface{}{float64(20)}}, {Id: "2", Sort: []interface{}{nil}}}
+	if result := ids(keys, hits); !reflect.DeepEqual(result, []string{"2", "1"}) {
+		t.Fatalf("missing values are not first %v", result)
+	}
+}
@@ -18,6 +18,27 @@ type SearchRequest struct {
 	Query     []byte `json:"query"`
 	Explain   bool   `json:"explain,omitempty"`
 	Timeout   time.Duration `json:"time_out,omitempty"`
+	// raw json of the aggs section
+	Aggregations []byte `json:"aggs,omitempty"`
+	// raw json of the sort section, sorted by score if empty
+	Sort        []byte `json:"sort,omitempty"`
+	// raw json array of the sort values of the last hit in the previous page
+	SearchAfter []byte `json:"search_after,omitempty"`
+	// nil returns the whole _source
+	Source       *FetchSource `json:"_source,omitempty"`
+	StoredFields []string     `json:"stored_fields,omitempty"`
+	Highlight    *Highlight   `json:"highlight,omitempty"`
+	// returns the _version of every hit
+	Version          bool `json:"version,omitempty"`
+	// returns the _seq_no and _primary_term of every hit
+	SeqNoPrimaryTerm bool `json:"seq_no_primary_term,omitempty"`
+}
+
+// GetRequest selects the parts of a document returned by GetDocument.
+type GetRequest struct {
+	// nil returns the whole _source
+	Source       *FetchSource
+	StoredFields []string
 }
 
 func NewSearchQuery(_index, _type string) *SearchRequest {
@@ -44,6 +65,38 @@ func (r *SearchRequest) SetTimeout(t time.Duration) {
 	r.Timeout = t
 }
 
+func (r *SearchRequest) SetAggregations(aggs []byte) {
+	r.Aggregations = aggs
+}
+
+func (r *SearchRequest) SetSort(sort []byte) {
+	r.Sort = sort
+}
+
+func (r *SearchRequest) SetSearchAfter(after []byte) {
+	r.SearchAfter = after
+}
+
+func (r *SearchRequest) SetSource(source *FetchSource) {
+	r.Source = source
+}
+
+func (r *SearchRequest) SetStoredFields(fields []string) {
+	r.StoredFields = fields
+}
+
+func (r *SearchRequest) SetHighlight(h *Highlight) {
+	r.Highlight = h
+}
+
+func (r *SearchRequest) SetVersion(v bool) {
+	r.Version = v
+}
+
+func (r *SearchRequest) SetSeqNoPrimaryTerm(v bool) {
+	r.SeqNoPrimaryTerm = v
+}
+
 func (r *SearchRequest)UnmarshalJSON(data []byte) error{
 	tmp := struct {
 		Size  *int `json:"size,omitempty"`
@@ -51,6 +104,15 @@ func (r *SearchRequest)UnmarshalJSON(data []byte) error{
 		Query json.RawMessage `json:"query,omitempty"`
 		Explain   *bool   `json:"explain,omitempty"`
 		Timeout   *time.Duration `json:"time_out,omitempty"`
+		Aggs         json.RawMessage `json:"aggs,omitempty"`
+		Aggregations json.RawMessage `json:"aggregations,omitempty"`
+		Sort         json.RawMessage `json:"sort,omitempty"`
+		SearchAfter  json.RawMessage `json:"search_after,omitempty"`
+		Source       json.RawMessage `json:"_source,omitempty"`
+		StoredFields json.RawMessage `json:"stored_fields,omitempty"`
+		Highlight    *Highlight      `json:"highlight,omitempty"`
+		Version          *bool `json:"version,omitempty"`
+		SeqNoPrimaryTerm *bool `json:"seq_no_primary_term,omitempty"`
 	}{}
 	err := json.Unmarshal(data, &tmp)
 	if err != nil {
@@ -69,6 +131,40 @@ func (r *SearchRequest)UnmarshalJSON(data []byte) error{
 	if tmp.Explain != nil {
 		r.SetExplain(*tmp.Explain)
 	}
+	if tmp.Aggs != nil {
+		r.SetAggregations(tmp.Aggs)
+	} else if tmp.Aggregations != nil {
+		r.SetAggregations(tmp.Aggregations)
+	}
+	if tmp.Sort != nil {
+		r.SetSort(tmp.Sort)
+	}
+	if tmp.SearchAfter != nil {
+		r.SetSearchAfter(tmp.SearchAfter)
+	}
+	if tmp.Source != nil {
+		source, err := ParseFetchSource(tmp.Source)
+		if err != nil {
+			return err
+		}
+		r.SetSource(source)
+	}
+	if tmp.StoredFields != nil {
+		fields, err := parsePatterns(tmp.StoredFields)
+		if err != nil {
+			return err
+		}
+		r.SetStoredFields(fields)
+	}
+	if tmp.Highlight != nil {
+		r.SetHighlight(tmp.Highlight)
+	}
+	if tmp.Version != nil {
+		r.SetVersion(*tmp.Version)
+	}
+	if tmp.SeqNoPrimaryTerm != nil {
+		r.SetSeqNoPrimaryTerm(*tmp.SeqNoPrimaryTerm)
+	}
 	return nil
 }
 
@@ -81,7 +177,17 @@ func (r *SearchRequest)MarshalJSON() ([]byte, error) {
 		// default 0
 		From      int    `json:"from,omitempty"`
 		Query     string `json:"query"`
-	}{Index: r.Index, Type: r.Type, Size: r.Size, From: r.From, Query: string(r.Query)}
+		Aggregations json.RawMessage `json:"aggs,omitempty"`
+		Sort         json.RawMessage `json:"sort,omitempty"`
+		SearchAfter  json.RawMessage `json:"search_after,omitempty"`
+		Source       *FetchSource    `json:"_source,omitempty"`
+		StoredFields []string        `json:"stored_fields,omitempty"`
+		Highlight    *Highlight      `json:"highlight,omitempty"`
+		Version          bool `json:"version,omitempty"`
+		SeqNoPrimaryTerm bool `json:"seq_no_primary_term,omitempty"`
+	}{Index: r.Index, Type: r.Type, Size: r.Size, From: r.From, Query: string(r.Query), Aggregations: r.Aggregations,
+		Sort: r.Sort, SearchAfter: r.SearchAfter, Source: r.Source, StoredFields: r.StoredFields,
+		Highlight: r.Highlight, Version: r.Version, SeqNoPrimaryTerm: r.SeqNoPrimaryTerm}
 	return json.Marshal(tmp)
 }
 
@@ -91,4 +197,34 @@ func (r *SearchRequest)Parse(data []byte) (error) {
 		return err
 	}
 	return nil
-}
\ No newline at end of file
+}
+// AddSortTieBreaker appends _id to the sort keys unless it is sorted by _id already,
+// so that every hit has distinct sort values to search after. It sorts by score if there are no sort keys.
+func (r *SearchRequest) AddSortTieBreaker() error {
+	if len(r.Sort) == 0 {
+		r.Sort = []byte(`["_score", "_id"]`)
+		return nil
+	}
+	var keys []json.RawMessage
+	if err := json.Unmarshal(r.Sort, &keys); err != nil {
+		keys = []json.RawMessage{r.Sort}
+	}
+	for _, key := range keys {
+		var field string
+		if json.Unmarshal(key, &field) == nil && field == "_id" {
+			return nil
+		}
+		obj := make(map[string]json.RawMessage)
+		if json.Unmarshal(key, &obj) == nil {
+			if _, ok := obj["_id"]; ok {
+				return nil
+			}
+		}
+	}
+	sort, err := json.Marshal(append(keys, json.RawMessage(`"_id"`)))
+	if err != nil {
+		return err
+	}
+	r.Sort = sort
+	return nil
+}
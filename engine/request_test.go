@@ -48,3 +48,26 @@ func TestParseRequest(t *testing.T) {
     	t.Fatal("parse failed")
     }
 }
+
+func TestAddSortTieBreaker(t *testing.T) {
+	groups := []struct {
+		sort   string
+		output string
+	}{
+		{``, `["_score", "_id"]`},
+		{`"price"`, `["price","_id"]`},
+		{`[{"price": "desc"}]`, `[{"price":"desc"},"_id"]`},
+		{`["price", "_id"]`, `["price", "_id"]`},
+		{`[{"_id": {"order": "desc"}}]`, `[{"_id": {"order": "desc"}}]`},
+	}
+	for _, group := range groups {
+		req := NewSearchQuery("db", "space")
+		req.SetSort([]byte(group.sort))
+		if err := req.AddSortTieBreaker(); err != nil {
+			t.Fatal(err)
+		}
+		if string(req.Sort) != group.output {
+			t.Fatalf("expect sort %s, got %s", group.output, req.Sort)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package engine
 
+import "encoding/json"
+
 type Shards struct {
 	Total     int     `json:"total"`
 	Successful int    `json:"successful"`
@@ -11,7 +13,24 @@ type HitDoc struct {
 	Type       string       `json:"_type"`
 	Id         string       `json:"_id"`
 	Score      float64      `json:"_score"`
-	Source     interface{}       `json:"_source"`
+	Source     interface{}       `json:"_source,omitempty"`
+	// Sort holds the sort values of the hit, it is only set for sorted searches
+	Sort       []interface{}     `json:"sort,omitempty"`
+	// Fields holds the selected stored fields, every field is an array of values
+	Fields     DOCUMENT          `json:"fields,omitempty"`
+	// Highlight holds the highlighted fragments of every field
+	Highlight  map[string][]string `json:"highlight,omitempty"`
+	// DocVersion is only set if the version or seq_no_primary_term is requested
+	DocVersion
+}
+
+// GetResult is a document read by id.
+type GetResult struct {
+	Id     string          `json:"_id"`
+	// Source is the original json of the document, filtered by the _source patterns of the request
+	Source json.RawMessage `json:"_source,omitempty"`
+	Fields DOCUMENT        `json:"fields,omitempty"`
+	DocVersion
 }
 
 type Hits struct {
@@ -26,4 +45,6 @@ type SearchResult struct {
 	TimeOut  bool        `json:"time_out"`
 	Shards   Shards      `json:"_shards,omitempty"`
 	Hits     Hits        `json:"hits"`
+	Aggregations Aggregations `json:"aggregations,omitempty"`
+	ScrollID     string       `json:"_scroll_id,omitempty"`
 }
@@ -0,0 +1,182 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// FetchSource selects the parts of _source returned with a document.
+type FetchSource struct {
+	Fetch    bool
+	Includes []string
+	Excludes []string
+}
+
+/*
+"_source": false
+"_source": "obj.*"
+"_source": [ "obj1.*", "obj2.*" ]
+"_source": {
+    "includes": [ "obj1.*", "obj2.*" ],
+    "excludes": [ "*.description" ]
+}
+*/
+func ParseFetchSource(data []byte) (*FetchSource, error) {
+	f := &FetchSource{Fetch: true}
+	var fetch bool
+	if err := json.Unmarshal(data, &fetch); err == nil {
+		f.Fetch = fetch
+		return f, nil
+	}
+	var err error
+	if f.Includes, err = parsePatterns(data); err == nil {
+		return f, nil
+	}
+	tmp := struct {
+		Includes json.RawMessage `json:"includes"`
+		Include  json.RawMessage `json:"include"`
+		Excludes json.RawMessage `json:"excludes"`
+		Exclude  json.RawMessage `json:"exclude"`
+	}{}
+	if err = json.Unmarshal(data, &tmp); err != nil {
+		return nil, errors.New("invalid _source")
+	}
+	if tmp.Includes == nil {
+		tmp.Includes = tmp.Include
+	}
+	if tmp.Excludes == nil {
+		tmp.Excludes = tmp.Exclude
+	}
+	if tmp.Includes != nil {
+		if f.Includes, err = parsePatterns(tmp.Includes); err != nil {
+			return nil, err
+		}
+	}
+	if tmp.Excludes != nil {
+		if f.Excludes, err = parsePatterns(tmp.Excludes); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// parsePatterns accepts a single pattern or an array of patterns.
+func parsePatterns(data []byte) ([]string, error) {
+	var pattern string
+	if err := json.Unmarshal(data, &pattern); err == nil {
+		return []string{pattern}, nil
+	}
+	var patterns []string
+	if err := json.Unmarshal(data, &patterns); err != nil {
+		return nil, errors.New("invalid field pattern")
+	}
+	return patterns, nil
+}
+
+func (f *FetchSource) MarshalJSON() ([]byte, error) {
+	if !f.Fetch || len(f.Includes)+len(f.Excludes) == 0 {
+		return json.Marshal(f.Fetch)
+	}
+	return json.Marshal(struct {
+		Includes []string `json:"includes,omitempty"`
+		Excludes []string `json:"excludes,omitempty"`
+	}{f.Includes, f.Excludes})
+}
+
+func (f *FetchSource) UnmarshalJSON(data []byte) error {
+	tmp, err := ParseFetchSource(data)
+	if err != nil {
+		return err
+	}
+	*f = *tmp
+	return nil
+}
+
+// Filter returns the parts of source selected by f, source is returned verbatim if there is nothing to filter.
+// A nil FetchSource selects the whole source.
+func (f *FetchSource) Filter(source []byte) ([]byte, error) {
+	if f == nil || len(source) == 0 {
+		return source, nil
+	}
+	if !f.Fetch {
+		return nil, nil
+	}
+	if len(f.Includes)+len(f.Excludes) == 0 {
+		return source, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(source))
+	decoder.UseNumber()
+	var obj map[string]interface{}
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+	filtered, _ := f.filterObject(obj, "", len(f.Includes) == 0)
+	return json.Marshal(filtered)
+}
+
+// filterObject keeps the fields of obj which are included and not excluded,
+// objects which are not included themselves are kept if some of their fields are included.
+func (f *FetchSource) filterObject(obj map[string]interface{}, prefix string, included bool) (map[string]interface{}, bool) {
+	filtered := make(map[string]interface{})
+	for name, value := range obj {
+		path := prefix + name
+		if matchAny(f.Excludes, path) {
+			continue
+		}
+		if v, ok := f.filterValue(value, path, included || matchAny(f.Includes, path)); ok {
+			filtered[name] = v
+		}
+	}
+	return filtered, included || len(filtered) > 0
+}
+
+func (f *FetchSource) filterValue(value interface{}, path string, included bool) (interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return f.filterObject(v, path+".", included)
+	case []interface{}:
+		var filtered []interface{}
+		for _, elem := range v {
+			if e, ok := f.filterValue(elem, path, included); ok {
+				filtered = append(filtered, e)
+			}
+		}
+		if included && filtered == nil {
+			filtered = []interface{}{}
+		}
+		return filtered, included || len(filtered) > 0
+	default:
+		return value, included
+	}
+}
+
+func matchAny(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		if WildcardMatch(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// WildcardMatch reports whether s matches pattern, where * matches any sequence of characters.
+func WildcardMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFetchSourceFilter(t *testing.T) {
+	source := []byte(`{"title": "baud", "user": {"name": "jack", "age": 18}, "tags": [{"name": "a", "id": 1}], "price": 12345678901234567}`)
+	groups := []struct {
+		input  string
+		output string
+	}{
+		{`true`, string(source)},
+		{`false`, ``},
+		{`"title"`, `{"title": "baud"}`},
+		{`["user.*", "price"]`, `{"user": {"name": "jack", "age": 18}, "price": 12345678901234567}`},
+		{`{"includes": ["*.name"]}`, `{"user": {"name": "jack"}, "tags": [{"name": "a"}]}`},
+		{`{"excludes": ["user.age", "tags"]}`, `{"title": "baud", "user": {"name": "jack"}, "price": 12345678901234567}`},
+		{`{"includes": "user", "excludes": "*.age"}`, `{"user": {"name": "jack"}}`},
+	}
+	for _, group := range groups {
+		f, err := ParseFetchSource([]byte(group.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		filtered, err := f.Filter(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if group.output == "" {
+			if filtered != nil {
+				t.Fatalf("expect no source for %s, got %s", group.input, filtered)
+			}
+			continue
+		}
+		var expect, actual interface{}
+		json.Unmarshal([]byte(group.output), &expect)
+		json.Unmarshal(filtered, &actual)
+		if !reflect.DeepEqual(expect, actual) {
+			t.Fatalf("filter %s: expect %s, got %s", group.input, group.output, filtered)
+		}
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	groups := []struct {
+		pattern, s string
+		match      bool
+	}{
+		{"user", "user", true},
+		{"user", "user.name", false},
+		{"user.*", "user.name", true},
+		{"*.name", "user.name", true},
+		{"u*r*e", "user.name", true},
+		{"u*x", "user", false},
+		{"*", "", true},
+	}
+	for _, group := range groups {
+		if WildcardMatch(group.pattern, group.s) != group.match {
+			t.Fatalf("match %s against %s should be %v", group.s, group.pattern, group.match)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package engine
+
+// DocVersion is the version of a document. Version counts the writes of the document,
+// SeqNo is the raft index of the last write and PrimaryTerm is the partition epoch version of that write.
+type DocVersion struct {
+	Version     uint64 `json:"_version,omitempty"`
+	SeqNo       uint64 `json:"_seq_no,omitempty"`
+	PrimaryTerm uint64 `json:"_primary_term,omitempty"`
+}
+
+// VersionedDocument is the raw json of a document written along with its version.
+type VersionedDocument struct {
+	Source []byte
+	DocVersion
+}
@@ -32,6 +32,7 @@ const (
 	PARTITION_NUM   = "partition_num"
 	PARTITION_ID    = "partition_id"
 	SPACE_SCHEMA    = "space_schema"
+	SPACE_MAPPING   = "space_mapping"
 	REPLICA_ID      = "replica_id"
 )
 
@@ -103,6 +104,7 @@ func (s *ApiServer) initAdminHandler() {
 	s.httpServer.Handle(netutil.PUT, "/manage/space/rename", s.handleSpaceRename)
 	s.httpServer.Handle(netutil.GET, "/manage/space/list", s.handleSpaceList)
 	s.httpServer.Handle(netutil.GET, "/manage/space/detail", s.handleSpaceDetail)
+	s.httpServer.Handle(netutil.PUT, "/manage/space/mapping", s.handleSpaceMapping)
 
 	s.httpServer.Handle(netutil.GET, "/manage/partition/list", s.handlePartitionList)
 	s.httpServer.Handle(netutil.GET, "/manage/partition/detail", s.handlePartitionDetail)
@@ -393,6 +395,33 @@ func (s *ApiServer) handleSpaceDetail(w http.ResponseWriter, r *http.Request, pa
 	sendReply(w, newHttpSucReply(space))
 }
 
+func (s *ApiServer) handleSpaceMapping(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	spaceName, err := checkMissingParam(w, r, SPACE_NAME)
+	if err != nil {
+		return
+	}
+	spaceMapping, err := checkMissingParam(w, r, SPACE_MAPPING)
+	if err != nil {
+		return
+	}
+
+	space, err := s.cluster.PutSpaceMapping(dbName, spaceName, spaceMapping)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(space))
+}
+
 func (s *ApiServer) handlePartitionList(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
 	partitions := s.cluster.PartitionCache.GetAllPartitions()
 	sendReply(w, newHttpSucReply(partitions))
@@ -496,6 +525,13 @@ func newHttpErrReply(err error) *HttpReply {
 		return newHttpSucReply("")
 	}
 
+	if _, ok := err.(*MappingError); ok {
+		return &HttpReply{
+			Code: ERRCODE_INVALID_MAPPING,
+			Msg:  err.Error(),
+		}
+	}
+
 	code, ok := Err2CodeMap[err]
 	if ok {
 		return &HttpReply{
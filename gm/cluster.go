@@ -513,6 +513,44 @@ func (c *Cluster) RenameSpace(dbName, srcSpaceName, destSpaceName string) error
 	return nil
 }
 
+// PutSpaceMapping adds the mapping to the schema of the space and bumps its schema version.
+// The schema is not ordered by the raft logs of the partitions: every replica applies it on its own
+// when its partition server sees the space updated in the topo, so the replicas may index by different
+// schema versions for a while. Only the additive changes are allowed and a schema is validated before
+// it is saved, so the replicas never fail to apply it and converge to the latest version.
+func (c *Cluster) PutSpaceMapping(dbName, spaceName, mapping string) (*Space, error) {
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	db := c.DbCache.FindDbByName(dbName)
+	if db == nil {
+		return nil, ErrDbNotExists
+	}
+	space := db.SpaceCache.FindSpaceByName(spaceName)
+	if space == nil {
+		return nil, ErrSpaceNotExists
+	}
+
+	schema, err := mergeMapping(space.Schema, mapping)
+	if err != nil {
+		log.Error("merge mapping of space[%s] error, err:[%v]", spaceName, err)
+		return nil, err
+	}
+	if err = validateSchema(schema); err != nil {
+		log.Error("invalid schema of space[%s], err:[%v]", spaceName, err)
+		return nil, err
+	}
+	oldSchema, oldVersion := space.putMapping(schema)
+	if err := space.update(); err != nil {
+		space.propertyLock.Lock()
+		space.Schema, space.SchemaVersion = oldSchema, oldVersion
+		space.propertyLock.Unlock()
+		return nil, err
+	}
+
+	return space, nil
+}
+
 // replica
 func (c *Cluster) CreateReplica(partitionId metapb.PartitionID, replicaZoneName string) error {
 	c.clusterLock.Lock()
@@ -74,6 +74,8 @@ const (
 
 	ERRCODE_METHOD_NOT_IMPLEMENT
 
+	ERRCODE_INVALID_MAPPING
+
 //	ERRCODE_UNKNOWN_RAFTCMDTYPE
 )
 
@@ -0,0 +1,173 @@
+package gm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/tiglabs/baudengine/engine/bleve"
+)
+
+// MappingError reports a mapping change which is not allowed on an existing space.
+type MappingError struct {
+	Path   string
+	Reason string
+}
+
+func (e *MappingError) Error() string {
+	return fmt.Sprintf("invalid mapping of [%s]: %s", e.Path, e.Reason)
+}
+
+/*
+mergeMapping adds the mapping to the schema of a space and returns the new schema, the mapping looks like
+
+{
+    "mappings": {
+        "blogpost": {
+            "properties": {
+                "tags": { "type": "keyword" },
+                "title": { "type": "text", "fields": { "raw": { "type": "keyword" } } }
+            }
+        }
+    }
+}
+
+Only additive changes are allowed: new types, new fields and new sub-fields, the mapped fields can not change,
+so the analyzer can only be set on the new fields. The settings of the types such as dynamic are replaced.
+*/
+func mergeMapping(schema, mapping string) (string, error) {
+	current := make(map[string]interface{})
+	if schema != "" {
+		if err := json.Unmarshal([]byte(schema), &current); err != nil {
+			return "", &MappingError{Path: "_schema", Reason: err.Error()}
+		}
+	}
+	update := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(mapping), &update); err != nil {
+		return "", &MappingError{Path: "mappings", Reason: err.Error()}
+	}
+	updateTypes, ok := update["mappings"].(map[string]interface{})
+	if !ok || len(updateTypes) == 0 {
+		return "", &MappingError{Path: "mappings", Reason: "no mappings"}
+	}
+	currentTypes, _ := current["mappings"].(map[string]interface{})
+	if currentTypes == nil {
+		currentTypes = make(map[string]interface{})
+		current["mappings"] = currentTypes
+	}
+
+	for name, value := range updateTypes {
+		typeMapping, ok := value.(map[string]interface{})
+		if !ok {
+			return "", &MappingError{Path: name, Reason: "mapping is not an object"}
+		}
+		currentType, ok := currentTypes[name].(map[string]interface{})
+		if !ok {
+			currentTypes[name] = typeMapping
+			continue
+		}
+		if err := mergeObject(name, currentType, typeMapping); err != nil {
+			return "", err
+		}
+	}
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// mergeObject merges the mapping of a type or an object property.
+func mergeObject(path string, current, update map[string]interface{}) error {
+	for key, value := range update {
+		if key != "properties" {
+			current[key] = value
+			continue
+		}
+		properties, ok := value.(map[string]interface{})
+		if !ok {
+			return &MappingError{Path: path, Reason: "properties is not an object"}
+		}
+		currentProperties, _ := current["properties"].(map[string]interface{})
+		if currentProperties == nil {
+			currentProperties = make(map[string]interface{})
+			current["properties"] = currentProperties
+		}
+		for name, property := range properties {
+			if err := mergeProperty(path+"."+name, currentProperties, name, property); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func mergeProperty(path string, properties map[string]interface{}, name string, value interface{}) error {
+	update, ok := value.(map[string]interface{})
+	if !ok {
+		return &MappingError{Path: path, Reason: "mapping is not an object"}
+	}
+	current, ok := properties[name].(map[string]interface{})
+	if !ok {
+		properties[name] = update
+		return nil
+	}
+	if isObjectProperty(current) != isObjectProperty(update) {
+		return &MappingError{Path: path, Reason: "can not change between object and field"}
+	}
+	if isObjectProperty(current) {
+		return mergeObject(path, current, update)
+	}
+
+	for key, v := range update {
+		if key == "fields" {
+			continue
+		}
+		if old, ok := current[key]; !ok || !reflect.DeepEqual(old, v) {
+			return &MappingError{Path: path, Reason: fmt.Sprintf("can not change [%s] of a mapped field", key)}
+		}
+	}
+	for key := range current {
+		if _, ok := update[key]; !ok && key != "fields" {
+			return &MappingError{Path: path, Reason: fmt.Sprintf("can not remove [%s] of a mapped field", key)}
+		}
+	}
+	fields, ok := update["fields"].(map[string]interface{})
+	if !ok {
+		if _, exist := update["fields"]; exist {
+			return &MappingError{Path: path, Reason: "fields is not an object"}
+		}
+		return nil
+	}
+	currentFields, _ := current["fields"].(map[string]interface{})
+	if currentFields == nil {
+		currentFields = make(map[string]interface{})
+		current["fields"] = currentFields
+	}
+	for sub, v := range fields {
+		if err := mergeProperty(path+"."+sub, currentFields, sub, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isObjectProperty(property map[string]interface{}) bool {
+	typ, _ := property["type"].(string)
+	_, hasProperties := property["properties"]
+	return typ == "object" || typ == "nested" || (typ == "" && hasProperties)
+}
+
+// validateSchema parses the schema the way the partition servers do. The servers apply the schema of a space
+// from their watches of the space in the topo, so they can not reject it, and a schema they fail to parse is rejected here.
+func validateSchema(schema string) error {
+	m, err := bleve.ParseSchema([]byte(schema))
+	if err == nil {
+		err = m.Validate()
+	}
+	if err != nil {
+		return &MappingError{Path: "mappings", Reason: err.Error()}
+	}
+	return nil
+}
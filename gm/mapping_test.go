@@ -0,0 +1,75 @@
+package gm
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMergeMapping(t *testing.T) {
+	schema := `{"mappings": {"blogpost": {"properties": {
+		"title": {"type": "text", "analyzer": "standard"},
+		"user": {"properties": {"name": {"type": "keyword"}}}
+	}}}}`
+
+	merged, err := mergeMapping(schema, `{"mappings": {
+		"blogpost": {"dynamic": "strict", "properties": {
+			"title": {"type": "text", "analyzer": "standard", "fields": {"raw": {"type": "keyword"}}},
+			"tags": {"type": "text", "analyzer": "whitespace"},
+			"user": {"properties": {"age": {"type": "integer"}}}
+		}},
+		"comment": {"properties": {"body": {"type": "text"}}}
+	}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `{"mappings": {
+		"blogpost": {"dynamic": "strict", "properties": {
+			"title": {"type": "text", "analyzer": "standard", "fields": {"raw": {"type": "keyword"}}},
+			"tags": {"type": "text", "analyzer": "whitespace"},
+			"user": {"properties": {"name": {"type": "keyword"}, "age": {"type": "integer"}}}
+		}},
+		"comment": {"properties": {"body": {"type": "text"}}}
+	}}`
+	var actualValue, expectValue interface{}
+	json.Unmarshal([]byte(merged), &actualValue)
+	json.Unmarshal([]byte(expect), &expectValue)
+	if !reflect.DeepEqual(actualValue, expectValue) {
+		t.Fatalf("invalid merged schema %s", merged)
+	}
+
+	for _, mapping := range []string{
+		`{"mappings": {}}`,
+		`{"mappings": {"blogpost": {"properties": {"title": {"type": "keyword"}}}}}`,
+		`{"mappings": {"blogpost": {"properties": {"title": {"type": "text", "analyzer": "english"}}}}}`,
+		`{"mappings": {"blogpost": {"properties": {"title": {"type": "text"}}}}}`,
+		`{"mappings": {"blogpost": {"properties": {"user": {"type": "keyword"}}}}}`,
+		`{"mappings": {"blogpost": {"properties": {"user": {"properties": {"name": {"type": "text"}}}}}}}`,
+	} {
+		if _, err := mergeMapping(schema, mapping); err == nil {
+			t.Fatalf("expect error of mapping %s", mapping)
+		} else if _, ok := err.(*MappingError); !ok {
+			t.Fatalf("invalid error %v", err)
+		}
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	if err := validateSchema(`{"mappings": {"blogpost": {"properties": {
+		"title": {"type": "text", "analyzer": "standard", "fields": {"raw": {"type": "keyword"}}},
+		"views": {"type": "long"}
+	}}}}`); err != nil {
+		t.Fatal(err)
+	}
+	for _, schema := range []string{
+		`{"mappings": {"blogpost": {"properties": {"title": {"type": "bogus"}}}}}`,
+		`{"mappings": {"blogpost": {"properties": {"title": {"type": "text", "analyzer": "bogus"}}}}}`,
+		`{"mappings": {"blogpost": {"properties": {"title": "text"}}}}`,
+	} {
+		if err := validateSchema(schema); err == nil {
+			t.Fatalf("expect error of schema %s", schema)
+		} else if _, ok := err.(*MappingError); !ok {
+			t.Fatalf("invalid error %v", err)
+		}
+	}
+}
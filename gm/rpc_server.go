@@ -97,3 +97,24 @@ func (s *RpcServer) GetSpace(ctx context.Context, req *masterpb.GetSpaceRequest)
 	resp.ResponseHeader = *makeRpcRespHeader(ErrSuc)
 	return resp, nil
 }
+
+func (s *RpcServer) GetSpaces(ctx context.Context, req *masterpb.GetSpacesRequest) (*masterpb.GetSpacesResponse, error) {
+	resp := new(masterpb.GetSpacesResponse)
+
+	dbs := s.cluster.DbCache.GetAllDBs()
+	if req.DBName != "" {
+		db := s.cluster.DbCache.FindDbByName(req.DBName)
+		if db == nil {
+			resp.ResponseHeader = *makeRpcRespHeader(ErrDbNotExists)
+			return resp, nil
+		}
+		dbs = []*DB{db}
+	}
+	for _, db := range dbs {
+		for _, space := range db.SpaceCache.GetAllSpaces() {
+			resp.Spaces = append(resp.Spaces, *space.Space)
+		}
+	}
+	resp.ResponseHeader = *makeRpcRespHeader(ErrSuc)
+	return resp, nil
+}
@@ -36,12 +36,13 @@ func NewSpace(dbId metapb.DBID, dbName, spaceName, spaceSchema string, policy *P
 	}
 
 	spaceMeta := &metapb.Space{
-		Name:   spaceName,
-		Schema: spaceSchema,
-		ID:     metapb.SpaceID(spaceId),
-		DB:     dbId,
-		DbName: dbName,
-		Status: metapb.SS_Init,
+		Name:          spaceName,
+		Schema:        spaceSchema,
+		ID:            metapb.SpaceID(spaceId),
+		DB:            dbId,
+		DbName:        dbName,
+		Status:        metapb.SS_Init,
+		SchemaVersion: 1,
 		KeyPolicy: &metapb.KeyPolicy{
 			KeyField: policy.Key,
 			KeyFunc:  policy.Function,
@@ -130,6 +131,17 @@ func (s *Space) rename(newName string) {
 	s.Name = newName
 }
 
+// putMapping replaces the schema and bumps its version, it returns the old schema and version.
+func (s *Space) putMapping(schema string) (string, uint64) {
+	s.propertyLock.Lock()
+	defer s.propertyLock.Unlock()
+
+	oldSchema, oldVersion := s.Schema, s.SchemaVersion
+	s.Schema = schema
+	s.SchemaVersion++
+	return oldSchema, oldVersion
+}
+
 // SpaceCache
 
 type SpaceCache struct {
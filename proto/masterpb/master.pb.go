@@ -15,6 +15,8 @@
 		GetDBResponse
 		GetSpaceRequest
 		GetSpaceResponse
+		GetSpacesRequest
+		GetSpacesResponse
 		GetRouteRequest
 		GetRouteResponse
 		PSRegisterRequest
@@ -156,6 +158,25 @@ func (m *GetSpaceResponse) Reset()                    { *m = GetSpaceResponse{}
 func (*GetSpaceResponse) ProtoMessage()               {}
 func (*GetSpaceResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{6} }
 
+type GetSpacesRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the spaces of all the dbs if empty
+	DBName string `protobuf:"bytes,2,opt,name=DB_name,json=DBName,proto3" json:"DB_name,omitempty"`
+}
+
+func (m *GetSpacesRequest) Reset()                    { *m = GetSpacesRequest{} }
+func (*GetSpacesRequest) ProtoMessage()               {}
+func (*GetSpacesRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{7} }
+
+type GetSpacesResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Spaces              []meta.Space `protobuf:"bytes,2,rep,name=spaces" json:"spaces"`
+}
+
+func (m *GetSpacesResponse) Reset()                    { *m = GetSpacesResponse{} }
+func (*GetSpacesResponse) ProtoMessage()               {}
+func (*GetSpacesResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{8} }
+
 type GetRouteRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	DB                 github_com_tiglabs_baudengine_proto_metapb.DBID    `protobuf:"varint,2,opt,name=db,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.DBID" json:"db,omitempty"`
@@ -165,7 +186,7 @@ type GetRouteRequest struct {
 
 func (m *GetRouteRequest) Reset()                    { *m = GetRouteRequest{} }
 func (*GetRouteRequest) ProtoMessage()               {}
-func (*GetRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{7} }
+func (*GetRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{9} }
 
 type GetRouteResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -174,7 +195,7 @@ type GetRouteResponse struct {
 
 func (m *GetRouteResponse) Reset()                    { *m = GetRouteResponse{} }
 func (*GetRouteResponse) ProtoMessage()               {}
-func (*GetRouteResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{8} }
+func (*GetRouteResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{10} }
 
 type PSRegisterRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -185,7 +206,7 @@ type PSRegisterRequest struct {
 
 func (m *PSRegisterRequest) Reset()                    { *m = PSRegisterRequest{} }
 func (*PSRegisterRequest) ProtoMessage()               {}
-func (*PSRegisterRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{9} }
+func (*PSRegisterRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{11} }
 
 type PSRegisterResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -196,7 +217,7 @@ type PSRegisterResponse struct {
 
 func (m *PSRegisterResponse) Reset()                    { *m = PSRegisterResponse{} }
 func (*PSRegisterResponse) ProtoMessage()               {}
-func (*PSRegisterResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{10} }
+func (*PSRegisterResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{12} }
 
 type CreatePartitionRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -205,7 +226,7 @@ type CreatePartitionRequest struct {
 
 func (m *CreatePartitionRequest) Reset()                    { *m = CreatePartitionRequest{} }
 func (*CreatePartitionRequest) ProtoMessage()               {}
-func (*CreatePartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{11} }
+func (*CreatePartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{13} }
 
 type CreatePartitionResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -214,7 +235,7 @@ type CreatePartitionResponse struct {
 
 func (m *CreatePartitionResponse) Reset()                    { *m = CreatePartitionResponse{} }
 func (*CreatePartitionResponse) ProtoMessage()               {}
-func (*CreatePartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{12} }
+func (*CreatePartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{14} }
 
 type DeletePartitionRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -224,7 +245,7 @@ type DeletePartitionRequest struct {
 
 func (m *DeletePartitionRequest) Reset()                    { *m = DeletePartitionRequest{} }
 func (*DeletePartitionRequest) ProtoMessage()               {}
-func (*DeletePartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{13} }
+func (*DeletePartitionRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{15} }
 
 type DeletePartitionResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -232,7 +253,7 @@ type DeletePartitionResponse struct {
 
 func (m *DeletePartitionResponse) Reset()                    { *m = DeletePartitionResponse{} }
 func (*DeletePartitionResponse) ProtoMessage()               {}
-func (*DeletePartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{14} }
+func (*DeletePartitionResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{16} }
 
 type ChangeReplicaRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -243,7 +264,7 @@ type ChangeReplicaRequest struct {
 
 func (m *ChangeReplicaRequest) Reset()                    { *m = ChangeReplicaRequest{} }
 func (*ChangeReplicaRequest) ProtoMessage()               {}
-func (*ChangeReplicaRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{15} }
+func (*ChangeReplicaRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{17} }
 
 type ChangeReplicaResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -251,7 +272,7 @@ type ChangeReplicaResponse struct {
 
 func (m *ChangeReplicaResponse) Reset()                    { *m = ChangeReplicaResponse{} }
 func (*ChangeReplicaResponse) ProtoMessage()               {}
-func (*ChangeReplicaResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{16} }
+func (*ChangeReplicaResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{18} }
 
 type ChangeLeaderRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -260,7 +281,7 @@ type ChangeLeaderRequest struct {
 
 func (m *ChangeLeaderRequest) Reset()                    { *m = ChangeLeaderRequest{} }
 func (*ChangeLeaderRequest) ProtoMessage()               {}
-func (*ChangeLeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{17} }
+func (*ChangeLeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{19} }
 
 type ChangeLeaderResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -268,7 +289,7 @@ type ChangeLeaderResponse struct {
 
 func (m *ChangeLeaderResponse) Reset()                    { *m = ChangeLeaderResponse{} }
 func (*ChangeLeaderResponse) ProtoMessage()               {}
-func (*ChangeLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{18} }
+func (*ChangeLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{20} }
 
 type PSConfig struct {
 	RPCPort                 int    `protobuf:"varint,1,opt,name=rpc_port,json=rpcPort,proto3,casttype=int" json:"rpc_port,omitempty"`
@@ -284,7 +305,7 @@ type PSConfig struct {
 
 func (m *PSConfig) Reset()                    { *m = PSConfig{} }
 func (*PSConfig) ProtoMessage()               {}
-func (*PSConfig) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{19} }
+func (*PSConfig) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{21} }
 
 type PSHeartbeatRequest struct {
 	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -295,7 +316,7 @@ type PSHeartbeatRequest struct {
 
 func (m *PSHeartbeatRequest) Reset()                    { *m = PSHeartbeatRequest{} }
 func (*PSHeartbeatRequest) ProtoMessage()               {}
-func (*PSHeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{20} }
+func (*PSHeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{22} }
 
 type PSHeartbeatResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -303,7 +324,7 @@ type PSHeartbeatResponse struct {
 
 func (m *PSHeartbeatResponse) Reset()                    { *m = PSHeartbeatResponse{} }
 func (*PSHeartbeatResponse) ProtoMessage()               {}
-func (*PSHeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{21} }
+func (*PSHeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{23} }
 
 type PartitionInfo struct {
 	ID         github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"id,omitempty"`
@@ -316,7 +337,7 @@ type PartitionInfo struct {
 
 func (m *PartitionInfo) Reset()                    { *m = PartitionInfo{} }
 func (*PartitionInfo) ProtoMessage()               {}
-func (*PartitionInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{22} }
+func (*PartitionInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{24} }
 
 type RuntimeInfo struct {
 	AppVersion string `protobuf:"bytes,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
@@ -327,7 +348,7 @@ type RuntimeInfo struct {
 
 func (m *RuntimeInfo) Reset()                    { *m = RuntimeInfo{} }
 func (*RuntimeInfo) ProtoMessage()               {}
-func (*RuntimeInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{23} }
+func (*RuntimeInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{25} }
 
 type RaftStatus struct {
 	meta.Replica `protobuf:"bytes,1,opt,name=replica,embedded=replica" json:"replica"`
@@ -340,7 +361,7 @@ type RaftStatus struct {
 
 func (m *RaftStatus) Reset()                    { *m = RaftStatus{} }
 func (*RaftStatus) ProtoMessage()               {}
-func (*RaftStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{24} }
+func (*RaftStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{26} }
 
 type RaftFollowerStatus struct {
 	meta.Replica `protobuf:"bytes,1,opt,name=replica,embedded=replica" json:"replica"`
@@ -353,7 +374,7 @@ type RaftFollowerStatus struct {
 
 func (m *RaftFollowerStatus) Reset()                    { *m = RaftFollowerStatus{} }
 func (*RaftFollowerStatus) ProtoMessage()               {}
-func (*RaftFollowerStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{25} }
+func (*RaftFollowerStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{27} }
 
 type NodeSysStats struct {
 	// Memory
@@ -390,7 +411,7 @@ type NodeSysStats struct {
 
 func (m *NodeSysStats) Reset()                    { *m = NodeSysStats{} }
 func (*NodeSysStats) ProtoMessage()               {}
-func (*NodeSysStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{26} }
+func (*NodeSysStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{28} }
 
 type PartitionStats struct {
 	Size_                  uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
@@ -403,7 +424,7 @@ type PartitionStats struct {
 
 func (m *PartitionStats) Reset()                    { *m = PartitionStats{} }
 func (*PartitionStats) ProtoMessage()               {}
-func (*PartitionStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{27} }
+func (*PartitionStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{29} }
 
 func init() {
 	proto.RegisterType((*GMaster)(nil), "GMaster")
@@ -413,6 +434,8 @@ func init() {
 	proto.RegisterType((*GetDBResponse)(nil), "GetDBResponse")
 	proto.RegisterType((*GetSpaceRequest)(nil), "GetSpaceRequest")
 	proto.RegisterType((*GetSpaceResponse)(nil), "GetSpaceResponse")
+	proto.RegisterType((*GetSpacesRequest)(nil), "GetSpacesRequest")
+	proto.RegisterType((*GetSpacesResponse)(nil), "GetSpacesResponse")
 	proto.RegisterType((*GetRouteRequest)(nil), "GetRouteRequest")
 	proto.RegisterType((*GetRouteResponse)(nil), "GetRouteResponse")
 	proto.RegisterType((*PSRegisterRequest)(nil), "PSRegisterRequest")
@@ -642,6 +665,65 @@ func (this *GetSpaceResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GetSpacesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetSpacesRequest)
+	if !ok {
+		that2, ok := that.(GetSpacesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.DBName != that1.DBName {
+		return false
+	}
+	return true
+}
+func (this *GetSpacesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetSpacesResponse)
+	if !ok {
+		that2, ok := that.(GetSpacesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if len(this.Spaces) != len(that1.Spaces) {
+		return false
+	}
+	for i := range this.Spaces {
+		if !this.Spaces[i].Equal(&that1.Spaces[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GetRouteRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1384,6 +1466,7 @@ type MasterRpcClient interface {
 	PSHeartbeat(ctx context.Context, in *PSHeartbeatRequest, opts ...grpc.CallOption) (*PSHeartbeatResponse, error)
 	GetDB(ctx context.Context, in *GetDBRequest, opts ...grpc.CallOption) (*GetDBResponse, error)
 	GetSpace(ctx context.Context, in *GetSpaceRequest, opts ...grpc.CallOption) (*GetSpaceResponse, error)
+	GetSpaces(ctx context.Context, in *GetSpacesRequest, opts ...grpc.CallOption) (*GetSpacesResponse, error)
 	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*CreatePartitionResponse, error)
 	DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error)
 	ChangeReplica(ctx context.Context, in *ChangeReplicaRequest, opts ...grpc.CallOption) (*ChangeReplicaResponse, error)
@@ -1443,6 +1526,15 @@ func (c *masterRpcClient) GetSpace(ctx context.Context, in *GetSpaceRequest, opt
 	return out, nil
 }
 
+func (c *masterRpcClient) GetSpaces(ctx context.Context, in *GetSpacesRequest, opts ...grpc.CallOption) (*GetSpacesResponse, error) {
+	out := new(GetSpacesResponse)
+	err := grpc.Invoke(ctx, "/MasterRpc/GetSpaces", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *masterRpcClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*CreatePartitionResponse, error) {
 	out := new(CreatePartitionResponse)
 	err := grpc.Invoke(ctx, "/MasterRpc/CreatePartition", in, out, c.cc, opts...)
@@ -1487,6 +1579,7 @@ type MasterRpcServer interface {
 	PSHeartbeat(context.Context, *PSHeartbeatRequest) (*PSHeartbeatResponse, error)
 	GetDB(context.Context, *GetDBRequest) (*GetDBResponse, error)
 	GetSpace(context.Context, *GetSpaceRequest) (*GetSpaceResponse, error)
+	GetSpaces(context.Context, *GetSpacesRequest) (*GetSpacesResponse, error)
 	CreatePartition(context.Context, *CreatePartitionRequest) (*CreatePartitionResponse, error)
 	DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error)
 	ChangeReplica(context.Context, *ChangeReplicaRequest) (*ChangeReplicaResponse, error)
@@ -1587,6 +1680,24 @@ func _MasterRpc_GetSpace_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterRpc_GetSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterRpcServer).GetSpaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MasterRpc/GetSpaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterRpcServer).GetSpaces(ctx, req.(*GetSpacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MasterRpc_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePartitionRequest)
 	if err := dec(in); err != nil {
@@ -1683,6 +1794,10 @@ var _MasterRpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSpace",
 			Handler:    _MasterRpc_GetSpace_Handler,
 		},
+		{
+			MethodName: "GetSpaces",
+			Handler:    _MasterRpc_GetSpaces_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _MasterRpc_CreatePartition_Handler,
@@ -1709,6 +1824,7 @@ var _MasterRpc_serviceDesc = grpc.ServiceDesc{
 type GMRpcClient interface {
 	GetDB(ctx context.Context, in *GetDBRequest, opts ...grpc.CallOption) (*GetDBResponse, error)
 	GetSpace(ctx context.Context, in *GetSpaceRequest, opts ...grpc.CallOption) (*GetSpaceResponse, error)
+	GetSpaces(ctx context.Context, in *GetSpacesRequest, opts ...grpc.CallOption) (*GetSpacesResponse, error)
 }
 
 type gMRpcClient struct {
@@ -1737,11 +1853,21 @@ func (c *gMRpcClient) GetSpace(ctx context.Context, in *GetSpaceRequest, opts ..
 	return out, nil
 }
 
+func (c *gMRpcClient) GetSpaces(ctx context.Context, in *GetSpacesRequest, opts ...grpc.CallOption) (*GetSpacesResponse, error) {
+	out := new(GetSpacesResponse)
+	err := grpc.Invoke(ctx, "/GMRpc/GetSpaces", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for GMRpc service
 
 type GMRpcServer interface {
 	GetDB(context.Context, *GetDBRequest) (*GetDBResponse, error)
 	GetSpace(context.Context, *GetSpaceRequest) (*GetSpaceResponse, error)
+	GetSpaces(context.Context, *GetSpacesRequest) (*GetSpacesResponse, error)
 }
 
 func RegisterGMRpcServer(s *grpc.Server, srv GMRpcServer) {
@@ -1784,6 +1910,24 @@ func _GMRpc_GetSpace_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GMRpc_GetSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GMRpcServer).GetSpaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GMRpc/GetSpaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GMRpcServer).GetSpaces(ctx, req.(*GetSpacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GMRpc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "GMRpc",
 	HandlerType: (*GMRpcServer)(nil),
@@ -1796,6 +1940,10 @@ var _GMRpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSpace",
 			Handler:    _GMRpc_GetSpace_Handler,
 		},
+		{
+			MethodName: "GetSpaces",
+			Handler:    _GMRpc_GetSpaces_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "master.proto",
@@ -2049,7 +2197,7 @@ func (m *GetSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetRouteRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetSpacesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2059,7 +2207,7 @@ func (m *GetRouteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetSpacesRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2072,6 +2220,76 @@ func (m *GetRouteRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n8
+	if len(m.DBName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.DBName)))
+		i += copy(dAtA[i:], m.DBName)
+	}
+	return i, nil
+}
+
+func (m *GetSpacesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSpacesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n9, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n9
+	if len(m.Spaces) > 0 {
+		for _, msg := range m.Spaces {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
+	n10, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n10
 	if m.DB != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2108,11 +2326,11 @@ func (m *GetRouteResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n9, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n11, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n11
 	if len(m.Routes) > 0 {
 		for _, msg := range m.Routes {
 			dAtA[i] = 0x12
@@ -2146,11 +2364,11 @@ func (m *PSRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n10, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n12, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n12
 	if m.NodeID != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2165,11 +2383,11 @@ func (m *PSRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RuntimeInfo.Size()))
-	n11, err := m.RuntimeInfo.MarshalTo(dAtA[i:])
+	n13, err := m.RuntimeInfo.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n13
 	return i, nil
 }
 
@@ -2191,11 +2409,11 @@ func (m *PSRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n12, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n14, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n14
 	if m.NodeID != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2234,19 +2452,19 @@ func (m *CreatePartitionRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n13, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n15, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n15
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Partition.Size()))
-	n14, err := m.Partition.MarshalTo(dAtA[i:])
+	n16, err := m.Partition.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n16
 	return i, nil
 }
 
@@ -2268,19 +2486,19 @@ func (m *CreatePartitionResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n15, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n17, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n17
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
-	n16, err := m.Replica.MarshalTo(dAtA[i:])
+	n18, err := m.Replica.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n18
 	return i, nil
 }
 
@@ -2302,11 +2520,11 @@ func (m *DeletePartitionRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n17, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n19, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n19
 	if m.PartitionID != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2338,11 +2556,11 @@ func (m *DeletePartitionResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n18, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n20, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n20
 	return i, nil
 }
 
@@ -2364,11 +2582,11 @@ func (m *ChangeReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n19, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n21, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n21
 	if m.Type != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2382,11 +2600,11 @@ func (m *ChangeReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
-	n20, err := m.Replica.MarshalTo(dAtA[i:])
+	n22, err := m.Replica.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n22
 	return i, nil
 }
 
@@ -2408,11 +2626,11 @@ func (m *ChangeReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n21, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n23, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n23
 	return i, nil
 }
 
@@ -2434,11 +2652,11 @@ func (m *ChangeLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n22, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n24, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n24
 	if m.PartitionID != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2465,11 +2683,11 @@ func (m *ChangeLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n23, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n25, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n25
 	return i, nil
 }
 
@@ -2554,11 +2772,11 @@ func (m *PSHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
-	n24, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	n26, err := m.RequestHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n26
 	if m.NodeID != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2579,11 +2797,11 @@ func (m *PSHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.SysStats.Size()))
-	n25, err := m.SysStats.MarshalTo(dAtA[i:])
+	n27, err := m.SysStats.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n27
 	return i, nil
 }
 
@@ -2605,11 +2823,11 @@ func (m *PSHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n26, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	n28, err := m.ResponseHeader.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n28
 	return i, nil
 }
 
@@ -2651,28 +2869,28 @@ func (m *PartitionInfo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Epoch.Size()))
-	n27, err := m.Epoch.MarshalTo(dAtA[i:])
+	n29, err := m.Epoch.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n29
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Statistics.Size()))
-	n28, err := m.Statistics.MarshalTo(dAtA[i:])
+	n30, err := m.Statistics.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n30
 	if m.RaftStatus != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintMaster(dAtA, i, uint64(m.RaftStatus.Size()))
-		n29, err := m.RaftStatus.MarshalTo(dAtA[i:])
+		n31, err := m.RaftStatus.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n31
 	}
 	return i, nil
 }
@@ -2737,11 +2955,11 @@ func (m *RaftStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
-	n30, err := m.Replica.MarshalTo(dAtA[i:])
+	n32, err := m.Replica.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n32
 	if m.Term != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2795,11 +3013,11 @@ func (m *RaftFollowerStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMaster(dAtA, i, uint64(m.Replica.Size()))
-	n31, err := m.Replica.MarshalTo(dAtA[i:])
+	n33, err := m.Replica.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n33
 	if m.Match != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -3084,10 +3302,37 @@ func NewPopulatedGetSpaceResponse(r randyMaster, easy bool) *GetSpaceResponse {
 	return this
 }
 
-func NewPopulatedGetRouteRequest(r randyMaster, easy bool) *GetRouteRequest {
-	this := &GetRouteRequest{}
+func NewPopulatedGetSpacesRequest(r randyMaster, easy bool) *GetSpacesRequest {
+	this := &GetSpacesRequest{}
 	v9 := meta.NewPopulatedRequestHeader(r, easy)
 	this.RequestHeader = *v9
+	this.DBName = string(randStringMaster(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetSpacesResponse(r randyMaster, easy bool) *GetSpacesResponse {
+	this := &GetSpacesResponse{}
+	v10 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v10
+	if r.Intn(10) != 0 {
+		v11 := r.Intn(5)
+		this.Spaces = make([]meta.Space, v11)
+		for i := 0; i < v11; i++ {
+			v12 := meta.NewPopulatedSpace(r, easy)
+			this.Spaces[i] = *v12
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetRouteRequest(r randyMaster, easy bool) *GetRouteRequest {
+	this := &GetRouteRequest{}
+	v13 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v13
 	this.DB = github_com_tiglabs_baudengine_proto_metapb.DBID(r.Uint32())
 	this.Space = github_com_tiglabs_baudengine_proto_metapb.SpaceID(r.Uint32())
 	this.Slot = github_com_tiglabs_baudengine_proto_metapb.SlotID(r.Uint32())
@@ -3098,14 +3343,14 @@ func NewPopulatedGetRouteRequest(r randyMaster, easy bool) *GetRouteRequest {
 
 func NewPopulatedGetRouteResponse(r randyMaster, easy bool) *GetRouteResponse {
 	this := &GetRouteResponse{}
-	v10 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v10
+	v14 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v14
 	if r.Intn(10) != 0 {
-		v11 := r.Intn(5)
-		this.Routes = make([]Route, v11)
-		for i := 0; i < v11; i++ {
-			v12 := NewPopulatedRoute(r, easy)
-			this.Routes[i] = *v12
+		v15 := r.Intn(5)
+		this.Routes = make([]Route, v15)
+		for i := 0; i < v15; i++ {
+			v16 := NewPopulatedRoute(r, easy)
+			this.Routes[i] = *v16
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3115,12 +3360,12 @@ func NewPopulatedGetRouteResponse(r randyMaster, easy bool) *GetRouteResponse {
 
 func NewPopulatedPSRegisterRequest(r randyMaster, easy bool) *PSRegisterRequest {
 	this := &PSRegisterRequest{}
-	v13 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v13
+	v17 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v17
 	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
 	this.Ip = string(randStringMaster(r))
-	v14 := NewPopulatedRuntimeInfo(r, easy)
-	this.RuntimeInfo = *v14
+	v18 := NewPopulatedRuntimeInfo(r, easy)
+	this.RuntimeInfo = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3128,15 +3373,15 @@ func NewPopulatedPSRegisterRequest(r randyMaster, easy bool) *PSRegisterRequest
 
 func NewPopulatedPSRegisterResponse(r randyMaster, easy bool) *PSRegisterResponse {
 	this := &PSRegisterResponse{}
-	v15 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v15
+	v19 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v19
 	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
 	if r.Intn(10) != 0 {
-		v16 := r.Intn(5)
-		this.Partitions = make([]meta.Partition, v16)
-		for i := 0; i < v16; i++ {
-			v17 := meta.NewPopulatedPartition(r, easy)
-			this.Partitions[i] = *v17
+		v20 := r.Intn(5)
+		this.Partitions = make([]meta.Partition, v20)
+		for i := 0; i < v20; i++ {
+			v21 := meta.NewPopulatedPartition(r, easy)
+			this.Partitions[i] = *v21
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3146,10 +3391,10 @@ func NewPopulatedPSRegisterResponse(r randyMaster, easy bool) *PSRegisterRespons
 
 func NewPopulatedCreatePartitionRequest(r randyMaster, easy bool) *CreatePartitionRequest {
 	this := &CreatePartitionRequest{}
-	v18 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v18
-	v19 := meta.NewPopulatedPartition(r, easy)
-	this.Partition = *v19
+	v22 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v22
+	v23 := meta.NewPopulatedPartition(r, easy)
+	this.Partition = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3157,10 +3402,10 @@ func NewPopulatedCreatePartitionRequest(r randyMaster, easy bool) *CreatePartiti
 
 func NewPopulatedCreatePartitionResponse(r randyMaster, easy bool) *CreatePartitionResponse {
 	this := &CreatePartitionResponse{}
-	v20 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v20
-	v21 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v21
+	v24 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v24
+	v25 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v25
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3168,8 +3413,8 @@ func NewPopulatedCreatePartitionResponse(r randyMaster, easy bool) *CreatePartit
 
 func NewPopulatedDeletePartitionRequest(r randyMaster, easy bool) *DeletePartitionRequest {
 	this := &DeletePartitionRequest{}
-	v22 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v22
+	v26 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v26
 	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
 	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
@@ -3179,8 +3424,8 @@ func NewPopulatedDeletePartitionRequest(r randyMaster, easy bool) *DeletePartiti
 
 func NewPopulatedDeletePartitionResponse(r randyMaster, easy bool) *DeletePartitionResponse {
 	this := &DeletePartitionResponse{}
-	v23 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v23
+	v27 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v27
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3188,12 +3433,12 @@ func NewPopulatedDeletePartitionResponse(r randyMaster, easy bool) *DeletePartit
 
 func NewPopulatedChangeReplicaRequest(r randyMaster, easy bool) *ChangeReplicaRequest {
 	this := &ChangeReplicaRequest{}
-	v24 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v24
+	v28 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v28
 	this.Type = ReplicaChangeType([]int32{0, 1}[r.Intn(2)])
 	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
-	v25 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v25
+	v29 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v29
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3201,8 +3446,8 @@ func NewPopulatedChangeReplicaRequest(r randyMaster, easy bool) *ChangeReplicaRe
 
 func NewPopulatedChangeReplicaResponse(r randyMaster, easy bool) *ChangeReplicaResponse {
 	this := &ChangeReplicaResponse{}
-	v26 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v26
+	v30 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v30
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3210,8 +3455,8 @@ func NewPopulatedChangeReplicaResponse(r randyMaster, easy bool) *ChangeReplicaR
 
 func NewPopulatedChangeLeaderRequest(r randyMaster, easy bool) *ChangeLeaderRequest {
 	this := &ChangeLeaderRequest{}
-	v27 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v27
+	v31 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v31
 	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -3220,8 +3465,8 @@ func NewPopulatedChangeLeaderRequest(r randyMaster, easy bool) *ChangeLeaderRequ
 
 func NewPopulatedChangeLeaderResponse(r randyMaster, easy bool) *ChangeLeaderResponse {
 	this := &ChangeLeaderResponse{}
-	v28 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v28
+	v32 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v32
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3245,19 +3490,19 @@ func NewPopulatedPSConfig(r randyMaster, easy bool) *PSConfig {
 
 func NewPopulatedPSHeartbeatRequest(r randyMaster, easy bool) *PSHeartbeatRequest {
 	this := &PSHeartbeatRequest{}
-	v29 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v29
+	v33 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v33
 	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
 	if r.Intn(10) != 0 {
-		v30 := r.Intn(5)
-		this.Partitions = make([]PartitionInfo, v30)
-		for i := 0; i < v30; i++ {
-			v31 := NewPopulatedPartitionInfo(r, easy)
-			this.Partitions[i] = *v31
+		v34 := r.Intn(5)
+		this.Partitions = make([]PartitionInfo, v34)
+		for i := 0; i < v34; i++ {
+			v35 := NewPopulatedPartitionInfo(r, easy)
+			this.Partitions[i] = *v35
 		}
 	}
-	v32 := NewPopulatedNodeSysStats(r, easy)
-	this.SysStats = *v32
+	v36 := NewPopulatedNodeSysStats(r, easy)
+	this.SysStats = *v36
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3265,8 +3510,8 @@ func NewPopulatedPSHeartbeatRequest(r randyMaster, easy bool) *PSHeartbeatReques
 
 func NewPopulatedPSHeartbeatResponse(r randyMaster, easy bool) *PSHeartbeatResponse {
 	this := &PSHeartbeatResponse{}
-	v33 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v33
+	v37 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v37
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3277,10 +3522,10 @@ func NewPopulatedPartitionInfo(r randyMaster, easy bool) *PartitionInfo {
 	this.ID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
 	this.IsLeader = bool(bool(r.Intn(2) == 0))
 	this.Status = meta.PartitionStatus([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	v34 := meta.NewPopulatedPartitionEpoch(r, easy)
-	this.Epoch = *v34
-	v35 := NewPopulatedPartitionStats(r, easy)
-	this.Statistics = *v35
+	v38 := meta.NewPopulatedPartitionEpoch(r, easy)
+	this.Epoch = *v38
+	v39 := NewPopulatedPartitionStats(r, easy)
+	this.Statistics = *v39
 	if r.Intn(10) != 0 {
 		this.RaftStatus = NewPopulatedRaftStatus(r, easy)
 	}
@@ -3302,18 +3547,18 @@ func NewPopulatedRuntimeInfo(r randyMaster, easy bool) *RuntimeInfo {
 
 func NewPopulatedRaftStatus(r randyMaster, easy bool) *RaftStatus {
 	this := &RaftStatus{}
-	v36 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v36
+	v40 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v40
 	this.Term = uint64(uint64(r.Uint32()))
 	this.Index = uint64(uint64(r.Uint32()))
 	this.Commit = uint64(uint64(r.Uint32()))
 	this.Applied = uint64(uint64(r.Uint32()))
 	if r.Intn(10) != 0 {
-		v37 := r.Intn(5)
-		this.Followers = make([]RaftFollowerStatus, v37)
-		for i := 0; i < v37; i++ {
-			v38 := NewPopulatedRaftFollowerStatus(r, easy)
-			this.Followers[i] = *v38
+		v41 := r.Intn(5)
+		this.Followers = make([]RaftFollowerStatus, v41)
+		for i := 0; i < v41; i++ {
+			v42 := NewPopulatedRaftFollowerStatus(r, easy)
+			this.Followers[i] = *v42
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3323,8 +3568,8 @@ func NewPopulatedRaftStatus(r randyMaster, easy bool) *RaftStatus {
 
 func NewPopulatedRaftFollowerStatus(r randyMaster, easy bool) *RaftFollowerStatus {
 	this := &RaftFollowerStatus{}
-	v39 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v39
+	v43 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v43
 	this.Match = uint64(uint64(r.Uint32()))
 	this.Commit = uint64(uint64(r.Uint32()))
 	this.Next = uint64(uint64(r.Uint32()))
@@ -3394,9 +3639,9 @@ func randUTF8RuneMaster(r randyMaster) rune {
 	return rune(ru + 61)
 }
 func randStringMaster(r randyMaster) string {
-	v40 := r.Intn(100)
-	tmps := make([]rune, v40)
-	for i := 0; i < v40; i++ {
+	v44 := r.Intn(100)
+	tmps := make([]rune, v44)
+	for i := 0; i < v44; i++ {
 		tmps[i] = randUTF8RuneMaster(r)
 	}
 	return string(tmps)
@@ -3418,11 +3663,11 @@ func randFieldMaster(dAtA []byte, r randyMaster, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMaster(dAtA, uint64(key))
-		v41 := r.Int63()
+		v45 := r.Int63()
 		if r.Intn(2) == 0 {
-			v41 *= -1
+			v45 *= -1
 		}
-		dAtA = encodeVarintPopulateMaster(dAtA, uint64(v41))
+		dAtA = encodeVarintPopulateMaster(dAtA, uint64(v45))
 	case 1:
 		dAtA = encodeVarintPopulateMaster(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3543,6 +3788,32 @@ func (m *GetSpaceResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetSpacesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovMaster(uint64(l))
+	l = len(m.DBName)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSpacesResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovMaster(uint64(l))
+	if len(m.Spaces) > 0 {
+		for _, e := range m.Spaces {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GetRouteRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -4023,6 +4294,28 @@ func (this *GetSpaceResponse) String() string {
 	}, "")
 	return s
 }
+func (this *GetSpacesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSpacesRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`DBName:` + fmt.Sprintf("%v", this.DBName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSpacesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSpacesResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Spaces:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Spaces), "Space", "meta.Space", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GetRouteRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -5129,6 +5422,226 @@ func (m *GetSpaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetSpacesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSpacesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSpacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DBName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DBName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSpacesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSpacesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSpacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spaces = append(m.Spaces, meta.Space{})
+			if err := m.Spaces[len(m.Spaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8327,138 +8840,140 @@ var (
 func init() { proto.RegisterFile("master.proto", fileDescriptorMaster) }
 
 var fileDescriptorMaster = []byte{
-	// 2114 bytes of a gzipped FileDescriptorProto
+	// 2148 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x8c, 0x1b, 0x49,
-	0xf5, 0x77, 0xfb, 0x6b, 0xec, 0xe7, 0xf9, 0xac, 0x49, 0x66, 0x9c, 0xc9, 0xff, 0x6f, 0x87, 0x16,
-	0xca, 0x0e, 0xcb, 0x6e, 0x27, 0x99, 0x65, 0x37, 0x2c, 0x52, 0xb4, 0x89, 0xc7, 0x24, 0x31, 0xca,
-	0x87, 0x69, 0x67, 0x59, 0xb1, 0x12, 0x6a, 0xb5, 0xbb, 0x6b, 0x3c, 0xad, 0xd8, 0x5d, 0x4d, 0x57,
-	0x39, 0xd9, 0xd9, 0x13, 0x37, 0xf6, 0xc8, 0x91, 0x13, 0x12, 0x37, 0xae, 0x20, 0x21, 0xad, 0x90,
-	0x90, 0x38, 0xe6, 0xc6, 0x8a, 0x13, 0x27, 0x6b, 0x63, 0x4e, 0x1c, 0x90, 0x38, 0xa2, 0x1c, 0x10,
-	0xaa, 0x57, 0xd5, 0xed, 0xb6, 0x67, 0x82, 0x88, 0x97, 0x48, 0x9c, 0xc6, 0xf5, 0xea, 0xf7, 0xbe,
-	0x7e, 0xf5, 0xaa, 0xaa, 0x5f, 0x0d, 0xac, 0x8e, 0x5c, 0x2e, 0x68, 0x6c, 0x45, 0x31, 0x13, 0x6c,
-	0xef, 0xed, 0x41, 0x20, 0x8e, 0xc7, 0x7d, 0xcb, 0x63, 0xa3, 0x2b, 0x03, 0x36, 0x60, 0x57, 0x50,
-	0xdc, 0x1f, 0x1f, 0xe1, 0x08, 0x07, 0xf8, 0x4b, 0xc3, 0xdf, 0xcd, 0xc0, 0x45, 0x30, 0x18, 0xba,
-	0x7d, 0x7e, 0xa5, 0xef, 0x8e, 0x7d, 0x1a, 0x0e, 0x82, 0x90, 0x2a, 0xe5, 0x2b, 0x23, 0x2a, 0xdc,
-	0xa8, 0x8f, 0x7f, 0x94, 0x9a, 0xd9, 0x86, 0x95, 0x3b, 0xf7, 0xd1, 0x2d, 0x59, 0x87, 0x7c, 0xe0,
-	0xd7, 0x8d, 0x4b, 0xc6, 0xfe, 0x9a, 0x9d, 0x0f, 0x7c, 0x1c, 0x47, 0xf5, 0xfc, 0x25, 0x63, 0xbf,
-	0x6a, 0xe7, 0x83, 0x88, 0x5c, 0x80, 0x4a, 0x1c, 0x79, 0x4e, 0xc4, 0x62, 0x51, 0x2f, 0x20, 0x6a,
-	0x25, 0x8e, 0xbc, 0x2e, 0x8b, 0x85, 0xb4, 0xf2, 0xf1, 0x57, 0xb7, 0xf2, 0x6b, 0x03, 0x4a, 0x36,
-	0x1b, 0x0b, 0x4a, 0x0e, 0xa0, 0x1a, 0xb9, 0xb1, 0x08, 0x44, 0xc0, 0x42, 0xb4, 0x55, 0x3b, 0x00,
-	0xab, 0x9b, 0x48, 0x5a, 0x95, 0x67, 0x93, 0x66, 0xee, 0x8b, 0x49, 0xd3, 0xb0, 0x67, 0x30, 0x72,
-	0x11, 0x4a, 0x21, 0xf3, 0x29, 0xaf, 0xe7, 0x2f, 0x15, 0xf6, 0x6b, 0x07, 0x25, 0xeb, 0x01, 0xf3,
-	0xa9, 0xad, 0x64, 0xe4, 0x23, 0x28, 0x0f, 0xa9, 0xeb, 0xd3, 0x58, 0xf9, 0x6c, 0x7d, 0x30, 0x9d,
-	0x34, 0xcb, 0xf7, 0x50, 0xf2, 0x62, 0xd2, 0xbc, 0xf6, 0x9f, 0x73, 0x87, 0x56, 0x3b, 0x6d, 0x5b,
-	0x9b, 0x33, 0x7f, 0x08, 0xab, 0x77, 0xa8, 0x68, 0xb7, 0x6c, 0xfa, 0xe3, 0x31, 0xe5, 0x82, 0x5c,
-	0x85, 0xf2, 0xb1, 0x72, 0xa4, 0xc2, 0x5e, 0xb7, 0xf4, 0xcc, 0x5d, 0x94, 0x66, 0x42, 0xd7, 0x38,
-	0xb2, 0x0b, 0x2b, 0xed, 0x96, 0x13, 0xba, 0x23, 0xaa, 0x59, 0x2a, 0xb7, 0x5b, 0x0f, 0xdc, 0x11,
-	0x35, 0x7f, 0x04, 0x6b, 0xda, 0x34, 0x8f, 0x58, 0xc8, 0x29, 0xb9, 0xb6, 0x60, 0x7b, 0xc3, 0x4a,
-	0xa6, 0x5e, 0x6a, 0xfc, 0x02, 0xe4, 0xfd, 0x3e, 0xda, 0xad, 0x1d, 0x14, 0xac, 0x76, 0xab, 0x55,
-	0x94, 0x10, 0x3b, 0xef, 0xf7, 0xcd, 0xdf, 0x18, 0xb0, 0x71, 0x87, 0x8a, 0x5e, 0xe4, 0x7a, 0x74,
-	0xf9, 0xe8, 0x1f, 0x40, 0xc9, 0xef, 0x3b, 0x81, 0x8f, 0x3e, 0xd6, 0x5a, 0xef, 0x4f, 0x27, 0xcd,
-	0x7c, 0xa7, 0xfd, 0x62, 0xd2, 0xbc, 0xf2, 0x0a, 0x9c, 0xb6, 0x5b, 0x9d, 0xb6, 0x5d, 0xf4, 0xfb,
-	0x1d, 0x9f, 0xfc, 0x3f, 0x00, 0x46, 0xa4, 0x08, 0x29, 0x20, 0x21, 0x55, 0x94, 0x20, 0x27, 0x01,
-	0x6c, 0xce, 0x62, 0x5e, 0x9e, 0x16, 0x13, 0x4a, 0x5c, 0xda, 0xd0, 0xcc, 0x94, 0x2d, 0xb4, 0xa8,
-	0xc9, 0x51, 0x53, 0xe6, 0xe7, 0x79, 0xe4, 0x07, 0x0b, 0x72, 0x79, 0x7e, 0x3a, 0xe9, 0x02, 0x68,
-	0x72, 0xda, 0xad, 0x65, 0xc8, 0xc9, 0xfb, 0x7d, 0xf2, 0x61, 0x12, 0xf4, 0xac, 0x84, 0x4b, 0x18,
-	0xf7, 0x8b, 0x49, 0xf3, 0xe0, 0x15, 0x0c, 0xa2, 0x4e, 0xa7, 0xad, 0xf3, 0x24, 0xdf, 0x87, 0x22,
-	0x1f, 0x32, 0x51, 0x2f, 0xa2, 0xd5, 0x1b, 0xd3, 0x49, 0xb3, 0xd8, 0x1b, 0x32, 0xf1, 0x8a, 0xdb,
-	0x42, 0xaa, 0xc8, 0x45, 0x94, 0xa6, 0xcc, 0xc7, 0xb8, 0x4a, 0x9a, 0xb9, 0xe5, 0x57, 0xe9, 0xeb,
-	0x50, 0x8e, 0xa5, 0x8d, 0x64, 0x4b, 0x97, 0x2d, 0x34, 0xa9, 0x97, 0x49, 0xcf, 0x99, 0x7f, 0x35,
-	0x60, 0xab, 0xdb, 0xb3, 0xe9, 0x20, 0x90, 0xe7, 0xcf, 0xf2, 0x2b, 0xf5, 0x11, 0x94, 0x43, 0xdc,
-	0xdb, 0x7a, 0xb5, 0xf0, 0x88, 0x50, 0xbb, 0x7d, 0xc9, 0x23, 0x42, 0x99, 0xd3, 0x27, 0x60, 0x21,
-	0x3d, 0x01, 0xdf, 0x87, 0xd5, 0x78, 0x1c, 0x8a, 0x60, 0x44, 0x9d, 0x20, 0x3c, 0x62, 0x48, 0x7c,
-	0xed, 0x60, 0xd5, 0xb2, 0x95, 0xb0, 0x13, 0x1e, 0xb1, 0x4c, 0x78, 0xb5, 0x78, 0x26, 0x36, 0xff,
-	0x64, 0x00, 0xc9, 0xe6, 0xba, 0x3c, 0xb7, 0xaf, 0x2d, 0xdb, 0xab, 0x00, 0xe9, 0x99, 0xcc, 0xeb,
-	0x45, 0x5c, 0xb8, 0xec, 0xd9, 0xad, 0x16, 0x2f, 0x83, 0x31, 0x3f, 0x85, 0x9d, 0xc3, 0x98, 0xba,
-	0x82, 0xa6, 0xa0, 0xe5, 0x17, 0xd1, 0xca, 0x5e, 0x1c, 0xf9, 0x53, 0x17, 0x87, 0x72, 0x3e, 0x83,
-	0x98, 0x4f, 0x60, 0xf7, 0x94, 0xef, 0xe5, 0x49, 0xdd, 0x87, 0x95, 0x98, 0x46, 0xc3, 0xc0, 0x73,
-	0xb5, 0xef, 0x8a, 0x65, 0xab, 0xb1, 0xf6, 0x9c, 0x4c, 0x9b, 0x3f, 0xcb, 0xc3, 0x4e, 0x9b, 0x0e,
-	0xe9, 0x7f, 0x25, 0xe9, 0xc7, 0x50, 0x4b, 0x33, 0x4a, 0x17, 0xb4, 0x33, 0x9d, 0x34, 0x6b, 0xdd,
-	0x99, 0xf8, 0xc5, 0xa4, 0xf9, 0xde, 0x2b, 0xac, 0x6a, 0x46, 0xd3, 0xce, 0x5a, 0x4f, 0x0b, 0xc7,
-	0xcf, 0xde, 0xa4, 0x5f, 0xbd, 0x70, 0x7c, 0xf3, 0x1e, 0xec, 0x9e, 0x62, 0x64, 0xe9, 0xa5, 0x30,
-	0x3f, 0xcb, 0xc3, 0xb9, 0xc3, 0x63, 0x37, 0x1c, 0x50, 0xbd, 0x02, 0xcb, 0xd3, 0x7b, 0x19, 0x8a,
-	0xe2, 0x24, 0x52, 0x77, 0xc5, 0xfa, 0x01, 0x49, 0x96, 0x54, 0x59, 0x7f, 0x74, 0x12, 0x51, 0x1b,
-	0xe7, 0xc9, 0x10, 0x56, 0x53, 0xa2, 0xe4, 0x8d, 0x58, 0x78, 0x8d, 0xeb, 0xe0, 0x67, 0x6b, 0xad,
-	0xf8, 0xef, 0x6b, 0xed, 0x7b, 0x70, 0x7e, 0x81, 0x89, 0xe5, 0x69, 0xfd, 0xad, 0x01, 0xdb, 0xca,
-	0x98, 0xfa, 0x78, 0x5a, 0x9e, 0xd5, 0x45, 0xb6, 0x5e, 0x67, 0xd5, 0xfa, 0x66, 0x27, 0xa9, 0x86,
-	0x24, 0xec, 0xe5, 0x29, 0xf8, 0x67, 0x01, 0x2a, 0xdd, 0xde, 0x21, 0x0b, 0x8f, 0x82, 0x01, 0x79,
-	0x3b, 0xf3, 0x35, 0x8b, 0xdf, 0xbc, 0x2d, 0x32, 0x9d, 0x34, 0x57, 0xec, 0xee, 0xa1, 0xfc, 0xa2,
-	0x7d, 0x31, 0x69, 0x16, 0x82, 0x50, 0xa4, 0x5f, 0xb8, 0xe4, 0x32, 0x80, 0xeb, 0x8f, 0x82, 0x50,
-	0x29, 0xa8, 0x94, 0x57, 0x12, 0x54, 0x15, 0xa7, 0x10, 0xf7, 0x1e, 0x90, 0x63, 0xea, 0xc6, 0xa2,
-	0x4f, 0x5d, 0xe1, 0x04, 0xa1, 0xa0, 0xf1, 0x13, 0x77, 0xa8, 0x0b, 0x2a, 0xc5, 0x6f, 0xa5, 0x90,
-	0x8e, 0x46, 0x90, 0xeb, 0xb0, 0x1d, 0xbb, 0x47, 0xc2, 0x99, 0x29, 0xa3, 0xa3, 0xe2, 0x82, 0xa2,
-	0xc4, 0xdc, 0x4d, 0x20, 0xe8, 0x30, 0x51, 0xd4, 0x35, 0x23, 0xa8, 0x52, 0x2c, 0x9d, 0xa1, 0x68,
-	0x27, 0x10, 0x54, 0xfc, 0x00, 0x76, 0x17, 0x3c, 0xa6, 0xe1, 0x96, 0xe7, 0x95, 0xcf, 0xcf, 0x79,
-	0x4d, 0x43, 0xde, 0x87, 0x4d, 0xed, 0x59, 0xb8, 0x41, 0xe8, 0x0c, 0xd9, 0x80, 0xd7, 0x57, 0x2e,
-	0x19, 0xfb, 0x45, 0x7b, 0x5d, 0x79, 0x93, 0xe2, 0x7b, 0x6c, 0xc0, 0xc9, 0x2d, 0xa8, 0x67, 0x63,
-	0x74, 0x3c, 0x16, 0x7a, 0xe3, 0x38, 0xa6, 0xa1, 0x77, 0x52, 0xaf, 0xcc, 0xfb, 0xda, 0xc9, 0x04,
-	0x7a, 0x38, 0x83, 0x91, 0x43, 0xb8, 0x80, 0x26, 0x78, 0xe8, 0x46, 0xfc, 0x98, 0x89, 0x39, 0x1b,
-	0xd5, 0x79, 0x1b, 0x98, 0x57, 0x4f, 0x03, 0x33, 0x46, 0xcc, 0x9f, 0xe6, 0xe5, 0x25, 0x9c, 0x66,
-	0xf2, 0x3f, 0xf8, 0xc5, 0xf1, 0xad, 0xb9, 0x3b, 0xb8, 0x80, 0x77, 0xf0, 0x7a, 0x66, 0x73, 0xc8,
-	0x2f, 0x8c, 0x53, 0xf7, 0x30, 0xb9, 0x0a, 0x55, 0x7e, 0xc2, 0x1d, 0x2e, 0x5c, 0xc1, 0xf5, 0x99,
-	0xb2, 0x86, 0x96, 0x7b, 0x27, 0xbc, 0x27, 0x85, 0x5a, 0xa7, 0xc2, 0xf5, 0xd8, 0xbc, 0x0b, 0xdb,
-	0x73, 0x44, 0x2c, 0xbf, 0xa9, 0x7e, 0x97, 0x87, 0xb5, 0xb9, 0xf8, 0x48, 0x77, 0xd6, 0x47, 0xb6,
-	0x6e, 0xa6, 0x5d, 0xc5, 0xb2, 0x87, 0x81, 0xec, 0x44, 0x2f, 0x42, 0x35, 0xe0, 0x8e, 0x6e, 0x03,
-	0x25, 0xe3, 0x15, 0xbb, 0x12, 0xf0, 0x7b, 0xc9, 0xd5, 0x5d, 0x96, 0x89, 0x8f, 0x39, 0xee, 0xb2,
-	0xf5, 0x83, 0xcd, 0x99, 0x7a, 0x0f, 0xe5, 0xb6, 0x9e, 0x27, 0xdf, 0x84, 0x12, 0x8d, 0x98, 0x77,
-	0xac, 0x29, 0xda, 0x98, 0x01, 0xbf, 0x2b, 0xc5, 0x49, 0x13, 0x81, 0x18, 0xf2, 0x2e, 0x80, 0x54,
-	0x0b, 0xb8, 0x08, 0x3c, 0x8e, 0xdb, 0x69, 0x4e, 0x23, 0x4b, 0x6b, 0x06, 0x48, 0xde, 0x82, 0x9a,
-	0xaa, 0x53, 0x15, 0x52, 0x19, 0xf5, 0x6a, 0x96, 0x2d, 0x2b, 0x52, 0x45, 0x03, 0x71, 0xfa, 0xdb,
-	0xfc, 0xcc, 0x80, 0x5a, 0xe6, 0xe3, 0x91, 0x34, 0xa1, 0xe6, 0x46, 0x91, 0xf3, 0x84, 0xc6, 0x3c,
-	0xe9, 0x9f, 0xab, 0x36, 0xb8, 0x51, 0xf4, 0x03, 0x25, 0x91, 0x4d, 0x16, 0x17, 0x6e, 0x2c, 0x1c,
-	0xa9, 0xa2, 0xbb, 0xce, 0x2a, 0x4a, 0x1e, 0x05, 0x23, 0x2a, 0xa7, 0x07, 0x2c, 0x55, 0xd7, 0x3d,
-	0xd8, 0x80, 0x25, 0xda, 0x7b, 0x50, 0x89, 0x86, 0xae, 0x38, 0x62, 0xf1, 0x08, 0x39, 0xa8, 0xda,
-	0xe9, 0xd8, 0xfc, 0xa3, 0x01, 0x30, 0x8b, 0x92, 0xbc, 0x35, 0xbb, 0xa4, 0x8c, 0x85, 0x4b, 0x6a,
-	0x56, 0x03, 0x09, 0x84, 0x10, 0x28, 0x0a, 0x1a, 0x8f, 0x30, 0xa0, 0xa2, 0x8d, 0xbf, 0xc9, 0x39,
-	0x28, 0x05, 0xa1, 0x4f, 0x3f, 0xc1, 0x30, 0x8a, 0xb6, 0x1a, 0x90, 0x1d, 0x28, 0x7b, 0x6c, 0x34,
-	0x0a, 0xd4, 0xd1, 0x56, 0xb4, 0xf5, 0x88, 0xd4, 0x61, 0xc5, 0x8d, 0xa2, 0x61, 0x40, 0x7d, 0xe4,
-	0xba, 0x68, 0x27, 0x43, 0x72, 0x1d, 0xaa, 0x47, 0x6c, 0x38, 0x64, 0x4f, 0x69, 0x2c, 0xf9, 0x94,
-	0x3b, 0x62, 0x1b, 0xf9, 0xbc, 0xad, 0xa5, 0x2a, 0xe2, 0xe4, 0x0b, 0x31, 0xc5, 0x9a, 0xbf, 0x37,
-	0x80, 0x9c, 0xc6, 0xbd, 0x62, 0x66, 0xe7, 0xa0, 0x34, 0x72, 0x85, 0x77, 0xac, 0x53, 0x53, 0x83,
-	0x4c, 0x16, 0x85, 0xb9, 0x2c, 0x08, 0x14, 0x43, 0xfa, 0x49, 0x92, 0x1b, 0xfe, 0x26, 0x5f, 0x83,
-	0x55, 0x9f, 0x3d, 0x0d, 0x1d, 0x4e, 0x3d, 0x16, 0xfa, 0x5c, 0xa7, 0x57, 0x93, 0xb2, 0x9e, 0x12,
-	0x49, 0x27, 0xb2, 0x5e, 0x28, 0x96, 0x4b, 0xd5, 0x56, 0x03, 0xf3, 0x17, 0x25, 0x58, 0xcd, 0x6e,
-	0x62, 0x69, 0x69, 0x44, 0x47, 0x2c, 0x3e, 0x71, 0x04, 0x13, 0xee, 0x10, 0xc3, 0x2f, 0xda, 0x35,
-	0x25, 0x7b, 0x24, 0x45, 0xe4, 0x32, 0x6c, 0x68, 0xc8, 0x98, 0x53, 0xdf, 0x89, 0x39, 0xd7, 0x81,
-	0xaf, 0x29, 0xf1, 0x87, 0x9c, 0xfa, 0x36, 0xe7, 0xb2, 0xd0, 0x32, 0x38, 0x9d, 0x05, 0xcc, 0x30,
-	0x19, 0xc0, 0x51, 0x4c, 0xa9, 0x4e, 0x48, 0x03, 0x6e, 0xc7, 0x94, 0x92, 0x37, 0x61, 0x8b, 0x3f,
-	0x75, 0x23, 0x67, 0x2e, 0xa2, 0x32, 0xc2, 0x36, 0xe4, 0xc4, 0xfd, 0x4c, 0x54, 0xfb, 0xb0, 0x99,
-	0xc5, 0xa2, 0x4b, 0x7d, 0x53, 0xcc, 0xa0, 0xe8, 0x76, 0x01, 0x89, 0xbe, 0x2b, 0x8b, 0x48, 0xf4,
-	0x6f, 0xc2, 0x9a, 0x17, 0x8d, 0x9d, 0x28, 0x66, 0x9e, 0x13, 0x4b, 0xee, 0xe0, 0x92, 0xb1, 0x6f,
-	0xd8, 0x35, 0x2f, 0x1a, 0x77, 0x63, 0xe6, 0xd9, 0xae, 0xa0, 0xf2, 0xdc, 0x90, 0x18, 0x8f, 0x8d,
-	0x43, 0x51, 0xaf, 0xe1, 0x93, 0x55, 0xc5, 0x8b, 0xc6, 0x87, 0x72, 0x2c, 0xf7, 0x8a, 0x1f, 0xf0,
-	0xc7, 0x3a, 0xf2, 0x0d, 0x74, 0x52, 0x95, 0x12, 0x15, 0xf3, 0x45, 0xc0, 0x81, 0x0a, 0x76, 0x13,
-	0x67, 0x2b, 0x52, 0x80, 0x61, 0x26, 0x93, 0x18, 0xdf, 0xd6, 0x6c, 0x12, 0x23, 0xbb, 0x06, 0x3b,
-	0x21, 0x15, 0x4e, 0xc0, 0x9c, 0x20, 0x74, 0xfa, 0x27, 0xf2, 0x46, 0xa6, 0xb1, 0x5c, 0xfe, 0xfa,
-	0x79, 0x44, 0x6e, 0x85, 0x54, 0x74, 0x58, 0x27, 0x6c, 0x9d, 0x08, 0xda, 0xa5, 0x71, 0x8f, 0x7a,
-	0xe4, 0x1d, 0xd8, 0xd5, 0x2a, 0x6c, 0x2c, 0xe6, 0x75, 0x76, 0x50, 0x87, 0xa0, 0xce, 0xc3, 0xb1,
-	0xc8, 0x28, 0x59, 0xb0, 0x2d, 0x95, 0x84, 0x17, 0xc9, 0xcb, 0x30, 0xa4, 0x9e, 0xba, 0x34, 0x76,
-	0x31, 0x4f, 0xe9, 0xe4, 0x91, 0x17, 0x1d, 0xce, 0x26, 0xc8, 0x0d, 0xf8, 0xbf, 0x04, 0xef, 0x7a,
-	0x22, 0x78, 0x42, 0x1d, 0x16, 0xd1, 0x90, 0xa7, 0x9e, 0xea, 0xe8, 0x69, 0x57, 0x29, 0xde, 0x42,
-	0xc4, 0x43, 0x09, 0xd0, 0xee, 0x36, 0xa1, 0xc0, 0x22, 0x5e, 0xbf, 0x80, 0x28, 0xf9, 0xd3, 0xfc,
-	0x9b, 0x01, 0xeb, 0xf3, 0x07, 0xa2, 0xdc, 0x00, 0x3c, 0xf8, 0x94, 0xea, 0xd2, 0xc4, 0xdf, 0x89,
-	0x62, 0x3e, 0x55, 0x24, 0x6f, 0xc0, 0xa6, 0xcc, 0x91, 0x4b, 0x82, 0x12, 0xef, 0xaa, 0x04, 0xd7,
-	0x50, 0xde, 0x09, 0xb5, 0xcf, 0x6f, 0xc0, 0x96, 0x02, 0x4a, 0x5a, 0x12, 0xa4, 0xaa, 0xc5, 0x75,
-	0x9c, 0x78, 0x38, 0x16, 0x1a, 0xfa, 0x6d, 0xa8, 0xe3, 0x4a, 0x3a, 0x72, 0x2b, 0xba, 0xa1, 0xcf,
-	0xb1, 0x34, 0x28, 0xe7, 0xe9, 0x89, 0xb2, 0x83, 0xf3, 0x87, 0x7a, 0xba, 0x9b, 0xcc, 0x92, 0x37,
-	0x60, 0xe3, 0x31, 0x3d, 0xc1, 0x27, 0x15, 0x67, 0x14, 0x70, 0x4e, 0xb9, 0xae, 0xe3, 0xf5, 0x44,
-	0x7c, 0x1f, 0xa5, 0x6f, 0xee, 0xc3, 0xd6, 0xa9, 0x0e, 0x82, 0xac, 0x40, 0xe1, 0x96, 0xef, 0x6f,
-	0xe6, 0x08, 0x40, 0xd9, 0xa6, 0x23, 0xf6, 0x84, 0x6e, 0x1a, 0x07, 0xbf, 0x2c, 0x42, 0x55, 0xbd,
-	0xaa, 0xda, 0x91, 0x47, 0xae, 0x41, 0x25, 0x79, 0x54, 0x21, 0x9b, 0xd6, 0xc2, 0xcb, 0xd4, 0xde,
-	0x96, 0xb5, 0xf8, 0xe2, 0x62, 0xe6, 0xc8, 0x75, 0x80, 0xd9, 0x6b, 0x01, 0x21, 0xd6, 0xa9, 0x67,
-	0x92, 0xbd, 0x6d, 0xeb, 0xf4, 0x73, 0x82, 0x99, 0x23, 0xdf, 0x81, 0x5a, 0xe6, 0x62, 0x27, 0x12,
-	0xb5, 0xf8, 0xbd, 0xb3, 0x77, 0xce, 0x3a, 0xe3, 0xee, 0x37, 0x73, 0x64, 0x1f, 0x4a, 0xf8, 0x6c,
-	0x49, 0xd6, 0xac, 0xec, 0xcb, 0xe8, 0xde, 0xba, 0x35, 0xf7, 0x9a, 0x69, 0xe6, 0x74, 0x46, 0xf8,
-	0x1c, 0xa5, 0x32, 0xca, 0xbe, 0x45, 0xaa, 0x8c, 0xe6, 0x5e, 0xfa, 0xcc, 0x1c, 0xb9, 0x0d, 0x1b,
-	0x0b, 0xfd, 0x3a, 0xd9, 0xb5, 0xce, 0x7e, 0x3d, 0xd8, 0xab, 0x5b, 0x2f, 0x69, 0xed, 0x95, 0x9d,
-	0x85, 0x66, 0x93, 0xec, 0x5a, 0x67, 0x37, 0xe4, 0x7b, 0x75, 0xeb, 0x25, 0x7d, 0xa9, 0x99, 0x23,
-	0x37, 0x61, 0x6d, 0xae, 0xb7, 0x22, 0xe7, 0xad, 0xb3, 0xba, 0xce, 0xbd, 0x1d, 0xeb, 0xcc, 0x16,
-	0xcc, 0xcc, 0x91, 0x1b, 0xb0, 0x9a, 0xed, 0x4c, 0xc8, 0x39, 0xeb, 0x8c, 0xfe, 0x6a, 0xef, 0xbc,
-	0x75, 0x56, 0xfb, 0x62, 0xe6, 0x0e, 0x7c, 0x28, 0xdd, 0xb9, 0x2f, 0xcb, 0xe3, 0x75, 0xd2, 0xde,
-	0xba, 0xf9, 0xec, 0x79, 0x23, 0xf7, 0xe7, 0xe7, 0x8d, 0xdc, 0x97, 0xcf, 0x1b, 0xb9, 0xbf, 0x3f,
-	0x6f, 0xe4, 0xfe, 0xf1, 0xbc, 0x61, 0xfc, 0x64, 0xda, 0x30, 0x7e, 0x35, 0x6d, 0x18, 0x9f, 0x4f,
-	0x1b, 0xb9, 0x3f, 0x4c, 0x1b, 0xb9, 0x67, 0xd3, 0x86, 0xf1, 0xc5, 0xb4, 0x61, 0x7c, 0x39, 0x6d,
-	0x18, 0x3f, 0xff, 0x4b, 0x23, 0x77, 0xd7, 0xf8, 0xb8, 0xa2, 0xfe, 0xa3, 0x11, 0xf5, 0xfb, 0x65,
-	0xfc, 0x34, 0x7b, 0xe7, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x67, 0x75, 0xf8, 0xe4, 0x18,
-	0x00, 0x00,
+	0x15, 0x76, 0xfb, 0x6f, 0xec, 0xe7, 0xf9, 0x73, 0xcd, 0x64, 0xc6, 0x71, 0xc0, 0x1e, 0x5a, 0x28,
+	0x3b, 0x2c, 0xbb, 0x9d, 0x64, 0xf6, 0x27, 0x2c, 0x52, 0xb4, 0x89, 0xc7, 0x24, 0x31, 0xca, 0xcf,
+	0xd0, 0x93, 0x65, 0xc5, 0x4a, 0xab, 0x56, 0xbb, 0xbb, 0xc6, 0xd3, 0x8a, 0xdd, 0xdd, 0x74, 0x95,
+	0x93, 0x9d, 0x3d, 0x71, 0x63, 0x8f, 0x1c, 0x11, 0x07, 0xce, 0x5c, 0x41, 0x42, 0x5a, 0x21, 0x21,
+	0x71, 0xcc, 0x8d, 0x15, 0x17, 0x38, 0x59, 0x1b, 0x73, 0xe2, 0x80, 0xc4, 0x11, 0xe5, 0x80, 0x50,
+	0xbd, 0xaa, 0xfe, 0xb1, 0x67, 0x82, 0x88, 0xb3, 0x91, 0xf6, 0x34, 0xae, 0x57, 0xdf, 0xfb, 0x7f,
+	0x5d, 0x55, 0xef, 0x0d, 0x2c, 0x8f, 0x6c, 0xc6, 0x69, 0x64, 0x84, 0x51, 0xc0, 0x83, 0xe6, 0x9b,
+	0x03, 0x8f, 0x1f, 0x8f, 0xfb, 0x86, 0x13, 0x8c, 0x2e, 0x0d, 0x82, 0x41, 0x70, 0x09, 0xc9, 0xfd,
+	0xf1, 0x11, 0xae, 0x70, 0x81, 0xbf, 0x14, 0xfc, 0x9d, 0x0c, 0x9c, 0x7b, 0x83, 0xa1, 0xdd, 0x67,
+	0x97, 0xfa, 0xf6, 0xd8, 0xa5, 0xfe, 0xc0, 0xf3, 0xa9, 0x64, 0xbe, 0x34, 0xa2, 0xdc, 0x0e, 0xfb,
+	0xf8, 0x47, 0xb2, 0xe9, 0x5d, 0x58, 0xba, 0x75, 0x17, 0xd5, 0x92, 0x55, 0xc8, 0x7b, 0x6e, 0x43,
+	0xdb, 0xd1, 0x76, 0x57, 0xcc, 0xbc, 0xe7, 0xe2, 0x3a, 0x6c, 0xe4, 0x77, 0xb4, 0xdd, 0xaa, 0x99,
+	0xf7, 0x42, 0x72, 0x1e, 0x2a, 0x51, 0xe8, 0x58, 0x61, 0x10, 0xf1, 0x46, 0x01, 0x51, 0x4b, 0x51,
+	0xe8, 0x1c, 0x04, 0x11, 0x17, 0x52, 0x3e, 0x7a, 0x79, 0x29, 0xbf, 0xd5, 0xa0, 0x64, 0x06, 0x63,
+	0x4e, 0xc9, 0x1e, 0x54, 0x43, 0x3b, 0xe2, 0x1e, 0xf7, 0x02, 0x1f, 0x65, 0xd5, 0xf6, 0xc0, 0x38,
+	0x88, 0x29, 0x9d, 0xca, 0x93, 0x49, 0x3b, 0xf7, 0xc5, 0xa4, 0xad, 0x99, 0x29, 0x8c, 0x5c, 0x80,
+	0x92, 0x1f, 0xb8, 0x94, 0x35, 0xf2, 0x3b, 0x85, 0xdd, 0xda, 0x5e, 0xc9, 0xb8, 0x17, 0xb8, 0xd4,
+	0x94, 0x34, 0xf2, 0x21, 0x94, 0x87, 0xd4, 0x76, 0x69, 0x24, 0x75, 0x76, 0xde, 0x9f, 0x4e, 0xda,
+	0xe5, 0x3b, 0x48, 0x79, 0x36, 0x69, 0x5f, 0xf9, 0xff, 0x63, 0x87, 0x52, 0x7b, 0x5d, 0x53, 0x89,
+	0xd3, 0x7f, 0x02, 0xcb, 0xb7, 0x28, 0xef, 0x76, 0x4c, 0xfa, 0xd3, 0x31, 0x65, 0x9c, 0x5c, 0x86,
+	0xf2, 0xb1, 0x54, 0x24, 0xcd, 0x5e, 0x35, 0xd4, 0xce, 0x6d, 0xa4, 0x66, 0x4c, 0x57, 0x38, 0xb2,
+	0x0d, 0x4b, 0xdd, 0x8e, 0xe5, 0xdb, 0x23, 0xaa, 0xa2, 0x54, 0xee, 0x76, 0xee, 0xd9, 0x23, 0xaa,
+	0x7f, 0x0c, 0x2b, 0x4a, 0x34, 0x0b, 0x03, 0x9f, 0x51, 0x72, 0x65, 0x4e, 0xf6, 0x9a, 0x11, 0x6f,
+	0x3d, 0x57, 0xf8, 0x79, 0xc8, 0xbb, 0x7d, 0x94, 0x5b, 0xdb, 0x2b, 0x18, 0xdd, 0x4e, 0xa7, 0x28,
+	0x20, 0x66, 0xde, 0xed, 0xeb, 0xbf, 0xd3, 0x60, 0xed, 0x16, 0xe5, 0x87, 0xa1, 0xed, 0xd0, 0xc5,
+	0xad, 0xbf, 0x07, 0x25, 0xb7, 0x6f, 0x79, 0x2e, 0xea, 0x58, 0xe9, 0xbc, 0x37, 0x9d, 0xb4, 0xf3,
+	0xbd, 0xee, 0xb3, 0x49, 0xfb, 0xd2, 0x0b, 0xc4, 0xb4, 0xdb, 0xe9, 0x75, 0xcd, 0xa2, 0xdb, 0xef,
+	0xb9, 0xe4, 0x9b, 0x00, 0x68, 0x91, 0x0c, 0x48, 0x01, 0x03, 0x52, 0x45, 0x0a, 0xc6, 0xc4, 0x83,
+	0xf5, 0xd4, 0xe6, 0xc5, 0xc3, 0xa2, 0x43, 0x89, 0x09, 0x19, 0x2a, 0x32, 0x65, 0x03, 0x25, 0xaa,
+	0xe0, 0xc8, 0x2d, 0xfd, 0xe3, 0x54, 0x15, 0x7b, 0x05, 0xd9, 0x1d, 0x42, 0x3d, 0x23, 0x7e, 0x71,
+	0x57, 0xbe, 0x0d, 0x65, 0xb4, 0x37, 0xae, 0xfb, 0x59, 0x5f, 0xd4, 0x9e, 0xfe, 0x79, 0x1e, 0x93,
+	0x8d, 0x5f, 0xd7, 0xe2, 0xce, 0xf4, 0x92, 0x6a, 0x52, 0x99, 0xee, 0x76, 0x16, 0xc9, 0x74, 0xde,
+	0xed, 0x93, 0x0f, 0xe2, 0x0c, 0xa4, 0xdf, 0x63, 0x09, 0x0d, 0x7f, 0x36, 0x69, 0xef, 0xbd, 0x80,
+	0x40, 0xe4, 0xe9, 0x75, 0x55, 0xd2, 0xc8, 0x8f, 0xa0, 0xc8, 0x86, 0x01, 0x6f, 0x14, 0x51, 0xea,
+	0xb5, 0xe9, 0xa4, 0x5d, 0x3c, 0x1c, 0x06, 0xfc, 0x05, 0xbf, 0x71, 0xc1, 0x22, 0x2a, 0x52, 0x88,
+	0xd2, 0x1f, 0xc2, 0x7a, 0x1a, 0xb9, 0x97, 0xca, 0x53, 0x24, 0x64, 0xa4, 0x79, 0x42, 0x91, 0x71,
+	0x9e, 0xe4, 0x9e, 0xfe, 0x0f, 0x0d, 0xea, 0x07, 0x87, 0x26, 0x1d, 0x78, 0xe2, 0x30, 0x5d, 0x3c,
+	0x53, 0x1f, 0x42, 0xd9, 0xc7, 0x83, 0xaa, 0x91, 0x4f, 0xe2, 0x5b, 0x96, 0x47, 0xd7, 0x82, 0xe7,
+	0x9d, 0x14, 0xa7, 0x8e, 0xf3, 0x42, 0x72, 0x9c, 0xbf, 0x07, 0xcb, 0xd1, 0xd8, 0xe7, 0xde, 0x88,
+	0x5a, 0x9e, 0x7f, 0x14, 0x60, 0xe0, 0x6b, 0x7b, 0xcb, 0x86, 0x29, 0x89, 0x3d, 0xff, 0x28, 0xc8,
+	0x98, 0x57, 0x8b, 0x52, 0xb2, 0xfe, 0x17, 0x0d, 0x48, 0xd6, 0xd7, 0xc5, 0x63, 0xfb, 0xca, 0xbc,
+	0xbd, 0x0c, 0x90, 0x5c, 0x30, 0xac, 0x51, 0xdc, 0x29, 0xcc, 0x5d, 0x44, 0x32, 0x79, 0x19, 0x8c,
+	0xfe, 0x29, 0x6c, 0xed, 0x47, 0xd4, 0xe6, 0x34, 0x01, 0x2d, 0x9e, 0x44, 0x23, 0x7b, 0x0b, 0xe6,
+	0x77, 0xb4, 0x33, 0x95, 0xa7, 0x10, 0xfd, 0x11, 0x6c, 0x9f, 0xd2, 0xbd, 0x78, 0x50, 0x77, 0x61,
+	0x29, 0xa2, 0xe1, 0xd0, 0x73, 0x6c, 0xa5, 0xbb, 0x62, 0x98, 0x72, 0xad, 0x34, 0xc7, 0xdb, 0xfa,
+	0x2f, 0xf2, 0xb0, 0xd5, 0xa5, 0x43, 0xfa, 0x95, 0x38, 0xfd, 0x10, 0x6a, 0x89, 0x47, 0x49, 0x42,
+	0x7b, 0xd3, 0x49, 0xbb, 0x76, 0x90, 0x92, 0x9f, 0x4d, 0xda, 0xef, 0xbe, 0x40, 0x56, 0x33, 0x9c,
+	0x66, 0x56, 0x7a, 0x52, 0x38, 0x6e, 0xf6, 0x59, 0xf0, 0xf2, 0x85, 0xe3, 0xea, 0x77, 0x60, 0xfb,
+	0x54, 0x44, 0x16, 0x4e, 0x85, 0xfe, 0x59, 0x1e, 0x36, 0xf7, 0x8f, 0x6d, 0x7f, 0x40, 0x55, 0x06,
+	0x16, 0x0f, 0xef, 0x45, 0x28, 0xf2, 0x93, 0x50, 0x5e, 0x46, 0xab, 0x7b, 0x24, 0x4e, 0xa9, 0x94,
+	0xfe, 0xe0, 0x24, 0xa4, 0x26, 0xee, 0x93, 0x21, 0x2c, 0x27, 0x81, 0xb2, 0xbc, 0x38, 0x3e, 0xaf,
+	0x26, 0x0f, 0x6e, 0xb6, 0xd6, 0x8a, 0xff, 0xbb, 0xd6, 0x7e, 0x08, 0xe7, 0xe6, 0x22, 0xb1, 0x78,
+	0x58, 0x7f, 0xaf, 0xc1, 0x86, 0x14, 0x26, 0x5f, 0x82, 0x8b, 0x47, 0x75, 0x3e, 0x5a, 0xaf, 0xb2,
+	0x6a, 0x5d, 0xbd, 0x07, 0x9b, 0xb3, 0x66, 0x2f, 0x1e, 0x82, 0xff, 0x14, 0xa0, 0x72, 0x70, 0xb8,
+	0x1f, 0xf8, 0x47, 0xde, 0x80, 0xbc, 0x99, 0x79, 0x9a, 0xe3, 0x03, 0xbe, 0x43, 0xa6, 0x93, 0xf6,
+	0x92, 0x79, 0xb0, 0x2f, 0x9e, 0xe7, 0xcf, 0x26, 0xed, 0x82, 0xe7, 0xf3, 0xe4, 0xb9, 0x4e, 0x2e,
+	0x02, 0xd8, 0xee, 0xc8, 0xf3, 0x25, 0x83, 0x74, 0x79, 0x29, 0x46, 0x55, 0x71, 0x0b, 0x71, 0xef,
+	0x02, 0x39, 0xa6, 0x76, 0xc4, 0xfb, 0xd4, 0xe6, 0x96, 0xe7, 0x73, 0x1a, 0x3d, 0xb2, 0x87, 0x8d,
+	0xc2, 0x2c, 0xbe, 0x9e, 0x40, 0x7a, 0x0a, 0x41, 0xae, 0xc2, 0x46, 0x64, 0x1f, 0x71, 0x2b, 0x65,
+	0x46, 0x45, 0xc5, 0x39, 0x46, 0x81, 0xb9, 0x1d, 0x43, 0x50, 0x61, 0xcc, 0xa8, 0x6a, 0x86, 0x53,
+	0xc9, 0x58, 0x3a, 0x83, 0xd1, 0x8c, 0x21, 0xc8, 0xf8, 0x3e, 0x6c, 0xcf, 0x69, 0x4c, 0xcc, 0x2d,
+	0xcf, 0x32, 0x9f, 0x9b, 0xd1, 0x9a, 0x98, 0xbc, 0x0b, 0xeb, 0x4a, 0x33, 0xb7, 0x3d, 0xdf, 0x1a,
+	0x06, 0x03, 0xd6, 0x58, 0xda, 0xd1, 0x76, 0x8b, 0xe6, 0xaa, 0xd4, 0x26, 0xc8, 0x77, 0x82, 0x01,
+	0x23, 0x37, 0xa0, 0x91, 0xb5, 0xd1, 0x72, 0x02, 0xdf, 0x19, 0x47, 0x11, 0xf5, 0x9d, 0x93, 0x46,
+	0x65, 0x56, 0xd7, 0x56, 0xc6, 0xd0, 0xfd, 0x14, 0x46, 0xf6, 0xe1, 0x3c, 0x8a, 0x60, 0xbe, 0x1d,
+	0xb2, 0xe3, 0x80, 0xcf, 0xc8, 0xa8, 0xce, 0xca, 0x40, 0xbf, 0x0e, 0x15, 0x30, 0x23, 0x44, 0xff,
+	0x79, 0x5e, 0x5c, 0xc2, 0x89, 0x27, 0x5f, 0xc3, 0x17, 0xc7, 0xdb, 0x33, 0x77, 0x70, 0x01, 0xef,
+	0xe0, 0xd5, 0xcc, 0xc7, 0x21, 0x5e, 0x18, 0xa7, 0xee, 0x61, 0x72, 0x19, 0xaa, 0xec, 0x84, 0x59,
+	0x8c, 0xdb, 0x9c, 0xa9, 0x33, 0x65, 0x05, 0x25, 0x1f, 0x9e, 0xb0, 0x43, 0x41, 0x54, 0x3c, 0x15,
+	0xa6, 0xd6, 0xfa, 0x6d, 0xd8, 0x98, 0x09, 0xc4, 0xe2, 0x1f, 0xd5, 0x1f, 0xf2, 0xb0, 0x32, 0x63,
+	0x1f, 0x39, 0x48, 0x9b, 0xe2, 0xce, 0xf5, 0xa4, 0x45, 0x5a, 0xf4, 0x30, 0x10, 0x6d, 0xf5, 0x05,
+	0xa8, 0x7a, 0xcc, 0x52, 0x3d, 0xad, 0x88, 0x78, 0xc5, 0xac, 0x78, 0xec, 0x4e, 0x7c, 0x75, 0x97,
+	0x85, 0xe3, 0x63, 0x86, 0x5f, 0xd9, 0xea, 0xde, 0x7a, 0xca, 0x7e, 0x88, 0x74, 0x53, 0xed, 0x93,
+	0xef, 0x42, 0x89, 0x86, 0x81, 0x73, 0xac, 0x42, 0xb4, 0x96, 0x02, 0x7f, 0x20, 0xc8, 0x71, 0x47,
+	0x84, 0x18, 0xf2, 0x0e, 0x80, 0x60, 0xf3, 0x18, 0xf7, 0x1c, 0xd6, 0x28, 0xcd, 0x73, 0x64, 0xc3,
+	0x9a, 0x01, 0x92, 0x37, 0xa0, 0x26, 0xeb, 0x54, 0x9a, 0x54, 0x46, 0xbe, 0x9a, 0x61, 0x8a, 0x8a,
+	0x94, 0xd6, 0x40, 0x94, 0xfc, 0xd6, 0x3f, 0xd3, 0xa0, 0x96, 0x79, 0x3c, 0x92, 0x36, 0xd4, 0xec,
+	0x30, 0xb4, 0x1e, 0xd1, 0x88, 0xc5, 0xc3, 0x80, 0xaa, 0x09, 0x76, 0x18, 0xfe, 0x58, 0x52, 0x44,
+	0xc7, 0xc8, 0xb8, 0x1d, 0x71, 0x4b, 0xb0, 0xa8, 0x26, 0xab, 0x8a, 0x94, 0x07, 0xde, 0x88, 0x8a,
+	0xed, 0x41, 0x90, 0xb0, 0xab, 0x86, 0x72, 0x10, 0xc4, 0xdc, 0x4d, 0xa8, 0x84, 0x43, 0x9b, 0x1f,
+	0x05, 0xd1, 0x08, 0x63, 0x50, 0x35, 0x93, 0xb5, 0xfe, 0x67, 0x0d, 0x20, 0xb5, 0x92, 0xbc, 0x91,
+	0x5e, 0x52, 0xda, 0xdc, 0x25, 0x95, 0xd6, 0x40, 0x0c, 0x21, 0x04, 0x8a, 0x9c, 0x46, 0x23, 0x34,
+	0xa8, 0x68, 0xe2, 0x6f, 0xb2, 0x09, 0x25, 0xcf, 0x77, 0xe9, 0x27, 0x68, 0x46, 0xd1, 0x94, 0x0b,
+	0xb2, 0x05, 0x65, 0x27, 0x18, 0x8d, 0x3c, 0x79, 0xb4, 0x15, 0x4d, 0xb5, 0x22, 0x0d, 0x58, 0xb2,
+	0xc3, 0x70, 0xe8, 0x51, 0x17, 0x63, 0x5d, 0x34, 0xe3, 0x25, 0xb9, 0x0a, 0xd5, 0xa3, 0x60, 0x38,
+	0x0c, 0x1e, 0xd3, 0x48, 0xc4, 0x53, 0x7c, 0x11, 0x1b, 0x18, 0xcf, 0x9b, 0x8a, 0x2a, 0x2d, 0x8e,
+	0x5f, 0x88, 0x09, 0x56, 0xff, 0xa3, 0x06, 0xe4, 0x34, 0xee, 0x05, 0x3d, 0xdb, 0x84, 0xd2, 0xc8,
+	0xe6, 0xce, 0xb1, 0x72, 0x4d, 0x2e, 0x32, 0x5e, 0x14, 0x66, 0xbc, 0x20, 0x50, 0xf4, 0xe9, 0x27,
+	0xb1, 0x6f, 0xf8, 0x9b, 0x7c, 0x0b, 0x96, 0xdd, 0xe0, 0xb1, 0x6f, 0x31, 0xea, 0x04, 0xbe, 0xcb,
+	0x94, 0x7b, 0x35, 0x41, 0x3b, 0x94, 0x24, 0xa1, 0x44, 0xd4, 0x0b, 0xc5, 0x72, 0xa9, 0x9a, 0x72,
+	0xa1, 0xff, 0xba, 0x04, 0xcb, 0xd9, 0x8f, 0x58, 0x48, 0x1a, 0xd1, 0x51, 0x10, 0x9d, 0x58, 0x3c,
+	0xe0, 0xf6, 0x10, 0xcd, 0x2f, 0x9a, 0x35, 0x49, 0x7b, 0x20, 0x48, 0xe4, 0x22, 0xac, 0x29, 0xc8,
+	0x98, 0x51, 0xd7, 0x8a, 0x18, 0x53, 0x86, 0xaf, 0x48, 0xf2, 0x07, 0x8c, 0xba, 0x26, 0x63, 0xa2,
+	0xd0, 0x32, 0x38, 0xe5, 0x05, 0xa4, 0x98, 0x0c, 0xe0, 0x28, 0xa2, 0xb4, 0x51, 0xcc, 0x02, 0x6e,
+	0x46, 0x94, 0x92, 0xd7, 0xa1, 0xce, 0x1e, 0xdb, 0xa1, 0x35, 0x63, 0x51, 0x19, 0x61, 0x6b, 0x62,
+	0xe3, 0x6e, 0xc6, 0xaa, 0x5d, 0x58, 0xcf, 0x62, 0x51, 0xa5, 0xba, 0x29, 0x52, 0x28, 0xaa, 0x9d,
+	0x43, 0xa2, 0xee, 0xca, 0x3c, 0x12, 0xf5, 0xeb, 0xb0, 0xe2, 0x84, 0x63, 0x2b, 0x8c, 0x02, 0xc7,
+	0x8a, 0x44, 0xec, 0x60, 0x47, 0xdb, 0xd5, 0xcc, 0x9a, 0x13, 0x8e, 0x0f, 0xa2, 0xc0, 0x31, 0x6d,
+	0x4e, 0xc5, 0xb9, 0x21, 0x30, 0x4e, 0x30, 0xf6, 0x79, 0xa3, 0x86, 0xf3, 0xb7, 0x8a, 0x13, 0x8e,
+	0xf7, 0xc5, 0x5a, 0x7c, 0x2b, 0xae, 0xc7, 0x1e, 0x2a, 0xcb, 0xd7, 0x50, 0x49, 0x55, 0x50, 0xa4,
+	0xcd, 0x17, 0x00, 0x17, 0xd2, 0xd8, 0x75, 0xdc, 0xad, 0x08, 0x02, 0x9a, 0x19, 0x6f, 0xa2, 0x7d,
+	0xf5, 0x74, 0x13, 0x2d, 0xbb, 0x02, 0x5b, 0x3e, 0xe5, 0x96, 0x17, 0x58, 0x9e, 0x6f, 0xf5, 0x4f,
+	0xc4, 0x8d, 0x4c, 0x23, 0x91, 0xfe, 0xc6, 0x39, 0x44, 0xd6, 0x7d, 0xca, 0x7b, 0x41, 0xcf, 0xef,
+	0x9c, 0x70, 0x7a, 0x40, 0xa3, 0x43, 0xea, 0x90, 0xb7, 0x60, 0x5b, 0xb1, 0x04, 0x63, 0x3e, 0xcb,
+	0xb3, 0x85, 0x3c, 0x04, 0x79, 0xee, 0x8f, 0x79, 0x86, 0xc9, 0x80, 0x0d, 0xc1, 0xc4, 0x9d, 0x50,
+	0x5c, 0x86, 0x3e, 0x75, 0xe4, 0xa5, 0xb1, 0x8d, 0x7e, 0x0a, 0x25, 0x0f, 0x9c, 0x70, 0x3f, 0xdd,
+	0x20, 0xd7, 0xe0, 0x1b, 0x31, 0xde, 0x76, 0xb8, 0xf7, 0x88, 0x5a, 0x41, 0x48, 0x7d, 0x96, 0x68,
+	0x6a, 0xa0, 0xa6, 0x6d, 0xc9, 0x78, 0x03, 0x11, 0xf7, 0x05, 0x40, 0xa9, 0x5b, 0x87, 0x42, 0x10,
+	0xb2, 0xc6, 0x79, 0x44, 0x89, 0x9f, 0xfa, 0x3f, 0x35, 0x58, 0x9d, 0x3d, 0x10, 0xc5, 0x07, 0xc0,
+	0xbc, 0x4f, 0xa9, 0x2a, 0x4d, 0xfc, 0x1d, 0x33, 0xe6, 0x13, 0x46, 0xf2, 0x1a, 0xac, 0x0b, 0x1f,
+	0x99, 0x08, 0x50, 0xac, 0x5d, 0x96, 0xe0, 0x0a, 0xd2, 0x7b, 0xbe, 0xd2, 0xf9, 0x1d, 0xa8, 0x4b,
+	0xa0, 0x08, 0x4b, 0x8c, 0x94, 0xb5, 0xb8, 0x8a, 0x1b, 0xf7, 0xc7, 0x5c, 0x41, 0xbf, 0x07, 0x0d,
+	0xcc, 0xa4, 0x25, 0x3e, 0x45, 0xdb, 0x77, 0x19, 0x96, 0x06, 0x65, 0x2c, 0x39, 0x51, 0xb6, 0x70,
+	0x7f, 0x5f, 0x6d, 0x1f, 0xc4, 0xbb, 0xe4, 0x35, 0x58, 0x7b, 0x48, 0x4f, 0x70, 0xa4, 0x62, 0x8d,
+	0x3c, 0xc6, 0x28, 0x53, 0x75, 0xbc, 0x1a, 0x93, 0xef, 0x22, 0xf5, 0xf5, 0x5d, 0xa8, 0x9f, 0xea,
+	0x20, 0xc8, 0x12, 0x14, 0x6e, 0xb8, 0xee, 0x7a, 0x8e, 0x00, 0x94, 0x4d, 0x3a, 0x0a, 0x1e, 0xd1,
+	0x75, 0x6d, 0xef, 0xaf, 0x45, 0xa8, 0xca, 0x11, 0xb1, 0x19, 0x3a, 0xe4, 0x0a, 0x54, 0xe2, 0xa1,
+	0x0a, 0x59, 0x37, 0xe6, 0x26, 0x53, 0xcd, 0xba, 0x31, 0x3f, 0x71, 0xd1, 0x73, 0xe4, 0x2a, 0x40,
+	0x3a, 0x2d, 0x20, 0xc4, 0x38, 0x35, 0x26, 0x69, 0x6e, 0x18, 0xa7, 0xc7, 0x09, 0x7a, 0x8e, 0x7c,
+	0x1f, 0x6a, 0x99, 0x8b, 0x9d, 0x6c, 0x18, 0x99, 0x55, 0xcc, 0xba, 0x69, 0x9c, 0x71, 0xf7, 0xeb,
+	0x39, 0xb2, 0x0b, 0x25, 0x9c, 0xc1, 0x92, 0x15, 0x23, 0x3b, 0xe6, 0x6d, 0xae, 0x1a, 0x33, 0xa3,
+	0x59, 0x3d, 0xa7, 0x3c, 0xc2, 0x71, 0x94, 0xf4, 0x28, 0x3b, 0x58, 0x6d, 0xd6, 0x33, 0x94, 0x84,
+	0xe5, 0x6d, 0xa8, 0xc6, 0x54, 0x46, 0xea, 0xc6, 0xfc, 0xb4, 0xb1, 0x49, 0x8c, 0x53, 0x13, 0x42,
+	0x3d, 0x47, 0x6e, 0xc2, 0xda, 0x5c, 0x97, 0x4f, 0xb6, 0x8d, 0xb3, 0x67, 0x0e, 0xcd, 0x86, 0xf1,
+	0x9c, 0x81, 0x80, 0x94, 0x33, 0xd7, 0xa2, 0x92, 0x6d, 0xe3, 0xec, 0x36, 0xbe, 0xd9, 0x30, 0x9e,
+	0xd3, 0xcd, 0xea, 0x39, 0x72, 0x1d, 0x56, 0x66, 0x3a, 0x32, 0x72, 0xce, 0x38, 0xab, 0x57, 0x6d,
+	0x6e, 0x19, 0x67, 0x36, 0x6e, 0x7a, 0x8e, 0x5c, 0x83, 0xe5, 0x6c, 0x3f, 0x43, 0x36, 0x8d, 0x33,
+	0xba, 0xb2, 0xe6, 0x39, 0xe3, 0xac, 0xa6, 0x47, 0xcf, 0xed, 0xfd, 0x4a, 0x83, 0xd2, 0xad, 0xbb,
+	0xa2, 0xaa, 0xbe, 0x7e, 0xd9, 0xea, 0x5c, 0x7f, 0xf2, 0xb4, 0x95, 0xfb, 0xdb, 0xd3, 0x56, 0xee,
+	0xcb, 0xa7, 0xad, 0xdc, 0xbf, 0x9e, 0xb6, 0x72, 0xff, 0x7e, 0xda, 0xd2, 0x7e, 0x36, 0x6d, 0x69,
+	0xbf, 0x99, 0xb6, 0xb4, 0xcf, 0xa7, 0xad, 0xdc, 0x9f, 0xa6, 0xad, 0xdc, 0x93, 0x69, 0x4b, 0xfb,
+	0x62, 0xda, 0xd2, 0xbe, 0x9c, 0xb6, 0xb4, 0x5f, 0xfe, 0xbd, 0x95, 0xbb, 0xad, 0x7d, 0x54, 0x91,
+	0xff, 0x0b, 0x0a, 0xfb, 0xfd, 0x32, 0xbe, 0x03, 0xdf, 0xfa, 0xef, 0x00, 0x1f, 0x24, 0xca, 0x87,
+	0x1e, 0x1a, 0x00, 0x00,
 }
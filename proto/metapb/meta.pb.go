@@ -177,14 +177,15 @@ func (*KeyPolicy) ProtoMessage()               {}
 func (*KeyPolicy) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{3} }
 
 type Space struct {
-	ID        SpaceID     `protobuf:"varint,1,opt,name=id,proto3,casttype=SpaceID" json:"id,omitempty"`
-	DB        DBID        `protobuf:"varint,2,opt,name=db,proto3,casttype=DBID" json:"db,omitempty"`
-	DbName    string      `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	Name      string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Type      SpaceType   `protobuf:"varint,5,opt,name=type,proto3,enum=SpaceType" json:"type,omitempty"`
-	Status    SpaceStatus `protobuf:"varint,6,opt,name=status,proto3,enum=SpaceStatus" json:"status,omitempty"`
-	KeyPolicy *KeyPolicy  `protobuf:"bytes,7,opt,name=key_policy,json=keyPolicy" json:"key_policy,omitempty"`
-	Schema    string      `protobuf:"bytes,8,opt,name=schema,proto3" json:"schema,omitempty"`
+	ID            SpaceID     `protobuf:"varint,1,opt,name=id,proto3,casttype=SpaceID" json:"id,omitempty"`
+	DB            DBID        `protobuf:"varint,2,opt,name=db,proto3,casttype=DBID" json:"db,omitempty"`
+	DbName        string      `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Name          string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Type          SpaceType   `protobuf:"varint,5,opt,name=type,proto3,enum=SpaceType" json:"type,omitempty"`
+	Status        SpaceStatus `protobuf:"varint,6,opt,name=status,proto3,enum=SpaceStatus" json:"status,omitempty"`
+	KeyPolicy     *KeyPolicy  `protobuf:"bytes,7,opt,name=key_policy,json=keyPolicy" json:"key_policy,omitempty"`
+	Schema        string      `protobuf:"bytes,8,opt,name=schema,proto3" json:"schema,omitempty"`
+	SchemaVersion uint64      `protobuf:"varint,9,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 }
 
 func (m *Space) Reset()                    { *m = Space{} }
@@ -514,6 +515,9 @@ func (this *Space) Equal(that interface{}) bool {
 	if this.Schema != that1.Schema {
 		return false
 	}
+	if this.SchemaVersion != that1.SchemaVersion {
+		return false
+	}
 	return true
 }
 func (this *PartitionEpoch) Equal(that interface{}) bool {
@@ -1135,6 +1139,11 @@ func (m *Space) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMeta(dAtA, i, uint64(len(m.Schema)))
 		i += copy(dAtA[i:], m.Schema)
 	}
+	if m.SchemaVersion != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.SchemaVersion))
+	}
 	return i, nil
 }
 
@@ -1714,6 +1723,7 @@ func NewPopulatedSpace(r randyMeta, easy bool) *Space {
 		this.KeyPolicy = NewPopulatedKeyPolicy(r, easy)
 	}
 	this.Schema = string(randStringMeta(r))
+	this.SchemaVersion = uint64(uint64(r.Uint32()))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2042,6 +2052,9 @@ func (m *Space) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMeta(uint64(l))
 	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovMeta(uint64(m.SchemaVersion))
+	}
 	return n
 }
 
@@ -2342,6 +2355,7 @@ func (this *Space) String() string {
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`KeyPolicy:` + strings.Replace(fmt.Sprintf("%v", this.KeyPolicy), "KeyPolicy", "KeyPolicy", 1) + `,`,
 		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3258,6 +3272,25 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 			}
 			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -5138,89 +5171,90 @@ var (
 func init() { proto.RegisterFile("meta.proto", fileDescriptorMeta) }
 
 var fileDescriptorMeta = []byte{
-	// 1339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x8f, 0xdb, 0xc4,
-	0x17, 0x8f, 0xb3, 0xce, 0x0f, 0x3f, 0x27, 0xa9, 0x3b, 0x6d, 0xbf, 0xdd, 0xf6, 0x2b, 0x9c, 0xc5,
-	0xa5, 0x68, 0xbb, 0x40, 0x5a, 0x2d, 0x12, 0x42, 0x15, 0x42, 0x6c, 0x9a, 0xb4, 0x8d, 0xd8, 0xa6,
-	0x2b, 0x27, 0x2a, 0xb4, 0x17, 0xcb, 0xb1, 0x67, 0xb3, 0xd6, 0x26, 0x1e, 0xd7, 0x9e, 0x54, 0xda,
-	0x9e, 0x7a, 0x83, 0xbf, 0x00, 0x71, 0x44, 0x82, 0x03, 0x27, 0xce, 0x1c, 0x39, 0xae, 0x38, 0xf5,
-	0x84, 0x38, 0x45, 0xdd, 0x70, 0xe4, 0xc2, 0x11, 0xed, 0x09, 0xcd, 0x0f, 0xcf, 0xa6, 0x5b, 0x09,
-	0x15, 0xa9, 0xa7, 0xcc, 0xe7, 0xbd, 0x37, 0x6f, 0x3e, 0xf3, 0x79, 0x2f, 0xcf, 0x03, 0x30, 0xc5,
-	0xd4, 0x6f, 0x25, 0x29, 0xa1, 0xe4, 0xf2, 0x07, 0xe3, 0x88, 0xee, 0xcd, 0x46, 0xad, 0x80, 0x4c,
-	0xaf, 0x8f, 0xc9, 0x98, 0x5c, 0xe7, 0xe6, 0xd1, 0x6c, 0x97, 0x23, 0x0e, 0xf8, 0x4a, 0x84, 0x3b,
-	0x5f, 0x82, 0xfe, 0x88, 0xc4, 0x18, 0x21, 0xd0, 0x63, 0x7f, 0x8a, 0x57, 0xb5, 0x35, 0x6d, 0xdd,
-	0x70, 0xf9, 0x1a, 0xbd, 0x0d, 0xb5, 0x0c, 0xa7, 0x4f, 0x70, 0xea, 0xf9, 0x61, 0x98, 0x66, 0xab,
-	0x45, 0xee, 0x33, 0x85, 0x6d, 0x8b, 0x99, 0xd0, 0x25, 0xa8, 0xa6, 0x84, 0x50, 0x2f, 0x8c, 0xd2,
-	0xd5, 0x15, 0xee, 0xae, 0x30, 0xdc, 0x89, 0x52, 0xe7, 0x36, 0xe8, 0x43, 0x3f, 0xdb, 0x47, 0x0d,
-	0x28, 0x46, 0xa1, 0xcc, 0x5b, 0x8c, 0x42, 0x76, 0x12, 0x3d, 0x48, 0xb0, 0xcc, 0xc6, 0xd7, 0xe8,
-	0x32, 0x54, 0x03, 0x12, 0x53, 0x1c, 0xd3, 0x4c, 0xa6, 0x51, 0xd8, 0xf9, 0x18, 0x8a, 0x9d, 0x36,
-	0xb2, 0x55, 0x96, 0x7a, 0xbb, 0xb1, 0x98, 0x37, 0x8b, 0xbd, 0xce, 0xf1, 0xbc, 0xa9, 0x77, 0xda,
-	0xbd, 0x4e, 0x9e, 0x95, 0xf3, 0x2f, 0x9e, 0xf0, 0x77, 0x6e, 0x81, 0xf1, 0x39, 0x3e, 0xd8, 0x21,
-	0x93, 0x28, 0x38, 0x40, 0xff, 0x07, 0x63, 0x1f, 0x1f, 0x78, 0xbb, 0x11, 0x9e, 0xe4, 0x6c, 0xaa,
-	0xfb, 0xf8, 0xe0, 0x36, 0xc3, 0xec, 0x1a, 0xdc, 0x39, 0x8b, 0x03, 0x99, 0xa1, 0xc2, 0x7c, 0xb3,
-	0x38, 0x70, 0x9e, 0x15, 0xa1, 0x34, 0x48, 0xfc, 0x80, 0xc9, 0x71, 0x42, 0xe1, 0xac, 0xa2, 0x50,
-	0xe1, 0x4e, 0xc9, 0xc2, 0x86, 0x62, 0x38, 0xe2, 0x19, 0x24, 0xcb, 0x4e, 0xfb, 0x84, 0x65, 0x38,
-	0x42, 0x17, 0xa1, 0x12, 0x8e, 0x3c, 0x4e, 0x54, 0x5c, 0xb3, 0x1c, 0x8e, 0xfa, 0x4c, 0xea, 0x9c,
-	0xbe, 0xbe, 0x24, 0xbf, 0x2d, 0x85, 0x2a, 0xad, 0x69, 0xeb, 0x8d, 0x4d, 0x68, 0xf1, 0x83, 0x86,
-	0x07, 0x09, 0x96, 0xa2, 0xbd, 0x03, 0xe5, 0x8c, 0xfa, 0x74, 0x96, 0xad, 0x96, 0x79, 0x44, 0x4d,
-	0x44, 0x0c, 0xb8, 0xcd, 0x95, 0x3e, 0x74, 0x0d, 0x80, 0x5d, 0x2d, 0xe1, 0x2a, 0xac, 0x56, 0xd6,
-	0xb4, 0x75, 0x73, 0x13, 0x5a, 0x4a, 0x17, 0x97, 0xa9, 0x22, 0x25, 0xfa, 0x1f, 0x94, 0xb3, 0x60,
-	0x0f, 0x4f, 0xfd, 0xd5, 0xaa, 0x20, 0x27, 0x90, 0x73, 0x0f, 0x1a, 0x3b, 0x7e, 0x4a, 0x23, 0x1a,
-	0x91, 0xb8, 0x9b, 0x90, 0x60, 0x8f, 0x75, 0x46, 0x40, 0xe2, 0x5d, 0xef, 0x09, 0x4e, 0xb3, 0x88,
-	0xc4, 0x5c, 0x14, 0xdd, 0x35, 0x99, 0xed, 0x81, 0x30, 0xa1, 0x55, 0xa8, 0xe4, 0xde, 0x22, 0xf7,
-	0xe6, 0xd0, 0xf9, 0xb3, 0x08, 0x86, 0xca, 0x87, 0xae, 0x2e, 0xa9, 0x7a, 0x41, 0xa9, 0x6a, 0xaa,
-	0x80, 0xd7, 0x54, 0x76, 0x03, 0x4a, 0x19, 0xbb, 0x3d, 0xd7, 0xb5, 0xde, 0x3e, 0xbf, 0x98, 0x37,
-	0x45, 0xd9, 0x96, 0x4b, 0x24, 0x42, 0xd0, 0x47, 0x00, 0x19, 0xf5, 0x53, 0xea, 0x65, 0x13, 0x42,
-	0xb9, 0xe4, 0xf5, 0xf6, 0xc5, 0xc5, 0xbc, 0x69, 0x0c, 0x98, 0x75, 0x30, 0x21, 0xf4, 0x78, 0xde,
-	0x2c, 0xb3, 0xdf, 0x5e, 0xc7, 0x35, 0xb2, 0xdc, 0x88, 0x6e, 0x40, 0x15, 0xc7, 0xa1, 0xd8, 0x55,
-	0x52, 0x84, 0x2b, 0xdd, 0x38, 0x3c, 0xb5, 0xa7, 0x82, 0x85, 0x09, 0x6d, 0x40, 0x35, 0xc5, 0xc9,
-	0x24, 0x0a, 0x7c, 0x56, 0xa4, 0x95, 0x75, 0x73, 0xb3, 0xda, 0x72, 0x85, 0xa1, 0xad, 0x1f, 0xce,
-	0x9b, 0x05, 0x57, 0xf9, 0xd1, 0xba, 0x2a, 0x67, 0x85, 0x97, 0xd3, 0x6a, 0x29, 0x0d, 0x4e, 0x95,
-	0xf4, 0x3d, 0x28, 0x61, 0x56, 0x06, 0x5e, 0x26, 0x73, 0xf3, 0x4c, 0xeb, 0xe5, 0xea, 0xc8, 0xcc,
-	0x22, 0xc6, 0xf9, 0x49, 0x83, 0x8a, 0x3c, 0x12, 0x5d, 0x51, 0x5a, 0xeb, 0xed, 0x73, 0x4a, 0x6b,
-	0x43, 0xba, 0xa5, 0xd2, 0xef, 0x43, 0x39, 0x26, 0x21, 0xee, 0x75, 0xa4, 0xda, 0x4c, 0xca, 0x72,
-	0x9f, 0x5b, 0x8e, 0xd5, 0xca, 0x95, 0x31, 0xe8, 0x13, 0xa8, 0xcb, 0x1b, 0xc8, 0x21, 0xb1, 0xc2,
-	0x39, 0xd5, 0xf3, 0x6b, 0xf2, 0x31, 0xd1, 0xae, 0x32, 0x46, 0xcf, 0xe7, 0x4d, 0xcd, 0xad, 0xa5,
-	0x4b, 0x76, 0xd6, 0xf6, 0x4f, 0x49, 0xac, 0xda, 0x9e, 0xad, 0x9d, 0x1f, 0x34, 0xd0, 0xd9, 0x21,
-	0x68, 0x6d, 0xa9, 0x33, 0x2c, 0xc5, 0x36, 0x27, 0xc0, 0xa8, 0xb2, 0xd1, 0x92, 0xc8, 0x3f, 0x6c,
-	0x31, 0x4a, 0x54, 0xba, 0x95, 0x93, 0x74, 0xcb, 0x7d, 0xc8, 0x2b, 0xad, 0xfa, 0xf0, 0x55, 0xea,
-	0xa5, 0xff, 0x40, 0xdd, 0xf9, 0x46, 0x83, 0xda, 0x72, 0x20, 0xba, 0x0a, 0x8d, 0x3d, 0xec, 0xa7,
-	0x74, 0x84, 0x7d, 0xca, 0x13, 0xca, 0x29, 0x53, 0x57, 0x56, 0x16, 0xc7, 0xc2, 0x64, 0x1e, 0x8a,
-	0x45, 0x98, 0xe0, 0x5f, 0x57, 0x56, 0x1e, 0xc6, 0x06, 0x6b, 0x12, 0x88, 0x80, 0x7c, 0xb0, 0x26,
-	0x01, 0x77, 0xbd, 0x05, 0xe0, 0x87, 0xd3, 0x28, 0x16, 0x4e, 0x21, 0x9d, 0xc1, 0x2d, 0xcc, 0xed,
-	0x7c, 0x06, 0x75, 0x17, 0x3f, 0x9e, 0xe1, 0x8c, 0xde, 0xc5, 0x7e, 0x88, 0x53, 0x74, 0x01, 0xca,
-	0x29, 0x7e, 0xec, 0xa9, 0x21, 0x5c, 0x4a, 0xf1, 0xe3, 0x5e, 0xc8, 0x84, 0xa1, 0xd1, 0x14, 0x93,
-	0x19, 0xcd, 0x47, 0x9e, 0x84, 0xce, 0x57, 0x1a, 0x34, 0x5c, 0x9c, 0x25, 0x24, 0xce, 0xf0, 0xbf,
-	0xe7, 0x58, 0x03, 0x3d, 0x20, 0x21, 0x96, 0x9d, 0x52, 0x3b, 0x9e, 0x37, 0xab, 0x6c, 0xe3, 0x2d,
-	0x12, 0x62, 0x97, 0x7b, 0xd8, 0x29, 0x53, 0x9c, 0x65, 0xfe, 0x38, 0xaf, 0x4a, 0x0e, 0x91, 0x03,
-	0x25, 0x9c, 0xa6, 0x44, 0xdc, 0xc0, 0xdc, 0x2c, 0xb7, 0xba, 0x0c, 0xa9, 0xe6, 0x65, 0xc0, 0xf9,
-	0x55, 0x03, 0xa3, 0x4f, 0xe8, 0xb6, 0x20, 0xb1, 0x05, 0xb5, 0x24, 0xef, 0x74, 0x4f, 0xb5, 0x86,
-	0xbd, 0x78, 0x79, 0x5c, 0x9c, 0x9e, 0x1e, 0xa6, 0xda, 0xd3, 0xe3, 0xcd, 0x3d, 0xe1, 0xc9, 0x96,
-	0x9b, 0x5b, 0xa4, 0x5f, 0x6e, 0x6e, 0x11, 0x83, 0x9a, 0x60, 0x8a, 0xd5, 0x72, 0x1d, 0x40, 0x98,
-	0x78, 0x29, 0xd4, 0x3f, 0x51, 0x7f, 0x8d, 0x7f, 0xe2, 0x3d, 0xa8, 0xf6, 0xc9, 0x1b, 0xbb, 0x8a,
-	0xf3, 0x00, 0xce, 0x2a, 0x5f, 0x9f, 0xd0, 0xdb, 0x64, 0x16, 0x87, 0x6f, 0x22, 0xef, 0x3e, 0x98,
-	0xf7, 0xb2, 0xf1, 0x90, 0x90, 0x6d, 0x3f, 0x1d, 0xe3, 0x37, 0x21, 0xfa, 0x25, 0xa8, 0x4e, 0xb3,
-	0xb1, 0x97, 0x45, 0x4f, 0x71, 0xfe, 0x2d, 0x98, 0x66, 0xe3, 0x41, 0xf4, 0x14, 0x3b, 0x0d, 0xa8,
-	0x0d, 0x45, 0xd7, 0xf1, 0xea, 0x3b, 0x57, 0xc0, 0x1c, 0xf0, 0xe7, 0x05, 0x87, 0xe8, 0x3c, 0x94,
-	0x02, 0x7f, 0x96, 0xe5, 0xcf, 0x12, 0x01, 0x9c, 0xdf, 0x34, 0x28, 0x09, 0xff, 0x35, 0x80, 0x98,
-	0x50, 0x4f, 0x96, 0x54, 0x93, 0x1f, 0x37, 0xd5, 0x31, 0xae, 0x11, 0xab, 0xe6, 0x79, 0x17, 0x8c,
-	0x98, 0x78, 0x4b, 0xc5, 0x37, 0x37, 0x8d, 0x56, 0x5e, 0x0f, 0xb7, 0x1a, 0xe7, 0x95, 0x69, 0xc3,
-	0xb9, 0x93, 0xfb, 0xb2, 0xe4, 0xbb, 0x4c, 0x58, 0x39, 0xd6, 0x50, 0xeb, 0x15, 0xc9, 0xdd, 0xb3,
-	0xc9, 0x2b, 0x55, 0xb8, 0x01, 0x75, 0x76, 0x61, 0x4a, 0x88, 0x37, 0x61, 0x22, 0xca, 0xf6, 0xa8,
-	0xb5, 0x96, 0x84, 0x75, 0xcd, 0xe9, 0x09, 0xb8, 0xa9, 0x1f, 0x7e, 0xd7, 0xd4, 0x36, 0x12, 0x30,
-	0x97, 0x3e, 0xe1, 0xa8, 0x01, 0x30, 0x18, 0x78, 0xbd, 0xf8, 0x89, 0x3f, 0x89, 0x42, 0xab, 0x80,
-	0x4c, 0xa8, 0x70, 0x1c, 0x51, 0x4b, 0x93, 0xce, 0x9d, 0x14, 0x27, 0x7e, 0x8a, 0xad, 0xa2, 0xc4,
-	0xee, 0x2c, 0x8e, 0xa3, 0x78, 0x6c, 0xad, 0xa0, 0x3a, 0x18, 0x83, 0x81, 0xd7, 0xc1, 0x13, 0x4c,
-	0xb1, 0xa5, 0xa3, 0x33, 0x60, 0xe6, 0x90, 0xf9, 0x4b, 0x97, 0xf5, 0xaf, 0xbf, 0xb7, 0x0b, 0x1b,
-	0x37, 0xc1, 0x50, 0xcf, 0x0a, 0xbe, 0x65, 0xe8, 0x75, 0xfb, 0xc3, 0xde, 0xf0, 0xa1, 0x3c, 0x6e,
-	0xe8, 0x75, 0x3b, 0x77, 0xba, 0x96, 0x26, 0x41, 0x7b, 0xfb, 0x7e, 0xdb, 0x2a, 0xca, 0xbd, 0x13,
-	0x38, 0x73, 0xea, 0x0b, 0xc5, 0x48, 0xec, 0x6c, 0x79, 0xbd, 0xfe, 0x83, 0xad, 0xed, 0x5e, 0xc7,
-	0x2a, 0x48, 0xdc, 0xbf, 0x3f, 0x74, 0xbb, 0x5b, 0x1d, 0x4b, 0x63, 0x2c, 0x76, 0xb6, 0x3c, 0x06,
-	0xee, 0xf7, 0xb7, 0x1f, 0x5a, 0x45, 0x64, 0x41, 0x4d, 0x1a, 0xbe, 0x70, 0x7b, 0xc3, 0xae, 0xb5,
-	0x22, 0x2d, 0x83, 0x9d, 0xed, 0xde, 0x70, 0xd8, 0xeb, 0xdf, 0xb1, 0x74, 0x71, 0x5a, 0xfb, 0xd3,
-	0xc3, 0x23, 0xbb, 0xf0, 0xfb, 0x91, 0x5d, 0x78, 0x71, 0x64, 0x17, 0xfe, 0x3a, 0xb2, 0x0b, 0x7f,
-	0x1f, 0xd9, 0xda, 0xb3, 0x85, 0xad, 0xfd, 0xb8, 0xb0, 0xb5, 0x9f, 0x17, 0x76, 0xe1, 0x97, 0x85,
-	0x5d, 0x38, 0x5c, 0xd8, 0xda, 0xf3, 0x85, 0xad, 0xbd, 0x58, 0xd8, 0xda, 0xb7, 0x7f, 0xd8, 0x85,
-	0xbb, 0xda, 0xa3, 0x32, 0x7b, 0x1b, 0x27, 0xa3, 0x51, 0x99, 0xbf, 0x77, 0x3f, 0xfc, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x4b, 0xa2, 0xb7, 0x6e, 0x2c, 0x0b, 0x00, 0x00,
+	// 1351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x8f, 0xdb, 0xc4,
+	0x1b, 0x8e, 0xbd, 0xce, 0x87, 0x5f, 0x27, 0xa9, 0x3b, 0x6d, 0x7f, 0x4d, 0xfb, 0x13, 0xce, 0xe2,
+	0x52, 0xb4, 0x5d, 0x20, 0xad, 0x16, 0x09, 0xa1, 0x0a, 0x21, 0x36, 0x4d, 0xda, 0x46, 0x6c, 0xd3,
+	0x95, 0x13, 0x15, 0xda, 0x8b, 0xe5, 0xd8, 0xb3, 0x59, 0x6b, 0x13, 0x8f, 0x6b, 0x3b, 0x95, 0xb6,
+	0x27, 0x6e, 0xf0, 0x17, 0x20, 0x8e, 0x48, 0x20, 0xc1, 0x89, 0x33, 0x47, 0x8e, 0x2b, 0x4e, 0x3d,
+	0x21, 0x4e, 0x51, 0x37, 0x1c, 0xb9, 0x70, 0x44, 0x7b, 0x42, 0xf3, 0xe1, 0x49, 0xba, 0x95, 0x50,
+	0x91, 0x7a, 0xca, 0xbc, 0x1f, 0xf3, 0xce, 0x33, 0xcf, 0xf3, 0xce, 0x1b, 0x03, 0x4c, 0x71, 0xe6,
+	0xb5, 0xe2, 0x84, 0x64, 0xe4, 0xf2, 0x7b, 0xe3, 0x30, 0xdb, 0x9f, 0x8d, 0x5a, 0x3e, 0x99, 0x5e,
+	0x1f, 0x93, 0x31, 0xb9, 0xce, 0xdc, 0xa3, 0xd9, 0x1e, 0xb3, 0x98, 0xc1, 0x56, 0x3c, 0xdd, 0xfe,
+	0x1c, 0xb4, 0x47, 0x24, 0xc2, 0x08, 0x81, 0x16, 0x79, 0x53, 0xdc, 0x50, 0xd6, 0x95, 0x0d, 0xdd,
+	0x61, 0x6b, 0xf4, 0x26, 0x54, 0x53, 0x9c, 0x3c, 0xc1, 0x89, 0xeb, 0x05, 0x41, 0x92, 0x36, 0x54,
+	0x16, 0x33, 0xb8, 0x6f, 0x9b, 0xba, 0xd0, 0x25, 0xa8, 0x24, 0x84, 0x64, 0x6e, 0x10, 0x26, 0x8d,
+	0x35, 0x16, 0x2e, 0x53, 0xbb, 0x13, 0x26, 0xf6, 0x6d, 0xd0, 0x86, 0x5e, 0x7a, 0x80, 0xea, 0xa0,
+	0x86, 0x81, 0xa8, 0xab, 0x86, 0x01, 0x3d, 0x29, 0x3b, 0x8c, 0xb1, 0xa8, 0xc6, 0xd6, 0xe8, 0x32,
+	0x54, 0x7c, 0x12, 0x65, 0x38, 0xca, 0x52, 0x51, 0x46, 0xda, 0xf6, 0x87, 0xa0, 0x76, 0xda, 0xc8,
+	0x92, 0x55, 0x6a, 0xed, 0xfa, 0x62, 0xde, 0x54, 0x7b, 0x9d, 0x93, 0x79, 0x53, 0xeb, 0xb4, 0x7b,
+	0x9d, 0xbc, 0x2a, 0xc3, 0xaf, 0x2e, 0xf1, 0xdb, 0xb7, 0x40, 0xff, 0x14, 0x1f, 0xee, 0x92, 0x49,
+	0xe8, 0x1f, 0xa2, 0xff, 0x83, 0x7e, 0x80, 0x0f, 0xdd, 0xbd, 0x10, 0x4f, 0x72, 0x34, 0x95, 0x03,
+	0x7c, 0x78, 0x9b, 0xda, 0xf4, 0x1a, 0x2c, 0x38, 0x8b, 0x7c, 0x51, 0xa1, 0x4c, 0x63, 0xb3, 0xc8,
+	0xb7, 0x7f, 0x50, 0xa1, 0x38, 0x88, 0x3d, 0x9f, 0xd2, 0xb1, 0x84, 0x70, 0x56, 0x42, 0x28, 0xb3,
+	0xa0, 0x40, 0x61, 0x81, 0x1a, 0x8c, 0x1a, 0xea, 0x12, 0x65, 0xa7, 0xbd, 0x44, 0x19, 0x8c, 0xd0,
+	0x45, 0x28, 0x07, 0x23, 0x97, 0x01, 0xe5, 0xd7, 0x2c, 0x05, 0xa3, 0x3e, 0xa5, 0x3a, 0x87, 0xaf,
+	0xad, 0xd0, 0x6f, 0x09, 0xa2, 0x8a, 0xeb, 0xca, 0x46, 0x7d, 0x0b, 0x5a, 0xec, 0xa0, 0xe1, 0x61,
+	0x8c, 0x05, 0x69, 0x6f, 0x41, 0x29, 0xcd, 0xbc, 0x6c, 0x96, 0x36, 0x4a, 0x2c, 0xa3, 0xca, 0x33,
+	0x06, 0xcc, 0xe7, 0x88, 0x18, 0xba, 0x06, 0x40, 0xaf, 0x16, 0x33, 0x16, 0x1a, 0xe5, 0x75, 0x65,
+	0xc3, 0xd8, 0x82, 0x96, 0xe4, 0xc5, 0xd1, 0x0f, 0xf2, 0x25, 0xfa, 0x1f, 0x94, 0x52, 0x7f, 0x1f,
+	0x4f, 0xbd, 0x46, 0x85, 0x83, 0xe3, 0x16, 0xba, 0x0a, 0x75, 0xbe, 0x72, 0x9f, 0xe0, 0x24, 0x0d,
+	0x49, 0xd4, 0xd0, 0xd7, 0x95, 0x0d, 0xcd, 0xa9, 0x71, 0xef, 0x03, 0xee, 0xb4, 0xef, 0x41, 0x7d,
+	0xd7, 0x4b, 0xb2, 0x30, 0x0b, 0x49, 0xd4, 0x8d, 0x89, 0xbf, 0x4f, 0x1b, 0xc8, 0x27, 0xd1, 0x9e,
+	0xdc, 0xa6, 0xb0, 0x6d, 0x06, 0xf5, 0x89, 0x4d, 0xa8, 0x01, 0xe5, 0x3c, 0xaa, 0xb2, 0x68, 0x6e,
+	0xda, 0x7f, 0xaa, 0xa0, 0xcb, 0x7a, 0xe8, 0xea, 0x0a, 0xf9, 0x17, 0x24, 0xf9, 0x86, 0x4c, 0x78,
+	0x45, 0x01, 0x36, 0xa1, 0x98, 0x52, 0x92, 0x18, 0xfd, 0xb5, 0xf6, 0xf9, 0xc5, 0xbc, 0xc9, 0xd5,
+	0x5d, 0x55, 0x92, 0xa7, 0xa0, 0x0f, 0x00, 0xd2, 0xcc, 0x4b, 0x32, 0x37, 0x9d, 0x90, 0x8c, 0x29,
+	0x53, 0x6b, 0x5f, 0x5c, 0xcc, 0x9b, 0xfa, 0x80, 0x7a, 0x07, 0x13, 0x92, 0x9d, 0xcc, 0x9b, 0x25,
+	0xfa, 0xdb, 0xeb, 0x38, 0x7a, 0x9a, 0x3b, 0xd1, 0x0d, 0xa8, 0xe0, 0x28, 0xe0, 0xbb, 0x8a, 0x12,
+	0x70, 0xb9, 0x1b, 0x05, 0xa7, 0xf6, 0x94, 0x31, 0x77, 0xa1, 0x4d, 0xa8, 0x24, 0x38, 0x9e, 0x84,
+	0xbe, 0x47, 0xb5, 0x5c, 0xdb, 0x30, 0xb6, 0x2a, 0x2d, 0x87, 0x3b, 0xda, 0xda, 0xd1, 0xbc, 0x59,
+	0x70, 0x64, 0x1c, 0x6d, 0x48, 0xd5, 0xcb, 0x4c, 0x75, 0xb3, 0x25, 0x39, 0x38, 0xa5, 0xfc, 0x3b,
+	0x50, 0xc4, 0x54, 0x06, 0xa6, 0xa6, 0xb1, 0x75, 0xa6, 0xf5, 0xa2, 0x3a, 0xa2, 0x32, 0xcf, 0xb1,
+	0x7f, 0x52, 0xa0, 0x2c, 0x8e, 0x44, 0x57, 0x24, 0xd7, 0x5a, 0xfb, 0x9c, 0xe4, 0x5a, 0x17, 0x61,
+	0xc1, 0xf4, 0xbb, 0x50, 0x8a, 0x48, 0x80, 0x7b, 0x9d, 0x86, 0x2a, 0xa9, 0x2c, 0xf5, 0x99, 0xe7,
+	0x44, 0xae, 0x1c, 0x91, 0x83, 0x3e, 0x82, 0x9a, 0xb8, 0x81, 0x98, 0x25, 0x6b, 0x0c, 0x53, 0x2d,
+	0xbf, 0x26, 0x9b, 0x26, 0xed, 0x0a, 0x45, 0xf4, 0x6c, 0xde, 0x54, 0x9c, 0x6a, 0xb2, 0xe2, 0xa7,
+	0xaf, 0xe3, 0x29, 0x89, 0xe4, 0xeb, 0xa0, 0x6b, 0xfb, 0x7b, 0x05, 0x34, 0x7a, 0x08, 0x5a, 0x5f,
+	0xe9, 0x0c, 0x53, 0xa2, 0xcd, 0x01, 0x50, 0xa8, 0x74, 0x02, 0xc5, 0xe2, 0x5d, 0xab, 0x61, 0x2c,
+	0xcb, 0xad, 0x2d, 0xcb, 0xad, 0xf6, 0x21, 0x53, 0x5a, 0xf6, 0xe1, 0xcb, 0xd0, 0x8b, 0xff, 0x01,
+	0xba, 0xfd, 0xb5, 0x02, 0xd5, 0xd5, 0x44, 0xfa, 0x98, 0xf6, 0xb1, 0x97, 0x64, 0x23, 0xec, 0x65,
+	0xac, 0xa0, 0x18, 0x46, 0x35, 0xe9, 0xa5, 0x79, 0x34, 0x4d, 0xd4, 0xc9, 0x30, 0x4f, 0xe3, 0xf8,
+	0x6b, 0xd2, 0xcb, 0xd2, 0xe8, 0xfc, 0x8d, 0x7d, 0x9e, 0x90, 0xcf, 0xdf, 0xd8, 0x67, 0xa1, 0x37,
+	0x00, 0xbc, 0x60, 0x1a, 0x46, 0x3c, 0xc8, 0xa9, 0xd3, 0x99, 0x87, 0x86, 0xed, 0x4f, 0xa0, 0xe6,
+	0xe0, 0xc7, 0x33, 0x9c, 0x66, 0x77, 0xb1, 0x17, 0xe0, 0x04, 0x5d, 0x80, 0x52, 0x82, 0x1f, 0xbb,
+	0x72, 0x56, 0x17, 0x13, 0xfc, 0xb8, 0x17, 0x50, 0x62, 0xb2, 0x70, 0x8a, 0xc9, 0x2c, 0xcb, 0x27,
+	0xa3, 0x30, 0xed, 0x2f, 0x15, 0xa8, 0x3b, 0x38, 0x8d, 0x49, 0x94, 0xe2, 0x7f, 0xaf, 0xb1, 0x0e,
+	0x9a, 0x4f, 0x02, 0x2c, 0x3a, 0xa5, 0x7a, 0x32, 0x6f, 0x56, 0xe8, 0xc6, 0x5b, 0x24, 0xc0, 0x0e,
+	0x8b, 0xd0, 0x53, 0xa6, 0x38, 0x4d, 0xbd, 0x71, 0xae, 0x4a, 0x6e, 0x22, 0x1b, 0x8a, 0x38, 0x49,
+	0x08, 0xbf, 0x81, 0xb1, 0x55, 0x6a, 0x75, 0xa9, 0x25, 0x9b, 0x97, 0x1a, 0xf6, 0xaf, 0x0a, 0xe8,
+	0x7d, 0x92, 0xed, 0x70, 0x10, 0xdb, 0x50, 0x8d, 0xf3, 0x4e, 0x77, 0x65, 0x6b, 0x58, 0x8b, 0x17,
+	0xc7, 0xc5, 0xe9, 0xe9, 0x61, 0xc8, 0x3d, 0x3d, 0xd6, 0xdc, 0x13, 0x56, 0x6c, 0xb5, 0xb9, 0x79,
+	0xf9, 0xd5, 0xe6, 0xe6, 0x39, 0xa8, 0x09, 0x06, 0x5f, 0xad, 0xea, 0x00, 0xdc, 0xc5, 0xa4, 0x90,
+	0x2f, 0x51, 0x7b, 0x85, 0x97, 0x78, 0x0f, 0x2a, 0x7d, 0xf2, 0xda, 0xae, 0x62, 0x3f, 0x80, 0xb3,
+	0x32, 0xd6, 0x27, 0xd9, 0x6d, 0x32, 0x8b, 0x82, 0xd7, 0x51, 0xf7, 0x00, 0x8c, 0x7b, 0xe9, 0x78,
+	0x48, 0xc8, 0x8e, 0x97, 0x8c, 0xf1, 0xeb, 0x20, 0xfd, 0x12, 0x54, 0xa6, 0xe9, 0xd8, 0x4d, 0xc3,
+	0xa7, 0x38, 0xff, 0x2f, 0x98, 0xa6, 0xe3, 0x41, 0xf8, 0x14, 0xdb, 0x75, 0xa8, 0x0e, 0x79, 0xd7,
+	0x31, 0xf5, 0xed, 0x2b, 0x60, 0x0c, 0xd8, 0x57, 0x08, 0x33, 0xd1, 0x79, 0x28, 0xfa, 0xde, 0x2c,
+	0xcd, 0xbf, 0x5e, 0xb8, 0x61, 0xff, 0xa6, 0x40, 0x91, 0xc7, 0xaf, 0x01, 0x44, 0x24, 0x73, 0x85,
+	0xa4, 0x8a, 0xf8, 0x0f, 0x94, 0x1d, 0xe3, 0xe8, 0x51, 0xbe, 0x44, 0x6f, 0x83, 0x1e, 0x11, 0x77,
+	0x45, 0x7c, 0x63, 0x4b, 0x6f, 0xe5, 0x7a, 0x38, 0x95, 0x48, 0xac, 0x50, 0x1b, 0xce, 0x2d, 0xef,
+	0x4b, 0x8b, 0xef, 0x51, 0x62, 0xc5, 0x58, 0x43, 0xad, 0x97, 0x28, 0x77, 0xce, 0xc6, 0x2f, 0xa9,
+	0x70, 0x03, 0x6a, 0xf4, 0xc2, 0x19, 0x21, 0xee, 0x84, 0x92, 0x28, 0xda, 0xa3, 0xda, 0x5a, 0x21,
+	0xd6, 0x31, 0xa6, 0x4b, 0xe3, 0xa6, 0x76, 0xf4, 0x6d, 0x53, 0xd9, 0x8c, 0xc1, 0x58, 0xf9, 0xa7,
+	0x47, 0x75, 0x80, 0xc1, 0xc0, 0xed, 0x45, 0x4f, 0xbc, 0x49, 0x18, 0x98, 0x05, 0x64, 0x40, 0x99,
+	0xd9, 0x61, 0x66, 0x2a, 0x22, 0xb8, 0x9b, 0xe0, 0xd8, 0x4b, 0xb0, 0xa9, 0x0a, 0xdb, 0x99, 0x45,
+	0x51, 0x18, 0x8d, 0xcd, 0x35, 0x54, 0x03, 0x7d, 0x30, 0x70, 0x3b, 0x78, 0x82, 0x33, 0x6c, 0x6a,
+	0xe8, 0x0c, 0x18, 0xb9, 0x49, 0xe3, 0xc5, 0xcb, 0xda, 0x57, 0xdf, 0x59, 0x85, 0xcd, 0x9b, 0xa0,
+	0xcb, 0xaf, 0x0f, 0xb6, 0x65, 0xe8, 0x76, 0xfb, 0xc3, 0xde, 0xf0, 0xa1, 0x38, 0x6e, 0xe8, 0x76,
+	0x3b, 0x77, 0xba, 0xa6, 0x22, 0x8c, 0xf6, 0xce, 0xfd, 0xb6, 0xa9, 0x8a, 0xbd, 0x13, 0x38, 0x73,
+	0xea, 0x1f, 0x8a, 0x82, 0xd8, 0xdd, 0x76, 0x7b, 0xfd, 0x07, 0xdb, 0x3b, 0xbd, 0x8e, 0x59, 0x10,
+	0x76, 0xff, 0xfe, 0xd0, 0xe9, 0x6e, 0x77, 0x4c, 0x85, 0xa2, 0xd8, 0xdd, 0x76, 0xa9, 0x71, 0xbf,
+	0xbf, 0xf3, 0xd0, 0x54, 0x91, 0x09, 0x55, 0xe1, 0xf8, 0xcc, 0xe9, 0x0d, 0xbb, 0xe6, 0x9a, 0xf0,
+	0x0c, 0x76, 0x77, 0x7a, 0xc3, 0x61, 0xaf, 0x7f, 0xc7, 0xd4, 0xf8, 0x69, 0xed, 0x8f, 0x8f, 0x8e,
+	0xad, 0xc2, 0xef, 0xc7, 0x56, 0xe1, 0xf9, 0xb1, 0x55, 0xf8, 0xeb, 0xd8, 0x2a, 0xfc, 0x7d, 0x6c,
+	0x29, 0x5f, 0x2c, 0x2c, 0xe5, 0xc7, 0x85, 0xa5, 0xfc, 0xbc, 0xb0, 0x0a, 0xbf, 0x2c, 0xac, 0xc2,
+	0xd1, 0xc2, 0x52, 0x9e, 0x2d, 0x2c, 0xe5, 0xf9, 0xc2, 0x52, 0xbe, 0xf9, 0xc3, 0x2a, 0xdc, 0x55,
+	0x1e, 0x95, 0xe8, 0x27, 0x74, 0x3c, 0x1a, 0x95, 0xd8, 0x67, 0xf1, 0xfb, 0xff, 0x0c, 0x00, 0x10,
+	0xf0, 0x13, 0xbb, 0x53, 0x0b, 0x00, 0x00,
 }
@@ -17,6 +17,26 @@
 		DeleteRequest
 		DeleteResponse
 		Failure
+		DeleteByQueryRequest
+		UpdateByQueryRequest
+		ByQueryResponse
+		AnalyzeRequest
+		AnalyzeToken
+		AnalyzeResponse
+		SearchRequest
+		ScrollRequest
+		ClearScrollRequest
+		ClearScrollResponse
+		SearchResponse
+		GetRequest
+		GetResult
+		GetResponse
+		MultiGetRequest
+		MultiGetResponse
+		BulkRequest
+		BulkResponse
+		CountRequest
+		CountResponse
 */
 package pspb
 
@@ -24,11 +44,15 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import meta "github.com/tiglabs/baudengine/proto/metapb"
 
 import github_com_tiglabs_baudengine_proto_metapb "github.com/tiglabs/baudengine/proto/metapb"
 
 import bytes "bytes"
 
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -80,6 +104,8 @@ const (
 	WriteResult_DELETED   WriteResult = 2
 	WriteResult_NOT_FOUND WriteResult = 3
 	WriteResult_NOOP      WriteResult = 4
+	// the precondition on the version of the document is not met
+	WriteResult_VERSION_CONFLICT WriteResult = 5
 )
 
 var WriteResult_name = map[int32]string{
@@ -88,13 +114,15 @@ var WriteResult_name = map[int32]string{
 	2: "DELETED",
 	3: "NOT_FOUND",
 	4: "NOOP",
+	5: "VERSION_CONFLICT",
 }
 var WriteResult_value = map[string]int32{
-	"CREATED":   0,
-	"UPDATED":   1,
-	"DELETED":   2,
-	"NOT_FOUND": 3,
-	"NOOP":      4,
+	"CREATED":          0,
+	"UPDATED":          1,
+	"DELETED":          2,
+	"NOT_FOUND":        3,
+	"NOOP":             4,
+	"VERSION_CONFLICT": 5,
 }
 
 func (x WriteResult) String() string {
@@ -135,8 +163,11 @@ func (*CreateRequest) ProtoMessage()               {}
 func (*CreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{2} }
 
 type CreateResponse struct {
-	ID     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
-	Result WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	ID          github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Result      WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	Version     uint64                                         `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SeqNo       uint64                                         `protobuf:"varint,4,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+	PrimaryTerm uint64                                         `protobuf:"varint,5,opt,name=primary_term,json=primaryTerm,proto3" json:"primary_term,omitempty"`
 }
 
 func (m *CreateResponse) Reset()                    { *m = CreateResponse{} }
@@ -147,6 +178,12 @@ type UpdateRequest struct {
 	ID     github_com_tiglabs_baudengine_proto_metapb.Key   `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
 	Data   github_com_tiglabs_baudengine_proto_metapb.Value `protobuf:"bytes,2,opt,name=data,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Value" json:"data,omitempty"`
 	Upsert bool                                             `protobuf:"varint,3,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	// merge data into the stored source as a RFC 7396 merge patch instead of replacing the document
+	Merge bool `protobuf:"varint,4,opt,name=merge,proto3" json:"merge,omitempty"`
+	// the preconditions on the current version of the document, zero means no condition
+	IfVersion     uint64 `protobuf:"varint,5,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	IfSeqNo       uint64 `protobuf:"varint,6,opt,name=if_seq_no,json=ifSeqNo,proto3" json:"if_seq_no,omitempty"`
+	IfPrimaryTerm uint64 `protobuf:"varint,7,opt,name=if_primary_term,json=ifPrimaryTerm,proto3" json:"if_primary_term,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
@@ -154,8 +191,11 @@ func (*UpdateRequest) ProtoMessage()               {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{4} }
 
 type UpdateResponse struct {
-	ID     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
-	Result WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	ID          github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Result      WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	Version     uint64                                         `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SeqNo       uint64                                         `protobuf:"varint,4,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+	PrimaryTerm uint64                                         `protobuf:"varint,5,opt,name=primary_term,json=primaryTerm,proto3" json:"primary_term,omitempty"`
 }
 
 func (m *UpdateResponse) Reset()                    { *m = UpdateResponse{} }
@@ -164,6 +204,10 @@ func (*UpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi,
 
 type DeleteRequest struct {
 	ID github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	// the preconditions on the current version of the document, zero means no condition
+	IfVersion     uint64 `protobuf:"varint,2,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	IfSeqNo       uint64 `protobuf:"varint,3,opt,name=if_seq_no,json=ifSeqNo,proto3" json:"if_seq_no,omitempty"`
+	IfPrimaryTerm uint64 `protobuf:"varint,4,opt,name=if_primary_term,json=ifPrimaryTerm,proto3" json:"if_primary_term,omitempty"`
 }
 
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
@@ -171,8 +215,11 @@ func (*DeleteRequest) ProtoMessage()               {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{6} }
 
 type DeleteResponse struct {
-	ID     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
-	Result WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	ID          github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Result      WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	Version     uint64                                         `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SeqNo       uint64                                         `protobuf:"varint,4,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+	PrimaryTerm uint64                                         `protobuf:"varint,5,opt,name=primary_term,json=primaryTerm,proto3" json:"primary_term,omitempty"`
 }
 
 func (m *DeleteResponse) Reset()                    { *m = DeleteResponse{} }
@@ -188,6 +235,245 @@ func (m *Failure) Reset()                    { *m = Failure{} }
 func (*Failure) ProtoMessage()               {}
 func (*Failure) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{8} }
 
+type DeleteByQueryRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	Query              []byte                                                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// the number of documents deleted by each raft batch
+	BatchSize int32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	MaxDocs   int64 `protobuf:"varint,5,opt,name=max_docs,json=maxDocs,proto3" json:"max_docs,omitempty"`
+	// continue instead of aborting when a matched document was changed concurrently
+	ProceedOnConflicts bool `protobuf:"varint,6,opt,name=proceed_on_conflicts,json=proceedOnConflicts,proto3" json:"proceed_on_conflicts,omitempty"`
+}
+
+func (m *DeleteByQueryRequest) Reset()                    { *m = DeleteByQueryRequest{} }
+func (*DeleteByQueryRequest) ProtoMessage()               {}
+func (*DeleteByQueryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{9} }
+
+type UpdateByQueryRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	Query              []byte                                                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// the number of documents updated by each raft batch
+	BatchSize int32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	MaxDocs   int64 `protobuf:"varint,5,opt,name=max_docs,json=maxDocs,proto3" json:"max_docs,omitempty"`
+	// continue instead of aborting when a matched document was changed concurrently
+	ProceedOnConflicts bool `protobuf:"varint,6,opt,name=proceed_on_conflicts,json=proceedOnConflicts,proto3" json:"proceed_on_conflicts,omitempty"`
+	// the partial document merged into the matched documents as a RFC 7396 merge patch, empty to reindex them as they are
+	Doc []byte `protobuf:"bytes,7,opt,name=doc,proto3" json:"doc,omitempty"`
+}
+
+func (m *UpdateByQueryRequest) Reset()                    { *m = UpdateByQueryRequest{} }
+func (*UpdateByQueryRequest) ProtoMessage()               {}
+func (*UpdateByQueryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+
+type ByQueryResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Took                int64     `protobuf:"varint,2,opt,name=took,proto3" json:"took,omitempty"`
+	TimedOut            bool      `protobuf:"varint,3,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Total               int64     `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Deleted             int64     `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Updated             int64     `protobuf:"varint,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	Batches             int64     `protobuf:"varint,7,opt,name=batches,proto3" json:"batches,omitempty"`
+	VersionConflicts    int64     `protobuf:"varint,8,opt,name=version_conflicts,json=versionConflicts,proto3" json:"version_conflicts,omitempty"`
+	Noops               int64     `protobuf:"varint,9,opt,name=noops,proto3" json:"noops,omitempty"`
+	Failures            []Failure `protobuf:"bytes,10,rep,name=failures" json:"failures"`
+}
+
+func (m *ByQueryResponse) Reset()                    { *m = ByQueryResponse{} }
+func (*ByQueryResponse) ProtoMessage()               {}
+func (*ByQueryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+
+type AnalyzeRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	Text               []string                                               `protobuf:"bytes,3,rep,name=text" json:"text,omitempty"`
+	Analyzer           string                                                 `protobuf:"bytes,4,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Field              string                                                 `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	// the names or the json definitions of the ad-hoc tokenizer and filters
+	Tokenizer  []byte   `protobuf:"bytes,6,opt,name=tokenizer,proto3" json:"tokenizer,omitempty"`
+	Filter     [][]byte `protobuf:"bytes,7,rep,name=filter" json:"filter,omitempty"`
+	CharFilter [][]byte `protobuf:"bytes,8,rep,name=char_filter,json=charFilter" json:"char_filter,omitempty"`
+}
+
+func (m *AnalyzeRequest) Reset()                    { *m = AnalyzeRequest{} }
+func (*AnalyzeRequest) ProtoMessage()               {}
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+
+type AnalyzeToken struct {
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StartOffset int32  `protobuf:"varint,2,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset   int32  `protobuf:"varint,3,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
+	Type        string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Position    int32  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (m *AnalyzeToken) Reset()                    { *m = AnalyzeToken{} }
+func (*AnalyzeToken) ProtoMessage()               {}
+func (*AnalyzeToken) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+
+type AnalyzeResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Tokens              []AnalyzeToken `protobuf:"bytes,2,rep,name=tokens" json:"tokens"`
+}
+
+func (m *AnalyzeResponse) Reset()                    { *m = AnalyzeResponse{} }
+func (*AnalyzeResponse) ProtoMessage()               {}
+func (*AnalyzeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
+
+type SearchRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	// the json body of the search request
+	Request []byte `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// the keep alive of the scroll opened by the search, such as "1m0s", no scroll if empty
+	Scroll string `protobuf:"bytes,4,opt,name=scroll,proto3" json:"scroll,omitempty"`
+}
+
+func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
+func (*SearchRequest) ProtoMessage()               {}
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
+
+// ScrollRequest reads the next page of a scroll opened by a search on the same server
+type ScrollRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ScrollID           string `protobuf:"bytes,2,opt,name=scroll_id,json=scrollId,proto3" json:"scroll_id,omitempty"`
+	// the keep alive of the scroll from now on, the default keep alive if empty
+	Scroll string `protobuf:"bytes,3,opt,name=scroll,proto3" json:"scroll,omitempty"`
+}
+
+func (m *ScrollRequest) Reset()                    { *m = ScrollRequest{} }
+func (*ScrollRequest) ProtoMessage()               {}
+func (*ScrollRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
+
+type ClearScrollRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ScrollIDs          []string `protobuf:"bytes,2,rep,name=scroll_ids,json=scrollIds" json:"scroll_ids,omitempty"`
+}
+
+func (m *ClearScrollRequest) Reset()                    { *m = ClearScrollRequest{} }
+func (*ClearScrollRequest) ProtoMessage()               {}
+func (*ClearScrollRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
+
+type ClearScrollResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the number of the scrolls found and released
+	NumFreed uint32 `protobuf:"varint,2,opt,name=num_freed,json=numFreed,proto3" json:"num_freed,omitempty"`
+}
+
+func (m *ClearScrollResponse) Reset()                    { *m = ClearScrollResponse{} }
+func (*ClearScrollResponse) ProtoMessage()               {}
+func (*ClearScrollResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
+
+type SearchResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the json of the search result of the partition
+	Result []byte `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
+func (*SearchResponse) ProtoMessage()               {}
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
+
+type GetRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	ID                 string                                                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// the json of the _source of the request, such as false or {"includes": []}, the whole _source if empty
+	Source       []byte   `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	StoredFields []string `protobuf:"bytes,5,rep,name=stored_fields,json=storedFields" json:"stored_fields,omitempty"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
+
+type GetResult struct {
+	ID     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Found  bool   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Source []byte `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// the json of the stored fields
+	Fields      []byte `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	Version     uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	SeqNo       uint64 `protobuf:"varint,6,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+	PrimaryTerm uint64 `protobuf:"varint,7,opt,name=primary_term,json=primaryTerm,proto3" json:"primary_term,omitempty"`
+}
+
+func (m *GetResult) Reset()                    { *m = GetResult{} }
+func (*GetResult) ProtoMessage()               {}
+func (*GetResult) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+
+type GetResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Doc                 GetResult `protobuf:"bytes,2,opt,name=doc" json:"doc"`
+}
+
+func (m *GetResponse) Reset()                    { *m = GetResponse{} }
+func (*GetResponse) ProtoMessage()               {}
+func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
+
+type MultiGetRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	IDs                []string                                               `protobuf:"bytes,3,rep,name=ids" json:"ids,omitempty"`
+	Source             []byte                                                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	StoredFields       []string                                               `protobuf:"bytes,5,rep,name=stored_fields,json=storedFields" json:"stored_fields,omitempty"`
+}
+
+func (m *MultiGetRequest) Reset()                    { *m = MultiGetRequest{} }
+func (*MultiGetRequest) ProtoMessage()               {}
+func (*MultiGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
+
+type MultiGetResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the documents in the order of the ids
+	Docs []GetResult `protobuf:"bytes,2,rep,name=docs" json:"docs"`
+}
+
+func (m *MultiGetResponse) Reset()                    { *m = MultiGetResponse{} }
+func (*MultiGetResponse) ProtoMessage()               {}
+func (*MultiGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
+
+type BulkRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	Requests           []RequestUnion                                         `protobuf:"bytes,3,rep,name=requests" json:"requests"`
+}
+
+func (m *BulkRequest) Reset()                    { *m = BulkRequest{} }
+func (*BulkRequest) ProtoMessage()               {}
+func (*BulkRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
+
+type BulkResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the responses in the order of the requests
+	Responses []ResponseUnion `protobuf:"bytes,2,rep,name=responses" json:"responses"`
+}
+
+func (m *BulkResponse) Reset()                    { *m = BulkResponse{} }
+func (*BulkResponse) ProtoMessage()               {}
+func (*BulkResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
+
+type CountRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	PartitionID        github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"partition_id,omitempty"`
+	// the json of the query, all the documents are counted if empty
+	Query []byte `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *CountRequest) Reset()                    { *m = CountRequest{} }
+func (*CountRequest) ProtoMessage()               {}
+func (*CountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
+
+type CountResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Count               uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *CountResponse) Reset()                    { *m = CountResponse{} }
+func (*CountResponse) ProtoMessage()               {}
+func (*CountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
+
 func init() {
 	proto.RegisterType((*RequestUnion)(nil), "RequestUnion")
 	proto.RegisterType((*ResponseUnion)(nil), "ResponseUnion")
@@ -198,6 +484,26 @@ func init() {
 	proto.RegisterType((*DeleteRequest)(nil), "DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "DeleteResponse")
 	proto.RegisterType((*Failure)(nil), "Failure")
+	proto.RegisterType((*DeleteByQueryRequest)(nil), "DeleteByQueryRequest")
+	proto.RegisterType((*UpdateByQueryRequest)(nil), "UpdateByQueryRequest")
+	proto.RegisterType((*ByQueryResponse)(nil), "ByQueryResponse")
+	proto.RegisterType((*AnalyzeRequest)(nil), "AnalyzeRequest")
+	proto.RegisterType((*AnalyzeToken)(nil), "AnalyzeToken")
+	proto.RegisterType((*AnalyzeResponse)(nil), "AnalyzeResponse")
+	proto.RegisterType((*SearchRequest)(nil), "SearchRequest")
+	proto.RegisterType((*ScrollRequest)(nil), "ScrollRequest")
+	proto.RegisterType((*ClearScrollRequest)(nil), "ClearScrollRequest")
+	proto.RegisterType((*ClearScrollResponse)(nil), "ClearScrollResponse")
+	proto.RegisterType((*SearchResponse)(nil), "SearchResponse")
+	proto.RegisterType((*GetRequest)(nil), "GetRequest")
+	proto.RegisterType((*GetResult)(nil), "GetResult")
+	proto.RegisterType((*GetResponse)(nil), "GetResponse")
+	proto.RegisterType((*MultiGetRequest)(nil), "MultiGetRequest")
+	proto.RegisterType((*MultiGetResponse)(nil), "MultiGetResponse")
+	proto.RegisterType((*BulkRequest)(nil), "BulkRequest")
+	proto.RegisterType((*BulkResponse)(nil), "BulkResponse")
+	proto.RegisterType((*CountRequest)(nil), "CountRequest")
+	proto.RegisterType((*CountResponse)(nil), "CountResponse")
 	proto.RegisterEnum("OpType", OpType_name, OpType_value)
 	proto.RegisterEnum("WriteResult", WriteResult_name, WriteResult_value)
 }
@@ -322,6 +628,15 @@ func (this *CreateResponse) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.SeqNo != that1.SeqNo {
+		return false
+	}
+	if this.PrimaryTerm != that1.PrimaryTerm {
+		return false
+	}
 	return true
 }
 func (this *UpdateRequest) Equal(that interface{}) bool {
@@ -352,6 +667,18 @@ func (this *UpdateRequest) Equal(that interface{}) bool {
 	if this.Upsert != that1.Upsert {
 		return false
 	}
+	if this.Merge != that1.Merge {
+		return false
+	}
+	if this.IfVersion != that1.IfVersion {
+		return false
+	}
+	if this.IfSeqNo != that1.IfSeqNo {
+		return false
+	}
+	if this.IfPrimaryTerm != that1.IfPrimaryTerm {
+		return false
+	}
 	return true
 }
 func (this *UpdateResponse) Equal(that interface{}) bool {
@@ -379,6 +706,15 @@ func (this *UpdateResponse) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.SeqNo != that1.SeqNo {
+		return false
+	}
+	if this.PrimaryTerm != that1.PrimaryTerm {
+		return false
+	}
 	return true
 }
 func (this *DeleteRequest) Equal(that interface{}) bool {
@@ -403,6 +739,15 @@ func (this *DeleteRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.ID, that1.ID) {
 		return false
 	}
+	if this.IfVersion != that1.IfVersion {
+		return false
+	}
+	if this.IfSeqNo != that1.IfSeqNo {
+		return false
+	}
+	if this.IfPrimaryTerm != that1.IfPrimaryTerm {
+		return false
+	}
 	return true
 }
 func (this *DeleteResponse) Equal(that interface{}) bool {
@@ -430,6 +775,15 @@ func (this *DeleteResponse) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.SeqNo != that1.SeqNo {
+		return false
+	}
+	if this.PrimaryTerm != that1.PrimaryTerm {
+		return false
+	}
 	return true
 }
 func (this *Failure) Equal(that interface{}) bool {
@@ -459,816 +813,7234 @@ func (this *Failure) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *RequestUnion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *DeleteByQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *RequestUnion) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
-	}
-	if m.Create != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
-		n1, err := m.Create.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*DeleteByQueryRequest)
+	if !ok {
+		that2, ok := that.(DeleteByQueryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n1
 	}
-	if m.Update != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
-		n2, err := m.Update.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
-		n3, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	return i, nil
-}
-
-func (m *ResponseUnion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.PartitionID != that1.PartitionID {
+		return false
 	}
-	return dAtA[:n], nil
+	if !bytes.Equal(this.Query, that1.Query) {
+		return false
+	}
+	if this.BatchSize != that1.BatchSize {
+		return false
+	}
+	if this.MaxDocs != that1.MaxDocs {
+		return false
+	}
+	if this.ProceedOnConflicts != that1.ProceedOnConflicts {
+		return false
+	}
+	return true
 }
-
-func (m *ResponseUnion) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
+func (this *UpdateByQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Create != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
-		n4, err := m.Create.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*UpdateByQueryRequest)
+	if !ok {
+		that2, ok := that.(UpdateByQueryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n4
 	}
-	if m.Update != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
-		n5, err := m.Update.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
-		n6, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	if m.Failure != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Failure.Size()))
-		n7, err := m.Failure.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+	if this.PartitionID != that1.PartitionID {
+		return false
 	}
-	return i, nil
-}
-
-func (m *CreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Query, that1.Query) {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.BatchSize != that1.BatchSize {
+		return false
+	}
+	if this.MaxDocs != that1.MaxDocs {
+		return false
+	}
+	if this.ProceedOnConflicts != that1.ProceedOnConflicts {
+		return false
+	}
+	if !bytes.Equal(this.Doc, that1.Doc) {
+		return false
+	}
+	return true
 }
+func (this *ByQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	that1, ok := that.(*ByQueryResponse)
+	if !ok {
+		that2, ok := that.(ByQueryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return i, nil
-}
-
-func (m *CreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if this.Took != that1.Took {
+		return false
 	}
-	return i, nil
-}
-
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.TimedOut != that1.TimedOut {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if this.Total != that1.Total {
+		return false
 	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	if this.Deleted != that1.Deleted {
+		return false
 	}
-	if m.Upsert {
-		dAtA[i] = 0x18
-		i++
-		if m.Upsert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.Updated != that1.Updated {
+		return false
 	}
-	return i, nil
-}
-
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Batches != that1.Batches {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if this.VersionConflicts != that1.VersionConflicts {
+		return false
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if this.Noops != that1.Noops {
+		return false
 	}
-	return i, nil
-}
-
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(this.Failures) != len(that1.Failures) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	for i := range this.Failures {
+		if !this.Failures[i].Equal(&that1.Failures[i]) {
+			return false
+		}
 	}
-	return i, nil
+	return true
 }
-
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *AnalyzeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	that1, ok := that.(*AnalyzeRequest)
+	if !ok {
+		that2, ok := that.(AnalyzeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Failure) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Failure) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if this.PartitionID != that1.PartitionID {
+		return false
 	}
-	if len(m.Cause) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Cause)))
-		i += copy(dAtA[i:], m.Cause)
+	if len(this.Text) != len(that1.Text) {
+		return false
 	}
-	return i, nil
-}
-
-func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	for i := range this.Text {
+		if this.Text[i] != that1.Text[i] {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func NewPopulatedRequestUnion(r randyApi, easy bool) *RequestUnion {
-	this := &RequestUnion{}
-	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
-	if r.Intn(10) != 0 {
-		this.Create = NewPopulatedCreateRequest(r, easy)
+	if this.Analyzer != that1.Analyzer {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Update = NewPopulatedUpdateRequest(r, easy)
+	if this.Field != that1.Field {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Delete = NewPopulatedDeleteRequest(r, easy)
+	if !bytes.Equal(this.Tokenizer, that1.Tokenizer) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(this.Filter) != len(that1.Filter) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedResponseUnion(r randyApi, easy bool) *ResponseUnion {
-	this := &ResponseUnion{}
-	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
-	if r.Intn(10) != 0 {
-		this.Create = NewPopulatedCreateResponse(r, easy)
+	for i := range this.Filter {
+		if !bytes.Equal(this.Filter[i], that1.Filter[i]) {
+			return false
+		}
 	}
-	if r.Intn(10) != 0 {
-		this.Update = NewPopulatedUpdateResponse(r, easy)
+	if len(this.CharFilter) != len(that1.CharFilter) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Delete = NewPopulatedDeleteResponse(r, easy)
+	for i := range this.CharFilter {
+		if !bytes.Equal(this.CharFilter[i], that1.CharFilter[i]) {
+			return false
+		}
 	}
-	if r.Intn(10) != 0 {
-		this.Failure = NewPopulatedFailure(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedCreateRequest(r randyApi, easy bool) *CreateRequest {
-	this := &CreateRequest{}
-	v1 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v1)
-	for i := 0; i < v1; i++ {
-		this.ID[i] = byte(r.Intn(256))
-	}
-	v2 := r.Intn(100)
-	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v2)
-	for i := 0; i < v2; i++ {
-		this.Data[i] = byte(r.Intn(256))
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+	return true
 }
-
-func NewPopulatedCreateResponse(r randyApi, easy bool) *CreateResponse {
-	this := &CreateResponse{}
-	v3 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v3)
-	for i := 0; i < v3; i++ {
-		this.ID[i] = byte(r.Intn(256))
-	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+func (this *AnalyzeToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return this
-}
 
-func NewPopulatedUpdateRequest(r randyApi, easy bool) *UpdateRequest {
-	this := &UpdateRequest{}
-	v4 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v4)
-	for i := 0; i < v4; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	that1, ok := that.(*AnalyzeToken)
+	if !ok {
+		that2, ok := that.(AnalyzeToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	v5 := r.Intn(100)
-	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v5)
-	for i := 0; i < v5; i++ {
-		this.Data[i] = byte(r.Intn(256))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	this.Upsert = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
+	if this.Token != that1.Token {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedUpdateResponse(r randyApi, easy bool) *UpdateResponse {
-	this := &UpdateResponse{}
-	v6 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v6)
-	for i := 0; i < v6; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	if this.StartOffset != that1.StartOffset {
+		return false
 	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+	if this.EndOffset != that1.EndOffset {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedDeleteRequest(r randyApi, easy bool) *DeleteRequest {
-	this := &DeleteRequest{}
-	v7 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v7)
-	for i := 0; i < v7; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	if this.Type != that1.Type {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.Position != that1.Position {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedDeleteResponse(r randyApi, easy bool) *DeleteResponse {
-	this := &DeleteResponse{}
-	v8 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v8)
-	for i := 0; i < v8; i++ {
-		this.ID[i] = byte(r.Intn(256))
-	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+func (this *AnalyzeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return this
-}
 
-func NewPopulatedFailure(r randyApi, easy bool) *Failure {
-	this := &Failure{}
-	v9 := r.Intn(100)
-	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v9)
-	for i := 0; i < v9; i++ {
-		this.ID[i] = byte(r.Intn(256))
+	that1, ok := that.(*AnalyzeResponse)
+	if !ok {
+		that2, ok := that.(AnalyzeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	this.Cause = string(randStringApi(r))
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-type randyApi interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneApi(r randyApi) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	return rune(ru + 61)
-}
-func randStringApi(r randyApi) string {
-	v10 := r.Intn(100)
-	tmps := make([]rune, v10)
-	for i := 0; i < v10; i++ {
-		tmps[i] = randUTF8RuneApi(r)
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
 	}
-	return string(tmps)
-}
-func randUnrecognizedApi(r randyApi, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(&that1.Tokens[i]) {
+			return false
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApi(dAtA, r, fieldNumber, wire)
 	}
-	return dAtA
+	return true
 }
-func randFieldApi(dAtA []byte, r randyApi, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		v11 := r.Int63()
-		if r.Intn(2) == 0 {
-			v11 *= -1
-		}
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(v11))
-	case 1:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+func (this *SearchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchRequest)
+	if !ok {
+		that2, ok := that.(SearchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-	default:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
 	}
-	return dAtA
-}
-func encodeVarintPopulateApi(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *RequestUnion) Size() (n int) {
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		n += 1 + sovApi(uint64(m.OpType))
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	if m.Create != nil {
-		l = m.Create.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if this.PartitionID != that1.PartitionID {
+		return false
 	}
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if !bytes.Equal(this.Request, that1.Request) {
+		return false
 	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if this.Scroll != that1.Scroll {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ScrollRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ResponseUnion) Size() (n int) {
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		n += 1 + sovApi(uint64(m.OpType))
+	that1, ok := that.(*ScrollRequest)
+	if !ok {
+		that2, ok := that.(ScrollRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Create != nil {
-		l = m.Create.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if this.ScrollID != that1.ScrollID {
+		return false
 	}
-	if m.Failure != nil {
-		l = m.Failure.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if this.Scroll != that1.Scroll {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ClearScrollRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*ClearScrollRequest)
+	if !ok {
+		that2, ok := that.(ClearScrollRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *CreateResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if len(this.ScrollIDs) != len(that1.ScrollIDs) {
+		return false
 	}
-	return n
+	for i := range this.ScrollIDs {
+		if this.ScrollIDs[i] != that1.ScrollIDs[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *ClearScrollResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*ClearScrollResponse)
+	if !ok {
+		that2, ok := that.(ClearScrollResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Upsert {
-		n += 2
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	return n
+	if this.NumFreed != that1.NumFreed {
+		return false
+	}
+	return true
 }
+func (this *SearchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*SearchResponse)
+	if !ok {
+		that2, ok := that.(SearchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *DeleteRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.Result, that1.Result) {
+		return false
+	}
+	return true
 }
+func (this *GetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeleteResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*GetRequest)
+	if !ok {
+		that2, ok := that.(GetRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *Failure) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
 	}
-	l = len(m.Cause)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.PartitionID != that1.PartitionID {
+		return false
 	}
-	return n
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.Source, that1.Source) {
+		return false
+	}
+	if len(this.StoredFields) != len(that1.StoredFields) {
+		return false
+	}
+	for i := range this.StoredFields {
+		if this.StoredFields[i] != that1.StoredFields[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *GetResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovApi(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	that1, ok := that.(*GetResult)
+	if !ok {
+		that2, ok := that.(GetResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-func sozApi(x uint64) (n int) {
-	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *RequestUnion) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&RequestUnion{`,
-		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
-		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateRequest", "CreateRequest", 1) + `,`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
-		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteRequest", "DeleteRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResponseUnion) String() string {
-	if this == nil {
-		return "nil"
+	if this.ID != that1.ID {
+		return false
 	}
-	s := strings.Join([]string{`&ResponseUnion{`,
-		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
-		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateResponse", "CreateResponse", 1) + `,`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateResponse", "UpdateResponse", 1) + `,`,
-		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteResponse", "DeleteResponse", 1) + `,`,
-		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "Failure", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.Found != that1.Found {
+		return false
 	}
-	s := strings.Join([]string{`&CreateRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Source, that1.Source) {
+		return false
 	}
-	s := strings.Join([]string{`&CreateResponse{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Fields, that1.Fields) {
+		return false
 	}
-	s := strings.Join([]string{`&UpdateRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`Upsert:` + fmt.Sprintf("%v", this.Upsert) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.Version != that1.Version {
+		return false
 	}
-	s := strings.Join([]string{`&UpdateResponse{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.SeqNo != that1.SeqNo {
+		return false
 	}
-	s := strings.Join([]string{`&DeleteRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.PrimaryTerm != that1.PrimaryTerm {
+		return false
+	}
+	return true
 }
-func (this *DeleteResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *GetResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetResponse)
+	if !ok {
+		that2, ok := that.(GetResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if !this.Doc.Equal(&that1.Doc) {
+		return false
+	}
+	return true
+}
+func (this *MultiGetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultiGetRequest)
+	if !ok {
+		that2, ok := that.(MultiGetRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if len(this.IDs) != len(that1.IDs) {
+		return false
+	}
+	for i := range this.IDs {
+		if this.IDs[i] != that1.IDs[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Source, that1.Source) {
+		return false
+	}
+	if len(this.StoredFields) != len(that1.StoredFields) {
+		return false
+	}
+	for i := range this.StoredFields {
+		if this.StoredFields[i] != that1.StoredFields[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *MultiGetResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultiGetResponse)
+	if !ok {
+		that2, ok := that.(MultiGetResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if len(this.Docs) != len(that1.Docs) {
+		return false
+	}
+	for i := range this.Docs {
+		if !this.Docs[i].Equal(&that1.Docs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BulkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BulkRequest)
+	if !ok {
+		that2, ok := that.(BulkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if len(this.Requests) != len(that1.Requests) {
+		return false
+	}
+	for i := range this.Requests {
+		if !this.Requests[i].Equal(&that1.Requests[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BulkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BulkResponse)
+	if !ok {
+		that2, ok := that.(BulkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if len(this.Responses) != len(that1.Responses) {
+		return false
+	}
+	for i := range this.Responses {
+		if !this.Responses[i].Equal(&that1.Responses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CountRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CountRequest)
+	if !ok {
+		that2, ok := that.(CountRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.PartitionID != that1.PartitionID {
+		return false
+	}
+	if !bytes.Equal(this.Query, that1.Query) {
+		return false
+	}
+	return true
+}
+func (this *CountResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CountResponse)
+	if !ok {
+		that2, ok := that.(CountResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiGrpc service
+
+type ApiGrpcClient interface {
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	MultiGet(ctx context.Context, in *MultiGetRequest, opts ...grpc.CallOption) (*MultiGetResponse, error)
+	Bulk(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	Scroll(ctx context.Context, in *ScrollRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	ClearScroll(ctx context.Context, in *ClearScrollRequest, opts ...grpc.CallOption) (*ClearScrollResponse, error)
+	DeleteByQuery(ctx context.Context, in *DeleteByQueryRequest, opts ...grpc.CallOption) (*ByQueryResponse, error)
+	UpdateByQuery(ctx context.Context, in *UpdateByQueryRequest, opts ...grpc.CallOption) (*ByQueryResponse, error)
+}
+
+type apiGrpcClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiGrpcClient(cc *grpc.ClientConn) ApiGrpcClient {
+	return &apiGrpcClient{cc}
+}
+
+func (c *apiGrpcClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) MultiGet(ctx context.Context, in *MultiGetRequest, opts ...grpc.CallOption) (*MultiGetResponse, error) {
+	out := new(MultiGetResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/MultiGet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Bulk(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Bulk", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Count", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Analyze", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Scroll(ctx context.Context, in *ScrollRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Scroll", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) ClearScroll(ctx context.Context, in *ClearScrollRequest, opts ...grpc.CallOption) (*ClearScrollResponse, error) {
+	out := new(ClearScrollResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/ClearScroll", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) DeleteByQuery(ctx context.Context, in *DeleteByQueryRequest, opts ...grpc.CallOption) (*ByQueryResponse, error) {
+	out := new(ByQueryResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/DeleteByQuery", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) UpdateByQuery(ctx context.Context, in *UpdateByQueryRequest, opts ...grpc.CallOption) (*ByQueryResponse, error) {
+	out := new(ByQueryResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/UpdateByQuery", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ApiGrpc service
+
+type ApiGrpcServer interface {
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	MultiGet(context.Context, *MultiGetRequest) (*MultiGetResponse, error)
+	Bulk(context.Context, *BulkRequest) (*BulkResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Count(context.Context, *CountRequest) (*CountResponse, error)
+	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	Scroll(context.Context, *ScrollRequest) (*SearchResponse, error)
+	ClearScroll(context.Context, *ClearScrollRequest) (*ClearScrollResponse, error)
+	DeleteByQuery(context.Context, *DeleteByQueryRequest) (*ByQueryResponse, error)
+	UpdateByQuery(context.Context, *UpdateByQueryRequest) (*ByQueryResponse, error)
+}
+
+func RegisterApiGrpcServer(s *grpc.Server, srv ApiGrpcServer) {
+	s.RegisterService(&_ApiGrpc_serviceDesc, srv)
+}
+
+func _ApiGrpc_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_MultiGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).MultiGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/MultiGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).MultiGet(ctx, req.(*MultiGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Bulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Bulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Bulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Bulk(ctx, req.(*BulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Analyze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Scroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Scroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Scroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Scroll(ctx, req.(*ScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_ClearScroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearScrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).ClearScroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/ClearScroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).ClearScroll(ctx, req.(*ClearScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_DeleteByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).DeleteByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/DeleteByQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).DeleteByQuery(ctx, req.(*DeleteByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_UpdateByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).UpdateByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/UpdateByQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).UpdateByQuery(ctx, req.(*UpdateByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApiGrpc_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ApiGrpc",
+	HandlerType: (*ApiGrpcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _ApiGrpc_Get_Handler,
+		},
+		{
+			MethodName: "MultiGet",
+			Handler:    _ApiGrpc_MultiGet_Handler,
+		},
+		{
+			MethodName: "Bulk",
+			Handler:    _ApiGrpc_Bulk_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _ApiGrpc_Search_Handler,
+		},
+		{
+			MethodName: "Count",
+			Handler:    _ApiGrpc_Count_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _ApiGrpc_Analyze_Handler,
+		},
+		{
+			MethodName: "Scroll",
+			Handler:    _ApiGrpc_Scroll_Handler,
+		},
+		{
+			MethodName: "ClearScroll",
+			Handler:    _ApiGrpc_ClearScroll_Handler,
+		},
+		{
+			MethodName: "DeleteByQuery",
+			Handler:    _ApiGrpc_DeleteByQuery_Handler,
+		},
+		{
+			MethodName: "UpdateByQuery",
+			Handler:    _ApiGrpc_UpdateByQuery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
+
+func (m *RequestUnion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestUnion) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
+	}
+	if m.Create != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
+		n1, err := m.Create.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Update != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
+		n2, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Delete != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
+		n3, err := m.Delete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *ResponseUnion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseUnion) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
+	}
+	if m.Create != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
+		n4, err := m.Create.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Update != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
+		n5, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Delete != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
+		n6, err := m.Delete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Failure != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Failure.Size()))
+		n7, err := m.Failure.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *CreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *CreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SeqNo))
+	}
+	if m.PrimaryTerm != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PrimaryTerm))
+	}
+	return i, nil
+}
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Upsert {
+		dAtA[i] = 0x18
+		i++
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Merge {
+		dAtA[i] = 0x20
+		i++
+		if m.Merge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.IfVersion != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfSeqNo))
+	}
+	if m.IfPrimaryTerm != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfPrimaryTerm))
+	}
+	return i, nil
+}
+
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SeqNo))
+	}
+	if m.PrimaryTerm != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PrimaryTerm))
+	}
+	return i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.IfVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfSeqNo))
+	}
+	if m.IfPrimaryTerm != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfPrimaryTerm))
+	}
+	return i, nil
+}
+
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SeqNo))
+	}
+	if m.PrimaryTerm != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PrimaryTerm))
+	}
+	return i, nil
+}
+
+func (m *Failure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Failure) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Cause) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Cause)))
+		i += copy(dAtA[i:], m.Cause)
+	}
+	return i, nil
+}
+
+func (m *DeleteByQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteByQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n8, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.Query) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
+	}
+	if m.BatchSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BatchSize))
+	}
+	if m.MaxDocs != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxDocs))
+	}
+	if m.ProceedOnConflicts {
+		dAtA[i] = 0x30
+		i++
+		if m.ProceedOnConflicts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *UpdateByQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateByQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n9, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n9
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.Query) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
+	}
+	if m.BatchSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BatchSize))
+	}
+	if m.MaxDocs != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxDocs))
+	}
+	if m.ProceedOnConflicts {
+		dAtA[i] = 0x30
+		i++
+		if m.ProceedOnConflicts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Doc) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Doc)))
+		i += copy(dAtA[i:], m.Doc)
+	}
+	return i, nil
+}
+
+func (m *ByQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n10, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n10
+	if m.Took != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Took))
+	}
+	if m.TimedOut {
+		dAtA[i] = 0x18
+		i++
+		if m.TimedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Total))
+	}
+	if m.Deleted != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Deleted))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Updated))
+	}
+	if m.Batches != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Batches))
+	}
+	if m.VersionConflicts != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.VersionConflicts))
+	}
+	if m.Noops != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Noops))
+	}
+	if len(m.Failures) > 0 {
+		for _, msg := range m.Failures {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AnalyzeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n11, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n11
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.Text) > 0 {
+		for _, s := range m.Text {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Analyzer) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Analyzer)))
+		i += copy(dAtA[i:], m.Analyzer)
+	}
+	if len(m.Field) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if len(m.Tokenizer) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Tokenizer)))
+		i += copy(dAtA[i:], m.Tokenizer)
+	}
+	if len(m.Filter) > 0 {
+		for _, b := range m.Filter {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.CharFilter) > 0 {
+		for _, b := range m.CharFilter {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *AnalyzeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzeToken) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Token)))
+		i += copy(dAtA[i:], m.Token)
+	}
+	if m.StartOffset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.StartOffset))
+	}
+	if m.EndOffset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.EndOffset))
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if m.Position != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Position))
+	}
+	return i, nil
+}
+
+func (m *AnalyzeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n12, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n12
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n13, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n13
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.Request) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Request)))
+		i += copy(dAtA[i:], m.Request)
+	}
+	if len(m.Scroll) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Scroll)))
+		i += copy(dAtA[i:], m.Scroll)
+	}
+	return i, nil
+}
+
+func (m *ScrollRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScrollRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n14, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n14
+	if len(m.ScrollID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ScrollID)))
+		i += copy(dAtA[i:], m.ScrollID)
+	}
+	if len(m.Scroll) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Scroll)))
+		i += copy(dAtA[i:], m.Scroll)
+	}
+	return i, nil
+}
+
+func (m *ClearScrollRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearScrollRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n15, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	if len(m.ScrollIDs) > 0 {
+		for _, s := range m.ScrollIDs {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ClearScrollResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearScrollResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n16, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	if m.NumFreed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.NumFreed))
+	}
+	return i, nil
+}
+
+func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n17, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	if len(m.Result) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n18, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.StoredFields) > 0 {
+		for _, s := range m.StoredFields {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *GetResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Found {
+		dAtA[i] = 0x10
+		i++
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SeqNo))
+	}
+	if m.PrimaryTerm != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PrimaryTerm))
+	}
+	return i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n19, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n19
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Doc.Size()))
+	n20, err := m.Doc.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	return i, nil
+}
+
+func (m *MultiGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n21, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.IDs) > 0 {
+		for _, s := range m.IDs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.StoredFields) > 0 {
+		for _, s := range m.StoredFields {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *MultiGetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiGetResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n22, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
+	if len(m.Docs) > 0 {
+		for _, msg := range m.Docs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n23, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n23
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BulkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n24, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n24
+	if len(m.Responses) > 0 {
+		for _, msg := range m.Responses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n25, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n25
+	if m.PartitionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PartitionID))
+	}
+	if len(m.Query) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
+	}
+	return i, nil
+}
+
+func (m *CountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n26, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedRequestUnion(r randyApi, easy bool) *RequestUnion {
+	this := &RequestUnion{}
+	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
+	if r.Intn(10) != 0 {
+		this.Create = NewPopulatedCreateRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Update = NewPopulatedUpdateRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Delete = NewPopulatedDeleteRequest(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedResponseUnion(r randyApi, easy bool) *ResponseUnion {
+	this := &ResponseUnion{}
+	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
+	if r.Intn(10) != 0 {
+		this.Create = NewPopulatedCreateResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Update = NewPopulatedUpdateResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Delete = NewPopulatedDeleteResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Failure = NewPopulatedFailure(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateRequest(r randyApi, easy bool) *CreateRequest {
+	this := &CreateRequest{}
+	v1 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v1)
+	for i := 0; i < v1; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	v2 := r.Intn(100)
+	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v2)
+	for i := 0; i < v2; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateResponse(r randyApi, easy bool) *CreateResponse {
+	this := &CreateResponse{}
+	v3 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v3)
+	for i := 0; i < v3; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	this.Version = uint64(uint64(r.Uint32()))
+	this.SeqNo = uint64(uint64(r.Uint32()))
+	this.PrimaryTerm = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateRequest(r randyApi, easy bool) *UpdateRequest {
+	this := &UpdateRequest{}
+	v4 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v4)
+	for i := 0; i < v4; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	v5 := r.Intn(100)
+	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v5)
+	for i := 0; i < v5; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	this.Upsert = bool(bool(r.Intn(2) == 0))
+	this.Merge = bool(bool(r.Intn(2) == 0))
+	this.IfVersion = uint64(uint64(r.Uint32()))
+	this.IfSeqNo = uint64(uint64(r.Uint32()))
+	this.IfPrimaryTerm = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateResponse(r randyApi, easy bool) *UpdateResponse {
+	this := &UpdateResponse{}
+	v6 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v6)
+	for i := 0; i < v6; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	this.Version = uint64(uint64(r.Uint32()))
+	this.SeqNo = uint64(uint64(r.Uint32()))
+	this.PrimaryTerm = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteRequest(r randyApi, easy bool) *DeleteRequest {
+	this := &DeleteRequest{}
+	v7 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v7)
+	for i := 0; i < v7; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.IfVersion = uint64(uint64(r.Uint32()))
+	this.IfSeqNo = uint64(uint64(r.Uint32()))
+	this.IfPrimaryTerm = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteResponse(r randyApi, easy bool) *DeleteResponse {
+	this := &DeleteResponse{}
+	v8 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v8)
+	for i := 0; i < v8; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	this.Version = uint64(uint64(r.Uint32()))
+	this.SeqNo = uint64(uint64(r.Uint32()))
+	this.PrimaryTerm = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFailure(r randyApi, easy bool) *Failure {
+	this := &Failure{}
+	v9 := r.Intn(100)
+	this.ID = make(github_com_tiglabs_baudengine_proto_metapb.Key, v9)
+	for i := 0; i < v9; i++ {
+		this.ID[i] = byte(r.Intn(256))
+	}
+	this.Cause = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteByQueryRequest(r randyApi, easy bool) *DeleteByQueryRequest {
+	this := &DeleteByQueryRequest{}
+	v10 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v10
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v11 := r.Intn(100)
+	this.Query = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.Query[i] = byte(r.Intn(256))
+	}
+	this.BatchSize = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.BatchSize *= -1
+	}
+	this.MaxDocs = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.MaxDocs *= -1
+	}
+	this.ProceedOnConflicts = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateByQueryRequest(r randyApi, easy bool) *UpdateByQueryRequest {
+	this := &UpdateByQueryRequest{}
+	v12 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v12
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v13 := r.Intn(100)
+	this.Query = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.Query[i] = byte(r.Intn(256))
+	}
+	this.BatchSize = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.BatchSize *= -1
+	}
+	this.MaxDocs = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.MaxDocs *= -1
+	}
+	this.ProceedOnConflicts = bool(bool(r.Intn(2) == 0))
+	v14 := r.Intn(100)
+	this.Doc = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.Doc[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedByQueryResponse(r randyApi, easy bool) *ByQueryResponse {
+	this := &ByQueryResponse{}
+	v15 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v15
+	this.Took = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Took *= -1
+	}
+	this.TimedOut = bool(bool(r.Intn(2) == 0))
+	this.Total = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Total *= -1
+	}
+	this.Deleted = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Deleted *= -1
+	}
+	this.Updated = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Updated *= -1
+	}
+	this.Batches = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Batches *= -1
+	}
+	this.VersionConflicts = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.VersionConflicts *= -1
+	}
+	this.Noops = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Noops *= -1
+	}
+	if r.Intn(10) != 0 {
+		v16 := r.Intn(5)
+		this.Failures = make([]Failure, v16)
+		for i := 0; i < v16; i++ {
+			v17 := NewPopulatedFailure(r, easy)
+			this.Failures[i] = *v17
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAnalyzeRequest(r randyApi, easy bool) *AnalyzeRequest {
+	this := &AnalyzeRequest{}
+	v18 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v18
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v19 := r.Intn(10)
+	this.Text = make([]string, v19)
+	for i := 0; i < v19; i++ {
+		this.Text[i] = string(randStringApi(r))
+	}
+	this.Analyzer = string(randStringApi(r))
+	this.Field = string(randStringApi(r))
+	v20 := r.Intn(100)
+	this.Tokenizer = make([]byte, v20)
+	for i := 0; i < v20; i++ {
+		this.Tokenizer[i] = byte(r.Intn(256))
+	}
+	v21 := r.Intn(10)
+	this.Filter = make([][]byte, v21)
+	for i := 0; i < v21; i++ {
+		v22 := r.Intn(100)
+		this.Filter[i] = make([]byte, v22)
+		for j := 0; j < v22; j++ {
+			this.Filter[i][j] = byte(r.Intn(256))
+		}
+	}
+	v23 := r.Intn(10)
+	this.CharFilter = make([][]byte, v23)
+	for i := 0; i < v23; i++ {
+		v24 := r.Intn(100)
+		this.CharFilter[i] = make([]byte, v24)
+		for j := 0; j < v24; j++ {
+			this.CharFilter[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAnalyzeToken(r randyApi, easy bool) *AnalyzeToken {
+	this := &AnalyzeToken{}
+	this.Token = string(randStringApi(r))
+	this.StartOffset = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.StartOffset *= -1
+	}
+	this.EndOffset = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.EndOffset *= -1
+	}
+	this.Type = string(randStringApi(r))
+	this.Position = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Position *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAnalyzeResponse(r randyApi, easy bool) *AnalyzeResponse {
+	this := &AnalyzeResponse{}
+	v25 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v25
+	if r.Intn(10) != 0 {
+		v26 := r.Intn(5)
+		this.Tokens = make([]AnalyzeToken, v26)
+		for i := 0; i < v26; i++ {
+			v27 := NewPopulatedAnalyzeToken(r, easy)
+			this.Tokens[i] = *v27
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchRequest(r randyApi, easy bool) *SearchRequest {
+	this := &SearchRequest{}
+	v28 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v28
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v29 := r.Intn(100)
+	this.Request = make([]byte, v29)
+	for i := 0; i < v29; i++ {
+		this.Request[i] = byte(r.Intn(256))
+	}
+	this.Scroll = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScrollRequest(r randyApi, easy bool) *ScrollRequest {
+	this := &ScrollRequest{}
+	v30 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v30
+	this.ScrollID = string(randStringApi(r))
+	this.Scroll = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedClearScrollRequest(r randyApi, easy bool) *ClearScrollRequest {
+	this := &ClearScrollRequest{}
+	v31 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v31
+	v32 := r.Intn(10)
+	this.ScrollIDs = make([]string, v32)
+	for i := 0; i < v32; i++ {
+		this.ScrollIDs[i] = string(randStringApi(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedClearScrollResponse(r randyApi, easy bool) *ClearScrollResponse {
+	this := &ClearScrollResponse{}
+	v33 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v33
+	this.NumFreed = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchResponse(r randyApi, easy bool) *SearchResponse {
+	this := &SearchResponse{}
+	v34 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v34
+	v35 := r.Intn(100)
+	this.Result = make([]byte, v35)
+	for i := 0; i < v35; i++ {
+		this.Result[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetRequest(r randyApi, easy bool) *GetRequest {
+	this := &GetRequest{}
+	v36 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v36
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	this.ID = string(randStringApi(r))
+	v37 := r.Intn(100)
+	this.Source = make([]byte, v37)
+	for i := 0; i < v37; i++ {
+		this.Source[i] = byte(r.Intn(256))
+	}
+	v38 := r.Intn(10)
+	this.StoredFields = make([]string, v38)
+	for i := 0; i < v38; i++ {
+		this.StoredFields[i] = string(randStringApi(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetResult(r randyApi, easy bool) *GetResult {
+	this := &GetResult{}
+	this.ID = string(randStringApi(r))
+	this.Found = bool(bool(r.Intn(2) == 0))
+	v39 := r.Intn(100)
+	this.Source = make([]byte, v39)
+	for i := 0; i < v39; i++ {
+		this.Source[i] = byte(r.Intn(256))
+	}
+	v40 := r.Intn(100)
+	this.Fields = make([]byte, v40)
+	for i := 0; i < v40; i++ {
+		this.Fields[i] = byte(r.Intn(256))
+	}
+	this.Version = uint64(uint64(r.Uint32()))
+	this.SeqNo = uint64(uint64(r.Uint32()))
+	this.PrimaryTerm = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetResponse(r randyApi, easy bool) *GetResponse {
+	this := &GetResponse{}
+	v41 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v41
+	v42 := NewPopulatedGetResult(r, easy)
+	this.Doc = *v42
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMultiGetRequest(r randyApi, easy bool) *MultiGetRequest {
+	this := &MultiGetRequest{}
+	v43 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v43
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v44 := r.Intn(10)
+	this.IDs = make([]string, v44)
+	for i := 0; i < v44; i++ {
+		this.IDs[i] = string(randStringApi(r))
+	}
+	v45 := r.Intn(100)
+	this.Source = make([]byte, v45)
+	for i := 0; i < v45; i++ {
+		this.Source[i] = byte(r.Intn(256))
+	}
+	v46 := r.Intn(10)
+	this.StoredFields = make([]string, v46)
+	for i := 0; i < v46; i++ {
+		this.StoredFields[i] = string(randStringApi(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMultiGetResponse(r randyApi, easy bool) *MultiGetResponse {
+	this := &MultiGetResponse{}
+	v47 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v47
+	if r.Intn(10) != 0 {
+		v48 := r.Intn(5)
+		this.Docs = make([]GetResult, v48)
+		for i := 0; i < v48; i++ {
+			v49 := NewPopulatedGetResult(r, easy)
+			this.Docs[i] = *v49
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBulkRequest(r randyApi, easy bool) *BulkRequest {
+	this := &BulkRequest{}
+	v50 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v50
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	if r.Intn(10) != 0 {
+		v51 := r.Intn(5)
+		this.Requests = make([]RequestUnion, v51)
+		for i := 0; i < v51; i++ {
+			v52 := NewPopulatedRequestUnion(r, easy)
+			this.Requests[i] = *v52
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBulkResponse(r randyApi, easy bool) *BulkResponse {
+	this := &BulkResponse{}
+	v53 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v53
+	if r.Intn(10) != 0 {
+		v54 := r.Intn(5)
+		this.Responses = make([]ResponseUnion, v54)
+		for i := 0; i < v54; i++ {
+			v55 := NewPopulatedResponseUnion(r, easy)
+			this.Responses[i] = *v55
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCountRequest(r randyApi, easy bool) *CountRequest {
+	this := &CountRequest{}
+	v56 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v56
+	this.PartitionID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v57 := r.Intn(100)
+	this.Query = make([]byte, v57)
+	for i := 0; i < v57; i++ {
+		this.Query[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCountResponse(r randyApi, easy bool) *CountResponse {
+	this := &CountResponse{}
+	v58 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v58
+	this.Count = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApi interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApi(r randyApi) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApi(r randyApi) string {
+	v59 := r.Intn(100)
+	tmps := make([]rune, v59)
+	for i := 0; i < v59; i++ {
+		tmps[i] = randUTF8RuneApi(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApi(r randyApi, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApi(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApi(dAtA []byte, r randyApi, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		v60 := r.Int63()
+		if r.Intn(2) == 0 {
+			v60 *= -1
+		}
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(v60))
+	case 1:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApi(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *RequestUnion) Size() (n int) {
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		n += 1 + sovApi(uint64(m.OpType))
+	}
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseUnion) Size() (n int) {
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		n += 1 + sovApi(uint64(m.OpType))
+	}
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	if m.Version != 0 {
+		n += 1 + sovApi(uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovApi(uint64(m.SeqNo))
+	}
+	if m.PrimaryTerm != 0 {
+		n += 1 + sovApi(uint64(m.PrimaryTerm))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.Merge {
+		n += 2
+	}
+	if m.IfVersion != 0 {
+		n += 1 + sovApi(uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		n += 1 + sovApi(uint64(m.IfSeqNo))
+	}
+	if m.IfPrimaryTerm != 0 {
+		n += 1 + sovApi(uint64(m.IfPrimaryTerm))
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	if m.Version != 0 {
+		n += 1 + sovApi(uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovApi(uint64(m.SeqNo))
+	}
+	if m.PrimaryTerm != 0 {
+		n += 1 + sovApi(uint64(m.PrimaryTerm))
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.IfVersion != 0 {
+		n += 1 + sovApi(uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		n += 1 + sovApi(uint64(m.IfSeqNo))
+	}
+	if m.IfPrimaryTerm != 0 {
+		n += 1 + sovApi(uint64(m.IfPrimaryTerm))
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	if m.Version != 0 {
+		n += 1 + sovApi(uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovApi(uint64(m.SeqNo))
+	}
+	if m.PrimaryTerm != 0 {
+		n += 1 + sovApi(uint64(m.PrimaryTerm))
+	}
+	return n
+}
+
+func (m *Failure) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteByQueryRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovApi(uint64(m.BatchSize))
+	}
+	if m.MaxDocs != 0 {
+		n += 1 + sovApi(uint64(m.MaxDocs))
+	}
+	if m.ProceedOnConflicts {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateByQueryRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovApi(uint64(m.BatchSize))
+	}
+	if m.MaxDocs != 0 {
+		n += 1 + sovApi(uint64(m.MaxDocs))
+	}
+	if m.ProceedOnConflicts {
+		n += 2
+	}
+	l = len(m.Doc)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ByQueryResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Took != 0 {
+		n += 1 + sovApi(uint64(m.Took))
+	}
+	if m.TimedOut {
+		n += 2
+	}
+	if m.Total != 0 {
+		n += 1 + sovApi(uint64(m.Total))
+	}
+	if m.Deleted != 0 {
+		n += 1 + sovApi(uint64(m.Deleted))
+	}
+	if m.Updated != 0 {
+		n += 1 + sovApi(uint64(m.Updated))
+	}
+	if m.Batches != 0 {
+		n += 1 + sovApi(uint64(m.Batches))
+	}
+	if m.VersionConflicts != 0 {
+		n += 1 + sovApi(uint64(m.VersionConflicts))
+	}
+	if m.Noops != 0 {
+		n += 1 + sovApi(uint64(m.Noops))
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AnalyzeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	if len(m.Text) > 0 {
+		for _, s := range m.Text {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	l = len(m.Analyzer)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Tokenizer)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Filter) > 0 {
+		for _, b := range m.Filter {
+			l = len(b)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.CharFilter) > 0 {
+		for _, b := range m.CharFilter {
+			l = len(b)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AnalyzeToken) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.StartOffset != 0 {
+		n += 1 + sovApi(uint64(m.StartOffset))
+	}
+	if m.EndOffset != 0 {
+		n += 1 + sovApi(uint64(m.EndOffset))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovApi(uint64(m.Position))
+	}
+	return n
+}
+
+func (m *AnalyzeResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SearchRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Scroll)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ScrollRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.ScrollID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Scroll)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearScrollRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.ScrollIDs) > 0 {
+		for _, s := range m.ScrollIDs {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClearScrollResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.NumFreed != 0 {
+		n += 1 + sovApi(uint64(m.NumFreed))
+	}
+	return n
+}
+
+func (m *SearchResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.StoredFields) > 0 {
+		for _, s := range m.StoredFields {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Found {
+		n += 2
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovApi(uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovApi(uint64(m.SeqNo))
+	}
+	if m.PrimaryTerm != 0 {
+		n += 1 + sovApi(uint64(m.PrimaryTerm))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = m.Doc.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *MultiGetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	if len(m.IDs) > 0 {
+		for _, s := range m.IDs {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.StoredFields) > 0 {
+		for _, s := range m.StoredFields {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiGetResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.Docs) > 0 {
+		for _, e := range m.Docs {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BulkRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BulkResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CountRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.PartitionID != 0 {
+		n += 1 + sovApi(uint64(m.PartitionID))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *CountResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovApi(uint64(m.Count))
+	}
+	return n
+}
+
+func sovApi(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApi(x uint64) (n int) {
+	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *RequestUnion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestUnion{`,
+		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
+		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateRequest", "CreateRequest", 1) + `,`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteRequest", "DeleteRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResponseUnion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResponseUnion{`,
+		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
+		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateResponse", "CreateResponse", 1) + `,`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateResponse", "UpdateResponse", 1) + `,`,
+		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteResponse", "DeleteResponse", 1) + `,`,
+		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "Failure", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`PrimaryTerm:` + fmt.Sprintf("%v", this.PrimaryTerm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Upsert:` + fmt.Sprintf("%v", this.Upsert) + `,`,
+		`Merge:` + fmt.Sprintf("%v", this.Merge) + `,`,
+		`IfVersion:` + fmt.Sprintf("%v", this.IfVersion) + `,`,
+		`IfSeqNo:` + fmt.Sprintf("%v", this.IfSeqNo) + `,`,
+		`IfPrimaryTerm:` + fmt.Sprintf("%v", this.IfPrimaryTerm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`PrimaryTerm:` + fmt.Sprintf("%v", this.PrimaryTerm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`IfVersion:` + fmt.Sprintf("%v", this.IfVersion) + `,`,
+		`IfSeqNo:` + fmt.Sprintf("%v", this.IfSeqNo) + `,`,
+		`IfPrimaryTerm:` + fmt.Sprintf("%v", this.IfPrimaryTerm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteResponse{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`PrimaryTerm:` + fmt.Sprintf("%v", this.PrimaryTerm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Failure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Failure{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteByQueryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteByQueryRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`MaxDocs:` + fmt.Sprintf("%v", this.MaxDocs) + `,`,
+		`ProceedOnConflicts:` + fmt.Sprintf("%v", this.ProceedOnConflicts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateByQueryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateByQueryRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`MaxDocs:` + fmt.Sprintf("%v", this.MaxDocs) + `,`,
+		`ProceedOnConflicts:` + fmt.Sprintf("%v", this.ProceedOnConflicts) + `,`,
+		`Doc:` + fmt.Sprintf("%v", this.Doc) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ByQueryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ByQueryResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Took:` + fmt.Sprintf("%v", this.Took) + `,`,
+		`TimedOut:` + fmt.Sprintf("%v", this.TimedOut) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`Batches:` + fmt.Sprintf("%v", this.Batches) + `,`,
+		`VersionConflicts:` + fmt.Sprintf("%v", this.VersionConflicts) + `,`,
+		`Noops:` + fmt.Sprintf("%v", this.Noops) + `,`,
+		`Failures:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Failures), "Failure", "Failure", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyzeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalyzeRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
+		`Analyzer:` + fmt.Sprintf("%v", this.Analyzer) + `,`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Tokenizer:` + fmt.Sprintf("%v", this.Tokenizer) + `,`,
+		`Filter:` + fmt.Sprintf("%v", this.Filter) + `,`,
+		`CharFilter:` + fmt.Sprintf("%v", this.CharFilter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyzeToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalyzeToken{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`StartOffset:` + fmt.Sprintf("%v", this.StartOffset) + `,`,
+		`EndOffset:` + fmt.Sprintf("%v", this.EndOffset) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Position:` + fmt.Sprintf("%v", this.Position) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyzeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalyzeResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Tokens:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Tokens), "AnalyzeToken", "AnalyzeToken", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Scroll:` + fmt.Sprintf("%v", this.Scroll) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScrollRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScrollRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`ScrollID:` + fmt.Sprintf("%v", this.ScrollID) + `,`,
+		`Scroll:` + fmt.Sprintf("%v", this.Scroll) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClearScrollRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClearScrollRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`ScrollIDs:` + fmt.Sprintf("%v", this.ScrollIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClearScrollResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClearScrollResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`NumFreed:` + fmt.Sprintf("%v", this.NumFreed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`StoredFields:` + fmt.Sprintf("%v", this.StoredFields) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetResult{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Found:` + fmt.Sprintf("%v", this.Found) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`PrimaryTerm:` + fmt.Sprintf("%v", this.PrimaryTerm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Doc:` + strings.Replace(strings.Replace(this.Doc.String(), "GetResult", "GetResult", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiGetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultiGetRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`IDs:` + fmt.Sprintf("%v", this.IDs) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`StoredFields:` + fmt.Sprintf("%v", this.StoredFields) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiGetResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultiGetResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Docs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Docs), "GetResult", "GetResult", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Requests:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Requests), "RequestUnion", "RequestUnion", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Responses:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Responses), "ResponseUnion", "ResponseUnion", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CountRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`PartitionID:` + fmt.Sprintf("%v", this.PartitionID) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CountResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CountResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RequestUnion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestUnion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestUnion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			}
+			m.OpType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpType |= (OpType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Create == nil {
+				m.Create = &CreateRequest{}
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &UpdateRequest{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseUnion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseUnion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			}
+			m.OpType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpType |= (OpType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Create == nil {
+				m.Create = &CreateResponse{}
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &UpdateResponse{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteResponse{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryTerm", wireType)
+			}
+			m.PrimaryTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryTerm |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merge = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfVersion", wireType)
+			}
+			m.IfVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfSeqNo", wireType)
+			}
+			m.IfSeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfSeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfPrimaryTerm", wireType)
+			}
+			m.IfPrimaryTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfPrimaryTerm |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryTerm", wireType)
+			}
+			m.PrimaryTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryTerm |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfVersion", wireType)
+			}
+			m.IfVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfSeqNo", wireType)
+			}
+			m.IfSeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfSeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfPrimaryTerm", wireType)
+			}
+			m.IfPrimaryTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfPrimaryTerm |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryTerm", wireType)
+			}
+			m.PrimaryTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryTerm |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Failure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Failure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Failure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteByQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteByQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDocs", wireType)
+			}
+			m.MaxDocs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDocs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProceedOnConflicts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProceedOnConflicts = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateByQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateByQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDocs", wireType)
+			}
+			m.MaxDocs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDocs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProceedOnConflicts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProceedOnConflicts = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doc = append(m.Doc[:0], dAtA[iNdEx:postIndex]...)
+			if m.Doc == nil {
+				m.Doc = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Took", wireType)
+			}
+			m.Took = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Took |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimedOut = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			m.Deleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deleted |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			m.Batches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Batches |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionConflicts", wireType)
+			}
+			m.VersionConflicts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VersionConflicts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Noops", wireType)
+			}
+			m.Noops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Noops |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, Failure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = append(m.Text, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyzer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokenizer = append(m.Tokenizer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tokenizer == nil {
+				m.Tokenizer = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = append(m.Filter, make([]byte, postIndex-iNdEx))
+			copy(m.Filter[len(m.Filter)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CharFilter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CharFilter = append(m.CharFilter, make([]byte, postIndex-iNdEx))
+			copy(m.CharFilter[len(m.CharFilter)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOffset", wireType)
+			}
+			m.StartOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartOffset |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndOffset", wireType)
+			}
+			m.EndOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndOffset |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, AnalyzeToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scroll", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scroll = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScrollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScrollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScrollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrollID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScrollID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scroll", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scroll = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearScrollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearScrollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearScrollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrollIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScrollIDs = append(m.ScrollIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&DeleteResponse{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *Failure) String() string {
-	if this == nil {
-		return "nil"
+func (m *ClearScrollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearScrollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearScrollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFreed", wireType)
+			}
+			m.NumFreed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumFreed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&Failure{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringApi(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *RequestUnion) Unmarshal(dAtA []byte) error {
+func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,17 +8063,17 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestUnion: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestUnion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
-			m.OpType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1311,14 +8083,106 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpType |= (OpType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1338,22 +8202,67 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Create == nil {
-				m.Create = &CreateRequest{}
-			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1363,30 +8272,28 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Update == nil {
-				m.Update = &UpdateRequest{}
-			}
-			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredFields", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1396,24 +8303,20 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteRequest{}
-			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StoredFields = append(m.StoredFields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1436,7 +8339,7 @@ func (m *RequestUnion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
+func (m *GetResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1459,17 +8362,17 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseUnion: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseUnion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.OpType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1479,16 +8382,26 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpType |= (OpType(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1498,30 +8411,17 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Create == nil {
-				m.Create = &CreateResponse{}
-			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Found = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1531,30 +8431,28 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Update == nil {
-				m.Update = &UpdateResponse{}
-			}
-			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1564,30 +8462,28 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteResponse{}
-			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Fields = append(m.Fields[:0], dAtA[iNdEx:postIndex]...)
+			if m.Fields == nil {
+				m.Fields = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1597,25 +8493,49 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Failure == nil {
-				m.Failure = &Failure{}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryTerm", wireType)
 			}
-			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PrimaryTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryTerm |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -1637,7 +8557,7 @@ func (m *ResponseUnion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1660,17 +8580,17 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1680,28 +8600,27 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1711,21 +8630,20 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1749,7 +8667,7 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiGetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1768,19 +8686,97 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiGetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IDs = append(m.IDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1804,16 +8800,16 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredFields", wireType)
 			}
-			m.Result = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1823,11 +8819,21 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredFields = append(m.StoredFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -1849,7 +8855,7 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *MultiGetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1872,17 +8878,17 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiGetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1892,28 +8898,27 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Docs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1923,43 +8928,23 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Docs = append(m.Docs, GetResult{})
+			if err := m.Docs[len(m.Docs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Upsert = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -1981,7 +8966,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *BulkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,17 +8989,17 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2024,28 +9009,27 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
 			}
-			m.Result = 0
+			m.PartitionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2055,11 +9039,42 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, RequestUnion{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2081,7 +9096,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,17 +9119,17 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2124,21 +9139,51 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, ResponseUnion{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2162,7 +9207,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *CountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,17 +9230,17 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2205,28 +9250,27 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
 			}
-			m.Result = 0
+			m.PartitionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2236,11 +9280,42 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
+				m.PartitionID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2262,7 +9337,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Failure) Unmarshal(dAtA []byte) error {
+func (m *CountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2285,17 +9360,17 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Failure: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Failure: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2305,28 +9380,27 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2336,21 +9410,11 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Count |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cause = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2480,43 +9544,119 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xf6, 0xb9, 0xae, 0xd3, 0x5c, 0xfe, 0x59, 0xd6, 0x4f, 0x3f, 0x45, 0x0c, 0x97, 0xc8, 0x42,
-	0xa4, 0xaa, 0xc0, 0x41, 0x81, 0x01, 0x21, 0x16, 0x52, 0xa7, 0x0a, 0x02, 0x92, 0xca, 0x4a, 0x40,
-	0xb0, 0x54, 0x76, 0x7c, 0x0d, 0x96, 0xdc, 0xf8, 0xb0, 0xcf, 0x43, 0xb6, 0x7e, 0x04, 0x46, 0x46,
-	0x24, 0x96, 0x7e, 0x04, 0x24, 0x16, 0xc6, 0x8e, 0x1d, 0x99, 0xa2, 0xc6, 0x7c, 0x01, 0x46, 0xd4,
-	0x09, 0xf9, 0xce, 0xb1, 0xea, 0x4c, 0x20, 0x45, 0x15, 0x93, 0xdf, 0xc7, 0xcf, 0x73, 0xef, 0x3d,
-	0xef, 0x7b, 0xef, 0x1d, 0x2c, 0x5a, 0xc4, 0xd5, 0x49, 0xe0, 0x53, 0xff, 0xd6, 0xbd, 0xa9, 0x4b,
-	0xdf, 0x45, 0xb6, 0x3e, 0xf1, 0x4f, 0xda, 0x53, 0x7f, 0xea, 0xb7, 0xd9, 0x6f, 0x3b, 0x3a, 0x66,
-	0x88, 0x01, 0x16, 0x71, 0xb9, 0x76, 0x06, 0x60, 0xd9, 0xc4, 0xef, 0x23, 0x1c, 0xd2, 0xf1, 0xcc,
-	0xf5, 0x67, 0x6a, 0x13, 0x16, 0x7c, 0x72, 0x44, 0xe7, 0x04, 0xd7, 0x41, 0x13, 0xec, 0x56, 0x3b,
-	0x05, 0x7d, 0x48, 0x46, 0x73, 0x82, 0x4d, 0xd9, 0x67, 0x5f, 0xf5, 0x0e, 0x94, 0x27, 0x01, 0xb6,
-	0x28, 0xae, 0x8b, 0x4d, 0xb0, 0x5b, 0xea, 0x54, 0xf5, 0x7d, 0x06, 0xd3, 0x34, 0x66, 0xca, 0x26,
-	0xba, 0x88, 0x38, 0x89, 0x6e, 0x2b, 0xd5, 0x8d, 0x19, 0xcc, 0x74, 0x9c, 0x4d, 0x74, 0x0e, 0xf6,
-	0x30, 0xc5, 0x75, 0x29, 0xd5, 0x19, 0x0c, 0x66, 0x3a, 0xce, 0x6a, 0x17, 0x00, 0x56, 0x4c, 0x1c,
-	0x12, 0x7f, 0x16, 0xe2, 0x3f, 0xf5, 0xda, 0x5a, 0xf3, 0x5a, 0xcb, 0xbc, 0xf2, 0x3c, 0x99, 0xd9,
-	0xd6, 0x9a, 0xd9, 0x5a, 0x66, 0x76, 0x25, 0x4c, 0xdd, 0xb6, 0xd6, 0xdc, 0xd6, 0x32, 0xb7, 0x2b,
-	0x21, 0xa7, 0x55, 0x0d, 0x16, 0x8e, 0x2d, 0xd7, 0x8b, 0x02, 0x5c, 0xdf, 0x66, 0xca, 0x1d, 0xfd,
-	0x80, 0x63, 0x73, 0x45, 0x68, 0x9f, 0x01, 0xac, 0xe4, 0x9a, 0xa7, 0xf6, 0xa1, 0xe8, 0x3a, 0xac,
-	0x9a, 0x72, 0xf7, 0x51, 0xbc, 0x68, 0x88, 0xcf, 0x8c, 0xab, 0x45, 0x43, 0xbf, 0x76, 0xa8, 0xd4,
-	0x9d, 0x7a, 0x96, 0x1d, 0xb6, 0x6d, 0x2b, 0x72, 0xf0, 0x6c, 0xea, 0xce, 0x30, 0x3f, 0xe2, 0xf6,
-	0x09, 0xa6, 0x16, 0xb1, 0xf5, 0xe7, 0x78, 0x6e, 0x8a, 0xae, 0xa3, 0xf6, 0xa1, 0xe4, 0x58, 0xd4,
-	0x62, 0x85, 0x97, 0xbb, 0x0f, 0xaf, 0x16, 0x8d, 0xfb, 0x7f, 0x91, 0xe5, 0x95, 0xe5, 0x45, 0xd8,
-	0x64, 0x19, 0xb4, 0x53, 0x00, 0xab, 0xf9, 0xb6, 0x6d, 0xd0, 0xe6, 0x6d, 0x28, 0x07, 0x38, 0x8c,
-	0x3c, 0xca, 0x8c, 0x56, 0x3b, 0x65, 0xfd, 0x75, 0xe0, 0xb2, 0x9d, 0x22, 0x8f, 0x9a, 0x29, 0xa7,
-	0x7d, 0x05, 0xb0, 0x92, 0x9b, 0x9e, 0x7f, 0xb1, 0x51, 0xea, 0xff, 0xc9, 0x10, 0x85, 0x38, 0xa0,
-	0x6c, 0x88, 0x76, 0xcc, 0x14, 0xb1, 0x06, 0xe6, 0xc7, 0xe9, 0xc6, 0x1b, 0xf8, 0x06, 0x56, 0x72,
-	0xb7, 0x6a, 0x73, 0x06, 0x58, 0x75, 0xf9, 0x3b, 0x70, 0xe3, 0xd5, 0xf9, 0xb0, 0x90, 0xde, 0xad,
-	0x0d, 0x6e, 0xfd, 0x1f, 0xdc, 0x9e, 0x58, 0x51, 0xc8, 0x9f, 0x8e, 0xa2, 0xc9, 0xc1, 0x63, 0xe9,
-	0xe3, 0xa7, 0x86, 0xb0, 0x77, 0x17, 0xca, 0xfc, 0xa5, 0x51, 0x21, 0x94, 0xf7, 0xcd, 0xde, 0xd3,
-	0x51, 0x4f, 0x11, 0x92, 0x78, 0x7c, 0x68, 0x24, 0x31, 0x48, 0x62, 0xa3, 0xf7, 0xa2, 0x37, 0xea,
-	0x29, 0xe2, 0xde, 0x4b, 0x58, 0xba, 0xe6, 0x5a, 0x2d, 0xc1, 0x02, 0x5f, 0x62, 0x28, 0x42, 0x02,
-	0xf8, 0x1a, 0x43, 0x01, 0x09, 0xe0, 0x8b, 0x0c, 0x45, 0x54, 0x2b, 0xb0, 0x38, 0x18, 0x8e, 0x8e,
-	0x0e, 0x86, 0xe3, 0x81, 0xa1, 0x6c, 0xa9, 0x3b, 0x50, 0x1a, 0x0c, 0x87, 0x87, 0x8a, 0xd4, 0x7d,
-	0x72, 0xbe, 0x44, 0xc2, 0xf7, 0x25, 0x12, 0x2e, 0x97, 0x48, 0xf8, 0xb9, 0x44, 0xc2, 0xaf, 0x25,
-	0x02, 0xa7, 0x31, 0x02, 0x67, 0x31, 0x02, 0x5f, 0x62, 0x24, 0x7c, 0x8b, 0x91, 0x70, 0x1e, 0x23,
-	0x70, 0x11, 0x23, 0x70, 0x19, 0x23, 0xf0, 0xe1, 0x07, 0x12, 0xfa, 0xe0, 0xad, 0x44, 0x42, 0x62,
-	0xdb, 0x32, 0x2b, 0xf6, 0xc1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0x6a, 0x1b, 0x9b, 0x34,
-	0x06, 0x00, 0x00,
+	// 1813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x9f, 0x9e, 0x9e, 0x8f, 0xee, 0x37, 0x3d, 0x1f, 0x5b, 0xeb, 0x44, 0xb3, 0xb3, 0x30, 0x63,
+	0x9a, 0x65, 0x63, 0xb2, 0x4b, 0x3b, 0x6b, 0x3e, 0x84, 0x56, 0x5c, 0x32, 0x1e, 0x3b, 0xb1, 0x58,
+	0x3c, 0xa1, 0xe2, 0x04, 0x04, 0x87, 0x51, 0x7b, 0xba, 0xc6, 0x6e, 0x79, 0xa6, 0xbb, 0xdd, 0x1f,
+	0x28, 0xce, 0x09, 0x24, 0x2e, 0xfc, 0x03, 0x68, 0x8f, 0x48, 0x5c, 0xf6, 0x0f, 0xe0, 0xb0, 0x07,
+	0x90, 0x38, 0x70, 0x88, 0x90, 0x90, 0x72, 0xe0, 0xc0, 0x69, 0xb4, 0x1e, 0xfe, 0x00, 0x38, 0xa2,
+	0x3d, 0xa1, 0x7a, 0x55, 0x3d, 0xee, 0x76, 0x9c, 0x65, 0xe3, 0xcd, 0x01, 0x47, 0x7b, 0xea, 0x7a,
+	0x1f, 0x55, 0xf5, 0xde, 0xef, 0xbd, 0x7a, 0xf5, 0xba, 0x40, 0xb7, 0x03, 0xd7, 0x0a, 0x42, 0x3f,
+	0xf6, 0x3b, 0xdf, 0x3a, 0x70, 0xe3, 0xc3, 0x64, 0xdf, 0x1a, 0xfb, 0xb3, 0xf5, 0x03, 0xff, 0xc0,
+	0x5f, 0x47, 0xf6, 0x7e, 0x32, 0x41, 0x0a, 0x09, 0x1c, 0x49, 0xf5, 0xef, 0x66, 0xd4, 0x63, 0xf7,
+	0x60, 0x6a, 0xef, 0x47, 0xeb, 0xfb, 0x76, 0xe2, 0x30, 0xef, 0xc0, 0xf5, 0x98, 0x98, 0xbc, 0x3e,
+	0x63, 0xb1, 0x1d, 0xec, 0xe3, 0x47, 0x4c, 0x33, 0x3f, 0x52, 0xc0, 0xa0, 0xec, 0x38, 0x61, 0x51,
+	0xfc, 0xc0, 0x73, 0x7d, 0x8f, 0xac, 0x42, 0xd5, 0x0f, 0x46, 0xf1, 0x49, 0xc0, 0xda, 0xca, 0xaa,
+	0xb2, 0xd6, 0xd8, 0xa8, 0x5a, 0xc3, 0x60, 0xef, 0x24, 0x60, 0xb4, 0xe2, 0xe3, 0x97, 0xbc, 0x0d,
+	0x95, 0x71, 0xc8, 0xec, 0x98, 0xb5, 0x8b, 0xab, 0xca, 0x5a, 0x6d, 0xa3, 0x61, 0x6d, 0x22, 0x29,
+	0x97, 0xa1, 0x52, 0xca, 0xf5, 0x92, 0xc0, 0xe1, 0x7a, 0xaa, 0xd4, 0x7b, 0x10, 0x38, 0x59, 0x3d,
+	0x21, 0xe5, 0x7a, 0x0e, 0x9b, 0xb2, 0x98, 0xb5, 0x4b, 0x52, 0x6f, 0x80, 0xe4, 0x52, 0x4f, 0x48,
+	0xcd, 0xa7, 0x0a, 0xd4, 0x29, 0x8b, 0x02, 0xdf, 0x8b, 0xd8, 0xe7, 0xb5, 0xf5, 0xc6, 0x39, 0x5b,
+	0x9b, 0x4b, 0x5b, 0xc5, 0x3a, 0x4b, 0x63, 0x6f, 0x9c, 0x33, 0xb6, 0xb9, 0x34, 0x36, 0x55, 0x94,
+	0xd6, 0xde, 0x38, 0x67, 0x6d, 0x73, 0x69, 0x6d, 0xaa, 0x28, 0xc4, 0xc4, 0x84, 0xea, 0xc4, 0x76,
+	0xa7, 0x49, 0xc8, 0xda, 0x65, 0xd4, 0xd4, 0xac, 0x6d, 0x41, 0xd3, 0x54, 0x60, 0xfe, 0x5e, 0x81,
+	0x7a, 0x0e, 0x3c, 0x72, 0x17, 0x8a, 0xae, 0x83, 0xde, 0x18, 0xfd, 0xef, 0x2f, 0xe6, 0xbd, 0xe2,
+	0xce, 0xe0, 0xd3, 0x79, 0xcf, 0xfa, 0xfc, 0xc1, 0xb5, 0x7e, 0xc8, 0x4e, 0x68, 0xd1, 0x75, 0xc8,
+	0x5d, 0x28, 0x39, 0x76, 0x6c, 0xa3, 0xe3, 0x46, 0xff, 0x3b, 0x9f, 0xce, 0x7b, 0xb7, 0x5e, 0x60,
+	0x95, 0x87, 0xf6, 0x34, 0x61, 0x14, 0x57, 0x30, 0xff, 0xae, 0x40, 0x23, 0x0f, 0xdb, 0x4b, 0x34,
+	0xf3, 0x2d, 0xa8, 0x84, 0x2c, 0x4a, 0xa6, 0x31, 0x1a, 0xda, 0xd8, 0x30, 0xac, 0x9f, 0x84, 0x2e,
+	0xee, 0x94, 0x4c, 0x63, 0x2a, 0x65, 0xa4, 0x0d, 0xd5, 0x5f, 0xb0, 0x30, 0x72, 0x7d, 0x0f, 0xe3,
+	0x53, 0xa2, 0x29, 0x49, 0xae, 0x41, 0x25, 0x62, 0xc7, 0x23, 0xcf, 0xc7, 0x78, 0x94, 0x68, 0x39,
+	0x62, 0xc7, 0xbb, 0x3e, 0xf9, 0x1a, 0x18, 0x41, 0xe8, 0xce, 0xec, 0xf0, 0x64, 0x14, 0xb3, 0x70,
+	0x86, 0x21, 0x28, 0xd1, 0x9a, 0xe4, 0xed, 0xb1, 0x70, 0x66, 0xfe, 0xa1, 0x08, 0xf5, 0x5c, 0x46,
+	0xfe, 0x3f, 0x82, 0x4f, 0xae, 0xf3, 0xc4, 0x8c, 0x58, 0x18, 0xa3, 0xe3, 0x1a, 0x95, 0x14, 0x59,
+	0x81, 0xf2, 0x8c, 0x85, 0x07, 0x22, 0x0d, 0x35, 0x2a, 0x08, 0xf2, 0x55, 0x00, 0x77, 0x32, 0x4a,
+	0xa1, 0x12, 0x4e, 0xeb, 0xee, 0xe4, 0xa1, 0x04, 0xab, 0x03, 0xba, 0x3b, 0x19, 0x49, 0xbc, 0x2a,
+	0x02, 0x48, 0x77, 0x72, 0x1f, 0x11, 0x7b, 0x1b, 0x9a, 0xee, 0x64, 0x94, 0x03, 0xad, 0x8a, 0x1a,
+	0x75, 0x77, 0x72, 0x2f, 0x03, 0x1b, 0xcf, 0x86, 0xfc, 0xd9, 0x78, 0x25, 0xb2, 0xe1, 0x8f, 0x0a,
+	0xd4, 0x73, 0x75, 0xe7, 0x25, 0x7a, 0x95, 0x8f, 0x4a, 0xf1, 0x33, 0xa3, 0xa2, 0xfe, 0xcf, 0xa8,
+	0x94, 0x9e, 0x17, 0x95, 0x7c, 0x21, 0x7a, 0x25, 0xa2, 0xe2, 0x43, 0x55, 0x16, 0xcd, 0x97, 0xe8,
+	0xce, 0x0a, 0x94, 0xc7, 0x76, 0x12, 0x89, 0x3b, 0x41, 0xa7, 0x82, 0x78, 0xbf, 0xf4, 0xe1, 0xef,
+	0x7a, 0x05, 0xf3, 0xe3, 0x22, 0xac, 0x08, 0x1c, 0xfb, 0x27, 0x3f, 0x4e, 0x58, 0x78, 0x92, 0x66,
+	0xc3, 0x2d, 0xa8, 0x1c, 0x32, 0xdb, 0x61, 0x61, 0x5b, 0x91, 0xb7, 0x94, 0x94, 0xdc, 0x45, 0x6e,
+	0x5f, 0x7b, 0x32, 0xef, 0x15, 0x9e, 0xce, 0x7b, 0x0a, 0x95, 0x7a, 0x64, 0x0a, 0x46, 0x60, 0x87,
+	0xb1, 0x1b, 0xbb, 0xbe, 0x37, 0x72, 0x1d, 0xdc, 0xad, 0xde, 0xdf, 0x59, 0xcc, 0x7b, 0xb5, 0x7b,
+	0x29, 0x1f, 0x7d, 0xf8, 0xde, 0x0b, 0xf8, 0x90, 0x99, 0x49, 0x6b, 0xcb, 0xe5, 0x77, 0xd0, 0xa9,
+	0x63, 0x6e, 0x2f, 0x62, 0x6f, 0x50, 0x41, 0xf0, 0xcc, 0xdb, 0xb7, 0xe3, 0xf1, 0xe1, 0x28, 0x72,
+	0x1f, 0x8b, 0x52, 0x51, 0xa6, 0x3a, 0x72, 0xee, 0xbb, 0x8f, 0x19, 0x79, 0x03, 0xb4, 0x99, 0xfd,
+	0x68, 0xe4, 0xf8, 0xe3, 0x08, 0xd1, 0x57, 0x69, 0x75, 0x66, 0x3f, 0x1a, 0xf8, 0xe3, 0x88, 0xdc,
+	0x82, 0x95, 0x20, 0xf4, 0xc7, 0x8c, 0x39, 0x23, 0xdf, 0x1b, 0x8d, 0x7d, 0x6f, 0x32, 0x75, 0xc7,
+	0x71, 0x84, 0x55, 0x43, 0xa3, 0x44, 0xca, 0x86, 0xde, 0x66, 0x2a, 0x31, 0xff, 0x5a, 0x84, 0x15,
+	0x51, 0x18, 0xbe, 0x84, 0xee, 0xc5, 0xa0, 0x23, 0x2d, 0x50, 0x1d, 0x7f, 0x8c, 0xf5, 0xd6, 0xa0,
+	0x7c, 0x68, 0x3e, 0x29, 0x42, 0x73, 0x09, 0xa3, 0x3c, 0xd0, 0xef, 0x9d, 0xc3, 0xb1, 0x69, 0xa5,
+	0xa2, 0xe7, 0x02, 0x49, 0xa0, 0x14, 0xfb, 0xfe, 0x11, 0x02, 0xa8, 0x52, 0x1c, 0x93, 0x37, 0x41,
+	0x8f, 0xdd, 0x19, 0x37, 0x2e, 0x49, 0x2f, 0x15, 0x0d, 0x19, 0xc3, 0x04, 0xaf, 0x95, 0xd8, 0x8f,
+	0xed, 0x29, 0x3a, 0xac, 0x52, 0x41, 0xf0, 0xa3, 0x2d, 0xba, 0x1a, 0x27, 0xf5, 0x55, 0x92, 0x5c,
+	0x22, 0x1a, 0x23, 0x07, 0xdd, 0x53, 0x69, 0x4a, 0x72, 0x09, 0xa2, 0xc5, 0x22, 0xf4, 0x4b, 0xa5,
+	0x29, 0x49, 0xde, 0x81, 0xd7, 0x64, 0x65, 0xc8, 0x80, 0xa3, 0xa1, 0x4e, 0x4b, 0x0a, 0xce, 0xa0,
+	0x59, 0x81, 0xb2, 0xe7, 0xfb, 0x41, 0xd4, 0xd6, 0x85, 0x41, 0x48, 0x90, 0x9b, 0xa0, 0xc9, 0x1e,
+	0x2a, 0x6a, 0xc3, 0xaa, 0x9a, 0xed, 0xae, 0xfa, 0x25, 0x8e, 0x02, 0x5d, 0xca, 0xcd, 0xbf, 0x15,
+	0xa1, 0x71, 0xdb, 0xb3, 0xa7, 0x27, 0x8f, 0xd9, 0x55, 0xc9, 0x48, 0x1e, 0x36, 0xf6, 0x88, 0x47,
+	0x47, 0x5d, 0xd3, 0x29, 0x8e, 0x49, 0x07, 0x34, 0x5b, 0x78, 0x11, 0x62, 0x70, 0x74, 0xba, 0xa4,
+	0x39, 0x48, 0x13, 0x97, 0x4d, 0x45, 0x74, 0x74, 0x2a, 0x08, 0xf2, 0x15, 0xd0, 0x63, 0xff, 0x88,
+	0x79, 0x2e, 0x9f, 0x52, 0xc1, 0xdc, 0x3a, 0x63, 0xf0, 0xc6, 0x62, 0xe2, 0x4e, 0x63, 0x16, 0xb6,
+	0xab, 0xab, 0xea, 0x9a, 0x41, 0x25, 0x45, 0x7a, 0x50, 0x1b, 0x1f, 0xda, 0xe1, 0x48, 0x0a, 0x35,
+	0x14, 0x02, 0x67, 0x6d, 0x23, 0xc7, 0xfc, 0xad, 0x02, 0x86, 0xc4, 0x73, 0x8f, 0xaf, 0x26, 0x72,
+	0xe6, 0x88, 0x79, 0x08, 0xa6, 0x4e, 0x05, 0xc1, 0xab, 0x7b, 0x14, 0xdb, 0x61, 0x3c, 0xf2, 0x27,
+	0x93, 0x88, 0x89, 0xab, 0xa3, 0x4c, 0x6b, 0xc8, 0x1b, 0x22, 0x8b, 0x1f, 0x31, 0xe6, 0x39, 0xa9,
+	0x82, 0x2a, 0x8e, 0x18, 0xf3, 0x1c, 0x29, 0xe6, 0x28, 0xf0, 0xde, 0x5e, 0x78, 0x8b, 0x63, 0x8e,
+	0x42, 0xe0, 0x47, 0x88, 0x13, 0x3a, 0x5b, 0xa6, 0x4b, 0xda, 0x3c, 0x86, 0xe6, 0x32, 0xce, 0x97,
+	0x3f, 0x32, 0xef, 0x40, 0x05, 0x1d, 0x88, 0xda, 0x45, 0x4c, 0xac, 0xba, 0x95, 0x75, 0x56, 0x66,
+	0x97, 0x54, 0x31, 0x4f, 0x15, 0xa8, 0xdf, 0x67, 0x76, 0x38, 0x3e, 0xbc, 0x2a, 0xa9, 0xd5, 0x86,
+	0x6a, 0x28, 0x0c, 0x92, 0xe5, 0x2e, 0x25, 0x79, 0x42, 0x44, 0xe3, 0xd0, 0x9f, 0x4e, 0x25, 0xe0,
+	0x92, 0x32, 0x7f, 0xcd, 0x7d, 0xc4, 0xe1, 0xe5, 0x7d, 0xfc, 0x26, 0xe8, 0x62, 0xb5, 0xd4, 0x41,
+	0xbd, 0x6f, 0x2c, 0xe6, 0x3d, 0x4d, 0xac, 0xbb, 0x33, 0xa0, 0x9a, 0x10, 0xef, 0x38, 0x19, 0x33,
+	0xd4, 0x9c, 0x19, 0x31, 0x90, 0xcd, 0x29, 0xb3, 0xc3, 0x2f, 0x6a, 0xca, 0xbb, 0x00, 0x4b, 0x53,
+	0x44, 0x8c, 0xf5, 0x7e, 0x7d, 0x31, 0xef, 0xe9, 0xa9, 0x2d, 0x11, 0xd5, 0x53, 0x63, 0x22, 0x93,
+	0xc1, 0xeb, 0xb9, 0x5d, 0x2f, 0x9f, 0x57, 0x6f, 0x82, 0xee, 0x25, 0xb3, 0xd1, 0x24, 0x64, 0x4c,
+	0xc6, 0x98, 0x6a, 0x5e, 0x32, 0xdb, 0xe6, 0xb4, 0xf9, 0x73, 0x68, 0xa4, 0x69, 0x74, 0xf9, 0x1d,
+	0xae, 0xe7, 0xda, 0x34, 0x23, 0x6d, 0xcc, 0xcc, 0x5f, 0x15, 0x01, 0xee, 0xb0, 0xf8, 0xaa, 0x64,
+	0xe8, 0x75, 0x6c, 0xf4, 0x30, 0xf8, 0xfd, 0x8a, 0x68, 0xf4, 0xb0, 0x6d, 0xe3, 0x89, 0xe1, 0x27,
+	0xe1, 0x58, 0x14, 0x04, 0x83, 0x4a, 0x8a, 0x7c, 0x1d, 0xea, 0x51, 0xec, 0x87, 0xcc, 0x19, 0x61,
+	0xd9, 0xe3, 0xd7, 0x31, 0xaf, 0x9a, 0x86, 0x60, 0x6e, 0x23, 0xcf, 0xfc, 0x93, 0x02, 0x3a, 0x62,
+	0x80, 0xad, 0xea, 0xf5, 0x65, 0x2f, 0x99, 0xdf, 0x82, 0xd7, 0x51, 0x3f, 0xf1, 0x84, 0x87, 0x1a,
+	0x15, 0x44, 0x66, 0x63, 0x35, 0xb7, 0x31, 0x56, 0x50, 0xdc, 0x51, 0x1a, 0x24, 0xa8, 0x6c, 0x23,
+	0x5c, 0x7e, 0x5e, 0x23, 0x5c, 0xf9, 0xac, 0x46, 0xb8, 0xfa, 0x6c, 0x23, 0xec, 0x40, 0x4d, 0x98,
+	0x7f, 0xe9, 0xec, 0x30, 0x45, 0x8f, 0x21, 0xde, 0x41, 0xc0, 0x5a, 0x82, 0x21, 0x2b, 0x1a, 0x17,
+	0x9a, 0xbf, 0x29, 0x42, 0xf3, 0x47, 0xc9, 0x34, 0x76, 0xaf, 0x50, 0xba, 0xbc, 0x01, 0x2a, 0x3f,
+	0xc8, 0x78, 0x55, 0xf6, 0xab, 0x8b, 0x79, 0x4f, 0xe5, 0x47, 0x98, 0xf3, 0xbe, 0x58, 0xc6, 0x1c,
+	0x41, 0xeb, 0x0c, 0x8a, 0xcb, 0xc3, 0xfe, 0x16, 0x94, 0xb0, 0x47, 0x14, 0x97, 0xc9, 0xb3, 0xb8,
+	0xa3, 0xd4, 0x9c, 0x2b, 0x50, 0xeb, 0x27, 0xd3, 0xa3, 0xab, 0x02, 0xfa, 0x3a, 0x68, 0xf2, 0xda,
+	0x10, 0xc8, 0xf3, 0x6b, 0x32, 0xfb, 0x8c, 0x98, 0x36, 0x61, 0xa9, 0x92, 0x99, 0x80, 0x21, 0xfc,
+	0xbb, 0x3c, 0x92, 0x1b, 0xa0, 0x87, 0x52, 0x27, 0x85, 0xb3, 0x61, 0xe5, 0x1e, 0x04, 0xe5, 0xae,
+	0x67, 0x6a, 0xe6, 0x5f, 0x14, 0x30, 0x36, 0xfd, 0xc4, 0x8b, 0xaf, 0xf4, 0xbf, 0x88, 0xf9, 0x53,
+	0xa8, 0x4b, 0x2f, 0x2e, 0x0f, 0x1f, 0xff, 0xeb, 0xe5, 0x6b, 0xc8, 0xf7, 0x07, 0x41, 0xdc, 0x7c,
+	0x17, 0x2a, 0xe2, 0xc9, 0x94, 0x00, 0x54, 0x36, 0xe9, 0xd6, 0xed, 0xbd, 0xad, 0x56, 0x81, 0x8f,
+	0x1f, 0xdc, 0x1b, 0xf0, 0xb1, 0xc2, 0xc7, 0x83, 0xad, 0x0f, 0xb6, 0xf6, 0xb6, 0x5a, 0xc5, 0x9b,
+	0x63, 0xa8, 0x65, 0xfe, 0xfc, 0x49, 0x0d, 0xaa, 0x62, 0xca, 0xa0, 0x55, 0xe0, 0x84, 0x98, 0x33,
+	0x68, 0x29, 0x9c, 0x10, 0x93, 0x06, 0xad, 0x22, 0xa9, 0x83, 0xbe, 0x3b, 0xdc, 0x1b, 0x6d, 0x0f,
+	0x1f, 0xec, 0x0e, 0x5a, 0x2a, 0xd1, 0xa0, 0xb4, 0x3b, 0x1c, 0xde, 0x6b, 0x95, 0xc8, 0x0a, 0xb4,
+	0x1e, 0x6e, 0xd1, 0xfb, 0x3b, 0xc3, 0xdd, 0xd1, 0xe6, 0x70, 0x77, 0xfb, 0x83, 0x9d, 0xcd, 0xbd,
+	0x56, 0x79, 0xe3, 0x5f, 0x2a, 0x54, 0x6f, 0x07, 0xee, 0x9d, 0x30, 0x18, 0xf3, 0xa2, 0x75, 0x87,
+	0xc5, 0xa4, 0x66, 0x9d, 0x15, 0xa4, 0x8e, 0x61, 0x65, 0x8e, 0xa4, 0x59, 0x20, 0xef, 0x81, 0x96,
+	0x1e, 0x54, 0xd2, 0xb2, 0xce, 0x95, 0xaf, 0xce, 0x6b, 0xd6, 0xf9, 0x53, 0x6c, 0x16, 0xc8, 0x37,
+	0xa0, 0xc4, 0xb3, 0x91, 0x18, 0x56, 0xe6, 0xd0, 0x75, 0xea, 0x56, 0x36, 0x45, 0xcd, 0x02, 0x6f,
+	0x05, 0xc5, 0xad, 0x4c, 0x1a, 0x56, 0xae, 0xcb, 0xeb, 0x34, 0xad, 0xfc, 0x75, 0x6d, 0x16, 0xc8,
+	0x1a, 0x94, 0x31, 0x46, 0xa4, 0x6e, 0x65, 0x33, 0xae, 0xd3, 0xb0, 0x72, 0xa1, 0x33, 0x0b, 0xc4,
+	0x82, 0xaa, 0x6c, 0x29, 0x49, 0xd3, 0xca, 0xff, 0x99, 0x74, 0x5a, 0xd6, 0xb9, 0x16, 0x56, 0x9a,
+	0x81, 0xed, 0x07, 0x37, 0x23, 0xdb, 0xfd, 0x5c, 0x64, 0xc6, 0xfb, 0x50, 0xcb, 0x34, 0x2c, 0xe4,
+	0x75, 0xeb, 0xd9, 0xa6, 0xa9, 0xb3, 0x62, 0x5d, 0xd0, 0xd3, 0xe0, 0xdc, 0x7a, 0xee, 0xed, 0x83,
+	0x5c, 0xb3, 0x2e, 0x7a, 0x0b, 0xe9, 0xb4, 0xac, 0x73, 0xbf, 0xa6, 0x62, 0x6e, 0xee, 0xe7, 0x9f,
+	0x5c, 0xb3, 0x2e, 0x7a, 0x0c, 0xb8, 0x68, 0x6e, 0xff, 0x07, 0x4f, 0x4e, 0xbb, 0x85, 0x7f, 0x9c,
+	0x76, 0x0b, 0x9f, 0x9c, 0x76, 0x0b, 0xff, 0x3e, 0xed, 0x16, 0xfe, 0x73, 0xda, 0x55, 0x7e, 0xb9,
+	0xe8, 0x2a, 0x1f, 0x2d, 0xba, 0xca, 0xc7, 0x8b, 0x6e, 0xe1, 0xcf, 0x8b, 0x6e, 0xe1, 0xc9, 0xa2,
+	0xab, 0x3c, 0x5d, 0x74, 0x95, 0x4f, 0x16, 0x5d, 0xe5, 0xc3, 0x7f, 0x76, 0x0b, 0x77, 0x95, 0x9f,
+	0x95, 0x82, 0x28, 0xd8, 0xdf, 0xaf, 0xe0, 0xc9, 0xfa, 0xf6, 0x7f, 0x07, 0x00, 0x6c, 0xd6, 0x9c,
+	0x40, 0x3c, 0x19, 0x00, 0x00,
 }
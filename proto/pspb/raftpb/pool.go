@@ -15,9 +15,11 @@ func CreateRaftCommand() *RaftCommand {
 	return raftCmdPool.Get().(*RaftCommand)
 }
 
-// Close reset and put to pool
+// Close reset and put to pool, the unmarshaling does not reset the fields missing in the data
 func (c *RaftCommand) Close() error {
+	c.Type = CmdType_WRITE
 	c.WriteCommands = nil
+	c.Term = 0
 	raftCmdPool.Put(c)
 	return nil
 }
@@ -9,6 +9,7 @@
 
 	It has these top-level messages:
 		RaftCommand
+		SnapshotKVPair
 */
 package raftpb
 
@@ -18,6 +19,8 @@ import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import api "github.com/tiglabs/baudengine/proto/pspb"
 
+import bytes "bytes"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -58,14 +61,26 @@ func (CmdType) EnumDescriptor() ([]byte, []int) { return fileDescriptorRaftcmd,
 type RaftCommand struct {
 	Type          CmdType            `protobuf:"varint,1,opt,name=type,proto3,enum=CmdType" json:"type,omitempty"`
 	WriteCommands []api.RequestUnion `protobuf:"bytes,2,rep,name=write_commands,json=writeCommands" json:"write_commands"`
+	// the raft term of the leader proposing the command, it is the primary term of the writes on all the replicas
+	Term uint64 `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
 }
 
 func (m *RaftCommand) Reset()                    { *m = RaftCommand{} }
 func (*RaftCommand) ProtoMessage()               {}
 func (*RaftCommand) Descriptor() ([]byte, []int) { return fileDescriptorRaftcmd, []int{0} }
 
+type SnapshotKVPair struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *SnapshotKVPair) Reset()                    { *m = SnapshotKVPair{} }
+func (*SnapshotKVPair) ProtoMessage()               {}
+func (*SnapshotKVPair) Descriptor() ([]byte, []int) { return fileDescriptorRaftcmd, []int{1} }
+
 func init() {
 	proto.RegisterType((*RaftCommand)(nil), "RaftCommand")
+	proto.RegisterType((*SnapshotKVPair)(nil), "SnapshotKVPair")
 	proto.RegisterEnum("CmdType", CmdType_name, CmdType_value)
 }
 func (this *RaftCommand) Equal(that interface{}) bool {
@@ -98,6 +113,36 @@ func (this *RaftCommand) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Term != that1.Term {
+		return false
+	}
+	return true
+}
+func (this *SnapshotKVPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotKVPair)
+	if !ok {
+		that2, ok := that.(SnapshotKVPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
 	return true
 }
 func (m *RaftCommand) Marshal() (dAtA []byte, err error) {
@@ -132,6 +177,41 @@ func (m *RaftCommand) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Term != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRaftcmd(dAtA, i, uint64(m.Term))
+	}
+	return i, nil
+}
+
+func (m *SnapshotKVPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotKVPair) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftcmd(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftcmd(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
 	return i, nil
 }
 
@@ -155,6 +235,24 @@ func NewPopulatedRaftCommand(r randyRaftcmd, easy bool) *RaftCommand {
 			this.WriteCommands[i] = *v2
 		}
 	}
+	this.Term = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSnapshotKVPair(r randyRaftcmd, easy bool) *SnapshotKVPair {
+	this := &SnapshotKVPair{}
+	v3 := r.Intn(100)
+	this.Key = make([]byte, v3)
+	for i := 0; i < v3; i++ {
+		this.Key[i] = byte(r.Intn(256))
+	}
+	v4 := r.Intn(100)
+	this.Value = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.Value[i] = byte(r.Intn(256))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -179,9 +277,9 @@ func randUTF8RuneRaftcmd(r randyRaftcmd) rune {
 	return rune(ru + 61)
 }
 func randStringRaftcmd(r randyRaftcmd) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v5 := r.Intn(100)
+	tmps := make([]rune, v5)
+	for i := 0; i < v5; i++ {
 		tmps[i] = randUTF8RuneRaftcmd(r)
 	}
 	return string(tmps)
@@ -203,11 +301,11 @@ func randFieldRaftcmd(dAtA []byte, r randyRaftcmd, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(key))
-		v4 := r.Int63()
+		v6 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v6 *= -1
 		}
-		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(v4))
+		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(v6))
 	case 1:
 		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -244,6 +342,23 @@ func (m *RaftCommand) Size() (n int) {
 			n += 1 + l + sovRaftcmd(uint64(l))
 		}
 	}
+	if m.Term != 0 {
+		n += 1 + sovRaftcmd(uint64(m.Term))
+	}
+	return n
+}
+
+func (m *SnapshotKVPair) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRaftcmd(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRaftcmd(uint64(l))
+	}
 	return n
 }
 
@@ -267,6 +382,18 @@ func (this *RaftCommand) String() string {
 	s := strings.Join([]string{`&RaftCommand{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`WriteCommands:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.WriteCommands), "RequestUnion", "api.RequestUnion", 1), `&`, ``, 1) + `,`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotKVPair) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotKVPair{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -358,6 +485,137 @@ func (m *RaftCommand) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotKVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotKVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotKVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmd(dAtA[iNdEx:])
@@ -487,24 +745,27 @@ var (
 func init() { proto.RegisterFile("raftcmd.proto", fileDescriptorRaftcmd) }
 
 var fileDescriptorRaftcmd = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x4a, 0x4c, 0x2b,
-	0x49, 0xce, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x97, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0x0b, 0x27, 0x95, 0xa6,
-	0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0x55, 0x6e, 0x84, 0xa4, 0xbc, 0x24, 0x33, 0x3d, 0x27, 0x31,
-	0xa9, 0x58, 0x3f, 0x29, 0xb1, 0x34, 0x25, 0x35, 0x2f, 0x3d, 0x33, 0x2f, 0x15, 0xa2, 0x59, 0xbf,
-	0xa0, 0xb8, 0x20, 0x49, 0x3f, 0xb1, 0x20, 0x13, 0xa2, 0x47, 0x29, 0x9d, 0x8b, 0x3b, 0x28, 0x31,
-	0xad, 0xc4, 0x39, 0x3f, 0x37, 0x37, 0x31, 0x2f, 0x45, 0x48, 0x86, 0x8b, 0xa5, 0xa4, 0xb2, 0x20,
-	0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xcf, 0x88, 0x43, 0xcf, 0x39, 0x37, 0x25, 0xa4, 0xb2, 0x20,
-	0x35, 0x08, 0x2c, 0x2a, 0x64, 0xc5, 0xc5, 0x57, 0x5e, 0x94, 0x59, 0x92, 0x1a, 0x9f, 0x0c, 0x51,
-	0x5e, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xc4, 0xab, 0x17, 0x94, 0x5a, 0x58, 0x9a, 0x5a,
-	0x5c, 0x12, 0x9a, 0x97, 0x99, 0x9f, 0xe7, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x2f, 0x58,
-	0x29, 0xd4, 0xe0, 0x62, 0x2d, 0x79, 0x2e, 0x76, 0xa8, 0x61, 0x42, 0x9c, 0x5c, 0xac, 0xe1, 0x41,
-	0x9e, 0x21, 0xae, 0x02, 0x0c, 0x20, 0xa6, 0xa3, 0x8b, 0xaf, 0xa7, 0x9f, 0x00, 0xa3, 0x93, 0xdd,
-	0x89, 0x87, 0x72, 0x0c, 0x37, 0x1e, 0xca, 0x31, 0x3c, 0x78, 0x28, 0xc7, 0xf0, 0xe1, 0xa1, 0x1c,
-	0xc3, 0x8f, 0x87, 0x72, 0x8c, 0x0d, 0x8f, 0xe4, 0x18, 0x57, 0x3c, 0x92, 0x63, 0xdc, 0xf1, 0x48,
-	0x8e, 0xe1, 0xc0, 0x23, 0x39, 0x86, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x06, 0x0f, 0xc6, 0x28, 0x36, 0x50, 0x98, 0x15, 0x24, 0x25,
-	0xb1, 0x81, 0x3d, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x4f, 0x67, 0x0c, 0x44, 0x01,
-	0x00, 0x00,
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xc1, 0x4a, 0xfb, 0x30,
+	0x1c, 0xc7, 0x93, 0xad, 0xdb, 0xff, 0x6f, 0xe6, 0xc6, 0x28, 0x1e, 0x8a, 0x48, 0x36, 0x76, 0x1a,
+	0x82, 0x2d, 0xcc, 0x8b, 0x78, 0x10, 0xdc, 0x14, 0x1c, 0xa2, 0x48, 0x9d, 0x0a, 0x5e, 0x24, 0x5d,
+	0xb3, 0x2e, 0xb8, 0x36, 0xb1, 0x4d, 0x95, 0xe1, 0xc5, 0xc7, 0xf0, 0x11, 0x7c, 0x04, 0x8f, 0x1e,
+	0x77, 0xf4, 0xe8, 0x49, 0xd6, 0xf8, 0x02, 0x1e, 0x3d, 0xca, 0xd2, 0x1d, 0xbc, 0x7d, 0xbe, 0x5f,
+	0x3e, 0xbf, 0x24, 0xe4, 0x87, 0xaa, 0x31, 0x19, 0xc9, 0x61, 0xe8, 0xdb, 0x22, 0xe6, 0x92, 0xaf,
+	0x6f, 0x05, 0x4c, 0x8e, 0x53, 0xcf, 0x1e, 0xf2, 0xd0, 0x09, 0x78, 0xc0, 0x1d, 0x5d, 0x7b, 0xe9,
+	0x48, 0x27, 0x1d, 0x34, 0x2d, 0xf5, 0xce, 0x1f, 0x5d, 0xb2, 0x60, 0x42, 0xbc, 0xc4, 0xf1, 0x48,
+	0xea, 0xd3, 0x28, 0x60, 0x11, 0xcd, 0x87, 0x1d, 0x91, 0x08, 0xcf, 0x21, 0x82, 0xe5, 0x33, 0xad,
+	0x47, 0x54, 0x71, 0xc9, 0x48, 0xf6, 0x78, 0x18, 0x92, 0xc8, 0x37, 0x37, 0x90, 0x21, 0xa7, 0x82,
+	0x5a, 0xb0, 0x09, 0xdb, 0xb5, 0xce, 0x7f, 0xbb, 0x17, 0xfa, 0x83, 0xa9, 0xa0, 0xae, 0x6e, 0xcd,
+	0x5d, 0x54, 0x7b, 0x88, 0x99, 0xa4, 0x37, 0xc3, 0x5c, 0x4f, 0xac, 0x42, 0xb3, 0xd8, 0xae, 0x74,
+	0xaa, 0xb6, 0x4b, 0xef, 0x52, 0x9a, 0xc8, 0x8b, 0x88, 0xf1, 0xa8, 0x6b, 0xcc, 0x3e, 0x1b, 0xc0,
+	0xad, 0x6a, 0x75, 0x79, 0x70, 0x62, 0x9a, 0xc8, 0x90, 0x34, 0x0e, 0xad, 0x62, 0x13, 0xb6, 0x0d,
+	0x57, 0x73, 0x6b, 0x07, 0xd5, 0xce, 0x23, 0x22, 0x92, 0x31, 0x97, 0xc7, 0x97, 0x67, 0x84, 0xc5,
+	0x66, 0x1d, 0x15, 0x6f, 0xe9, 0x54, 0x5f, 0xbf, 0xea, 0x2e, 0xd0, 0x5c, 0x43, 0xa5, 0x7b, 0x32,
+	0x49, 0xa9, 0x55, 0xd0, 0x5d, 0x1e, 0x36, 0x1b, 0xe8, 0xdf, 0xf2, 0x69, 0xe6, 0x0a, 0x2a, 0x5d,
+	0xb9, 0xfd, 0xc1, 0x61, 0x1d, 0x2c, 0x70, 0xff, 0xe0, 0xa4, 0x7f, 0x5a, 0x87, 0xdd, 0xbd, 0x59,
+	0x86, 0xc1, 0x47, 0x86, 0xc1, 0x3c, 0xc3, 0xe0, 0x3b, 0xc3, 0xe0, 0x27, 0xc3, 0xf0, 0x49, 0x61,
+	0xf8, 0xa2, 0x30, 0x7c, 0x55, 0x18, 0xbc, 0x29, 0x0c, 0x66, 0x0a, 0xc3, 0x77, 0x85, 0xe1, 0x5c,
+	0x61, 0xf8, 0xfc, 0x85, 0xc1, 0x11, 0xbc, 0x2e, 0x2f, 0x36, 0x20, 0x3c, 0xaf, 0xac, 0xbf, 0x67,
+	0xfb, 0x77, 0x00, 0xf6, 0xc0, 0xcf, 0xb1, 0x92, 0x01, 0x00, 0x00,
 }
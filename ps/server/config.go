@@ -27,6 +27,14 @@ type Config struct {
 	AdminPort         int           `json:"admin-port,omitempty"`
 	HeartbeatInterval int           `json:"heartbeat-interval,omitempty"`
 
+	// the topo server watched for the schema changes of the spaces, no watch if the endpoints are empty
+	Topo          string `json:"topo,omitempty"`
+	TopoEndPoints string `json:"topo-endpoints,omitempty"`
+	TopoRootDir   string `json:"topo-root-dir,omitempty"`
+
+	// AnalysisDictDir is the directory of the word dicts used by the kernel analyzers, such as baud_zh.dict
+	AnalysisDictDir string `json:"analysis-dict-dir,omitempty"`
+
 	RaftHeartbeatPort      int    `json:"raft-heartbeat-port,omitempty"`
 	RaftReplicatePort      int    `json:"raft-replicate-port,omitempty"`
 	RaftHeartbeatInterval  int    `json:"raft-heartbeat-interval,omitempty"`
@@ -56,6 +64,16 @@ func LoadConfig(conf *config.Config) *Config {
 	c.LogDir = conf.GetString("log.dir")
 	c.LogModule = conf.GetString("log.module")
 	c.LogLevel = conf.GetString("log.level")
+	c.Topo = conf.GetString("topo.implementation")
+	if c.Topo == "" {
+		c.Topo = "etcd3"
+	}
+	c.TopoEndPoints = conf.GetString("topo.endpoints")
+	c.TopoRootDir = conf.GetString("topo.root.dir")
+	if c.TopoRootDir == "" {
+		c.TopoRootDir = "/"
+	}
+	c.AnalysisDictDir = conf.GetString("analysis.dict.dir")
 	c.PartitionStore = conf.GetString("partition.store")
 	if c.PartitionStore == "" {
 		c.PartitionStore = "raftstore"
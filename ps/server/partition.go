@@ -16,9 +16,16 @@ type PartitionStore interface {
 	GetMeta() metapb.Partition
 	GetStats() *masterpb.PartitionInfo
 
-	Get(docID engine.DOC_ID, timeout string) (doc engine.DOCUMENT, found bool, err error)
+	Get(docID engine.DOC_ID, req *engine.GetRequest, timeout string) (doc *engine.GetResult, found bool, err error)
+	Search(req *engine.SearchRequest, timeout string) (*engine.SearchResult, error)
+	NewSearchSnapshot() (engine.Snapshot, error)
+	Analyze(req *engine.AnalyzeRequest) (*engine.AnalyzeResult, error)
 
 	Bulk(requests []pspb.RequestUnion, timeout string) (responses []pspb.ResponseUnion, err error)
+	DeleteByQuery(request *pspb.DeleteByQueryRequest, timeout string) (*pspb.ByQueryResponse, error)
+	UpdateByQuery(request *pspb.UpdateByQueryRequest, timeout string) (*pspb.ByQueryResponse, error)
+
+	UpdateSchema(schema string, version uint64) error
 }
 
 func (s *Server) CreatePartitionStore(p metapb.Partition) (PartitionStore, error) {
@@ -35,6 +42,9 @@ func (s *Server) CreatePartitionStore(p metapb.Partition) (PartitionStore, error
 			ReadOnly:     false,
 			ExtraOptions: s.StoreOption,
 		}
+		if s.schemas != nil {
+			conf.EngineConfig.Schema, conf.EngineConfig.SchemaVersion = s.schemas.getSchema(p.DB, p.Space)
+		}
 		conf.EngineName = s.StoreEngine
 		conf.Meta = p
 		conf.NodeID = s.NodeID
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/topo"
+	_ "github.com/tiglabs/baudengine/topo/etcd3topo"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+const (
+	schemaLoadTimeout   = 10 * time.Second
+	schemaWatchInterval = 3 * time.Second
+)
+
+type spaceKey struct {
+	db    metapb.DBID
+	space metapb.SpaceID
+}
+
+// schemaWatcher watches the spaces in topo, and updates the schema of the partitions when the schema of their space changes.
+type schemaWatcher struct {
+	server     *Server
+	topoServer *topo.TopoServer
+
+	lock   sync.RWMutex
+	spaces map[spaceKey]*metapb.Space
+}
+
+func newSchemaWatcher(s *Server) (*schemaWatcher, error) {
+	topoServer, err := topo.OpenServer(s.Topo, s.TopoEndPoints, s.TopoRootDir)
+	if err != nil {
+		return nil, err
+	}
+	return &schemaWatcher{server: s, topoServer: topoServer, spaces: make(map[spaceKey]*metapb.Space)}, nil
+}
+
+// load reads the schemas of all spaces, so that the partitions are created with the schema of their space.
+func (w *schemaWatcher) load() error {
+	ctx, cancel := context.WithTimeout(w.server.ctx, schemaLoadTimeout)
+	defer cancel()
+
+	spaces, err := w.topoServer.GetAllSpaces(ctx)
+	if err != nil && err != topo.ErrNoNode {
+		return err
+	}
+	for _, space := range spaces {
+		w.updateSpace(space.Space)
+	}
+	return nil
+}
+
+// getSchema returns the schema of the space and its version, the version is 0 if the space is unknown.
+func (w *schemaWatcher) getSchema(db metapb.DBID, space metapb.SpaceID) (string, uint64) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+
+	if s, ok := w.spaces[spaceKey{db: db, space: space}]; ok {
+		return s.Schema, s.SchemaVersion
+	}
+	return "", 0
+}
+
+// watch watches the spaces until the watch fails or the server stops, it is run by a work daemon.
+func (w *schemaWatcher) watch() {
+	ctx, cancel := context.WithCancel(w.server.ctx)
+	defer cancel()
+
+	err, spaces, changes, cancelWatch := w.topoServer.WatchSpaces(ctx)
+	if err != nil {
+		log.Error("watch spaces error: %s", err)
+		w.wait()
+		return
+	}
+	defer cancelWatch()
+
+	for _, space := range spaces {
+		w.updateSpace(space.Space)
+	}
+	for change := range changes {
+		switch change.Err {
+		case nil:
+			w.updateSpace(change.Space)
+		case topo.ErrNoNode:
+			w.lock.Lock()
+			delete(w.spaces, spaceKey{db: change.DB, space: change.ID})
+			w.lock.Unlock()
+		default:
+			log.Error("watch spaces error: %s", change.Err)
+			w.wait()
+			return
+		}
+	}
+}
+
+func (w *schemaWatcher) wait() {
+	select {
+	case <-w.server.ctx.Done():
+	case <-time.After(schemaWatchInterval):
+	}
+}
+
+// updateSpace keeps the space, and updates the schema of its partitions if the schema is newer.
+func (w *schemaWatcher) updateSpace(space *metapb.Space) {
+	key := spaceKey{db: space.DB, space: space.ID}
+	w.lock.Lock()
+	if old, ok := w.spaces[key]; ok && old.SchemaVersion >= space.SchemaVersion {
+		w.lock.Unlock()
+		return
+	}
+	w.spaces[key] = space
+	w.lock.Unlock()
+
+	w.server.partitions.Range(func(key, value interface{}) bool {
+		p := value.(PartitionStore)
+		meta := p.GetMeta()
+		if meta.DB != space.DB || meta.Space != space.ID {
+			return true
+		}
+		if err := p.UpdateSchema(space.Schema, space.SchemaVersion); err != nil {
+			log.Error("update schema of partition[%d] to version %d error: %s", meta.ID, space.SchemaVersion, err)
+		} else {
+			log.Info("update schema of partition[%d] to version %d", meta.ID, space.SchemaVersion)
+		}
+		return true
+	})
+}
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/uuid"
+)
+
+const (
+	defaultScrollKeepAlive = time.Minute
+	maxScrollKeepAlive     = 24 * time.Hour
+)
+
+// scrollReapInterval is the interval of closing the expired scrolls
+var scrollReapInterval = 10 * time.Second
+
+// scrollCursor is a search paging through a pinned snapshot of a partition.
+type scrollCursor struct {
+	sync.Mutex
+	partitionID metapb.PartitionID
+	snapshot    engine.Snapshot
+	request     engine.SearchRequest
+	expire      time.Time
+	closed      bool
+}
+
+func (c *scrollCursor) close() {
+	c.Lock()
+	if !c.closed {
+		c.closed = true
+		c.snapshot.Close()
+	}
+	c.Unlock()
+}
+
+// nextPage searches the hits after the last page, later writes to the partition are not visible.
+func (c *scrollCursor) nextPage(ctx context.Context) (*engine.SearchResult, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.closed {
+		return nil, fmt.Errorf("scroll cursor of partition %d is closed", c.partitionID)
+	}
+	result, err := c.snapshot.Search(ctx, &c.request)
+	if err != nil {
+		return nil, err
+	}
+	if n := len(result.Hits.Hits); n > 0 {
+		after, err := json.Marshal(result.Hits.Hits[n-1].Sort)
+		if err != nil {
+			return nil, err
+		}
+		c.request.SetSearchAfter(after)
+	}
+	return result, nil
+}
+
+// scrollManager keeps the scroll cursors of the server, expired cursors are closed by reap.
+type scrollManager struct {
+	sync.Mutex
+	cursors map[string]*scrollCursor
+}
+
+func newScrollManager() *scrollManager {
+	return &scrollManager{cursors: make(map[string]*scrollCursor)}
+}
+
+func scrollKeepAlive(keepAlive time.Duration) (time.Duration, error) {
+	if keepAlive <= 0 {
+		return defaultScrollKeepAlive, nil
+	}
+	if keepAlive > maxScrollKeepAlive {
+		return 0, fmt.Errorf("scroll keep alive %s exceeds the limit %s", keepAlive, maxScrollKeepAlive)
+	}
+	return keepAlive, nil
+}
+
+// open pins a snapshot of the partition and returns the first page along with the scroll id.
+func (m *scrollManager) open(ctx context.Context, store PartitionStore, req *engine.SearchRequest, keepAlive time.Duration) (*engine.SearchResult, error) {
+	keepAlive, err := scrollKeepAlive(keepAlive)
+	if err != nil {
+		return nil, err
+	}
+	cursor := &scrollCursor{partitionID: store.GetMeta().ID, request: *req}
+	cursor.request.SetFrom(0)
+	if err = cursor.request.AddSortTieBreaker(); err != nil {
+		return nil, err
+	}
+	if cursor.snapshot, err = store.NewSearchSnapshot(); err != nil {
+		return nil, err
+	}
+
+	result, err := cursor.nextPage(ctx)
+	if err != nil {
+		cursor.close()
+		return nil, err
+	}
+	result.ScrollID = uuid.FlakeUUID()
+	cursor.expire = time.Now().Add(keepAlive)
+
+	m.Lock()
+	m.cursors[result.ScrollID] = cursor
+	m.Unlock()
+	return result, nil
+}
+
+// next returns the next page of the scroll and extends its keep alive.
+func (m *scrollManager) next(ctx context.Context, scrollID string, keepAlive time.Duration) (*engine.SearchResult, error) {
+	keepAlive, err := scrollKeepAlive(keepAlive)
+	if err != nil {
+		return nil, err
+	}
+	m.Lock()
+	cursor, ok := m.cursors[scrollID]
+	if ok {
+		cursor.expire = time.Now().Add(keepAlive)
+	}
+	m.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("scroll %s not found or expired", scrollID)
+	}
+
+	result, err := cursor.nextPage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.ScrollID = scrollID
+	return result, nil
+}
+
+// clear closes the scrolls and returns the number of scrolls found.
+func (m *scrollManager) clear(scrollIDs ...string) int {
+	var cursors []*scrollCursor
+	m.Lock()
+	for _, id := range scrollIDs {
+		if cursor, ok := m.cursors[id]; ok {
+			delete(m.cursors, id)
+			cursors = append(cursors, cursor)
+		}
+	}
+	m.Unlock()
+
+	for _, cursor := range cursors {
+		cursor.close()
+	}
+	return len(cursors)
+}
+
+// clearPartition closes the scrolls of a partition before the partition is closed.
+func (m *scrollManager) clearPartition(partitionID metapb.PartitionID) {
+	m.removeIf(func(cursor *scrollCursor) bool {
+		return cursor.partitionID == partitionID
+	})
+}
+
+// reap closes the scrolls which are not accessed within their keep alive.
+func (m *scrollManager) reap(now time.Time) int {
+	return m.removeIf(func(cursor *scrollCursor) bool {
+		return now.After(cursor.expire)
+	})
+}
+
+func (m *scrollManager) closeAll() {
+	m.removeIf(func(*scrollCursor) bool {
+		return true
+	})
+}
+
+func (m *scrollManager) removeIf(cond func(cursor *scrollCursor) bool) int {
+	var cursors []*scrollCursor
+	m.Lock()
+	for id, cursor := range m.cursors {
+		if cond(cursor) {
+			delete(m.cursors, id)
+			cursors = append(cursors, cursor)
+		}
+	}
+	m.Unlock()
+
+	for _, cursor := range cursors {
+		cursor.close()
+	}
+	return len(cursors)
+}
+
+// scrollReaper closes expired scrolls periodically until the server stops.
+func (s *Server) scrollReaper() {
+	ticker := time.NewTicker(scrollReapInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+
+		case now := <-ticker.C:
+			if n := s.scrolls.reap(now); n > 0 {
+				log.Info("Server reaped %d expired scrolls", n)
+			}
+		}
+	}
+}
+
+// OpenScroll starts a scroll search on the partition, the snapshot of the partition is kept for keepAlive.
+func (s *Server) OpenScroll(partitionID metapb.PartitionID, req *engine.SearchRequest, keepAlive time.Duration) (*engine.SearchResult, error) {
+	store, err := s.getPartitionStore(partitionID)
+	if err != nil {
+		return nil, err
+	}
+	return s.scrolls.open(s.ctx, store, req, keepAlive)
+}
+
+// NextScroll returns the next page of a scroll search.
+func (s *Server) NextScroll(scrollID string, keepAlive time.Duration) (*engine.SearchResult, error) {
+	return s.scrolls.next(s.ctx, scrollID, keepAlive)
+}
+
+// ReleaseScrolls releases the snapshots of scroll searches, it returns the number of scrolls released.
+func (s *Server) ReleaseScrolls(scrollIDs ...string) int {
+	return s.scrolls.clear(scrollIDs...)
+}
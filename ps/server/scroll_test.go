@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/engine/bleve"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+// snapshotStore is a partition store of the search snapshots of an in-memory engine
+type snapshotStore struct {
+	PartitionStore
+	engine engine.Engine
+	opened int
+	closed int
+}
+
+type countedSnapshot struct {
+	engine.Snapshot
+	store *snapshotStore
+}
+
+func (s *countedSnapshot) Close() error {
+	s.store.closed++
+	return s.Snapshot.Close()
+}
+
+func (s *snapshotStore) GetMeta() metapb.Partition {
+	return metapb.Partition{ID: 1}
+}
+
+func (s *snapshotStore) NewSearchSnapshot() (engine.Snapshot, error) {
+	snap, err := s.engine.NewSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	s.opened++
+	return &countedSnapshot{Snapshot: snap, store: s}, nil
+}
+
+func newSnapshotStore(t *testing.T, docs int) *snapshotStore {
+	e, err := bleve.New(engine.EngineConfig{Schema: `{"mappings": {"doc": {"properties": {"n": {"type": "long"}}}}}`, ExtraOptions: `{"store": "memory"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < docs; i++ {
+		if err = e.AddDocument(context.Background(), engine.DOC_ID(fmt.Sprintf("doc%d", i)), map[string]interface{}{"n": i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &snapshotStore{engine: e}
+}
+
+func scrollRequest(size int) *engine.SearchRequest {
+	req := engine.NewSearchQuery("", "")
+	req.SetQuery([]byte(`{"match_all": {}}`))
+	req.SetSize(size)
+	return req
+}
+
+func TestScrollKeepAlive(t *testing.T) {
+	store := newSnapshotStore(t, 3)
+	defer store.engine.Close()
+	m := newScrollManager()
+	ctx := context.Background()
+
+	result, err := m.open(ctx, store, scrollRequest(1), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ScrollID == "" || len(result.Hits.Hits) != 1 {
+		t.Fatalf("bad first page %+v", result)
+	}
+	ids := []string{result.Hits.Hits[0].Id}
+
+	// a scroll accessed within its keep alive is kept, and its keep alive is extended by the access
+	if n := m.reap(time.Now().Add(30 * time.Second)); n != 0 {
+		t.Fatalf("%d scrolls are reaped within the keep alive", n)
+	}
+	if result, err = m.next(ctx, result.ScrollID, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	ids = append(ids, result.Hits.Hits[0].Id)
+	if n := m.reap(time.Now().Add(90 * time.Second)); n != 0 {
+		t.Fatalf("%d scrolls are reaped within the extended keep alive", n)
+	}
+	if ids[0] == ids[1] {
+		t.Fatalf("the pages repeat the hit %s", ids[0])
+	}
+
+	if n := m.reap(time.Now().Add(3 * time.Minute)); n != 1 {
+		t.Fatalf("%d scrolls are reaped after the keep alive, want 1", n)
+	}
+	if store.closed != store.opened {
+		t.Fatalf("%d of the %d snapshots are closed", store.closed, store.opened)
+	}
+	if _, err = m.next(ctx, result.ScrollID, 0); err == nil {
+		t.Fatal("expect the error of the expired scroll")
+	}
+
+	if _, err = m.open(ctx, store, scrollRequest(1), maxScrollKeepAlive+time.Second); err == nil {
+		t.Fatal("expect the error of the keep alive over the limit")
+	}
+}
+
+func TestClearScroll(t *testing.T) {
+	store := newSnapshotStore(t, 3)
+	defer store.engine.Close()
+	m := newScrollManager()
+	ctx := context.Background()
+
+	var scrollIDs []string
+	for i := 0; i < 2; i++ {
+		result, err := m.open(ctx, store, scrollRequest(1), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scrollIDs = append(scrollIDs, result.ScrollID)
+	}
+	if n := m.clear(scrollIDs[0], "missing"); n != 1 {
+		t.Fatalf("%d scrolls are cleared, want 1", n)
+	}
+	if n := m.clear(scrollIDs[0]); n != 0 {
+		t.Fatalf("the cleared scroll is cleared again")
+	}
+	if _, err := m.next(ctx, scrollIDs[0], 0); err == nil {
+		t.Fatal("expect the error of the cleared scroll")
+	}
+	if _, err := m.next(ctx, scrollIDs[1], 0); err != nil {
+		t.Fatal(err)
+	}
+	if store.closed != 1 {
+		t.Fatalf("%d snapshots are closed, want 1", store.closed)
+	}
+
+	m.closeAll()
+	if store.closed != store.opened {
+		t.Fatalf("%d of the %d snapshots are closed", store.closed, store.opened)
+	}
+}
+
+func TestScrollReaper(t *testing.T) {
+	interval := scrollReapInterval
+	scrollReapInterval = 10 * time.Millisecond
+	defer func() { scrollReapInterval = interval }()
+
+	store := newSnapshotStore(t, 1)
+	defer store.engine.Close()
+	s := &Server{scrolls: newScrollManager()}
+	s.ctx, s.ctxCancel = context.WithCancel(context.Background())
+	defer s.ctxCancel()
+	go s.scrollReaper()
+
+	if _, err := s.scrolls.open(s.ctx, store, scrollRequest(1), 20*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.scrolls.Lock()
+		n := len(s.scrolls.cursors)
+		s.scrolls.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the expired scroll is not reaped")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if store.closed != 1 {
+		t.Fatalf("%d snapshots are closed, want 1", store.closed)
+	}
+}
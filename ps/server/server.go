@@ -3,7 +3,9 @@ package server
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"path/filepath"
 	"sort"
 	"sync"
 	"time"
@@ -12,6 +14,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	kconfig "github.com/tiglabs/baudengine/engine/kernel/config"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/proto/pspb"
@@ -48,11 +51,14 @@ type Server struct {
 
 	connMgr         *rpc.ConnectionMgr
 	adminServer     *grpc.Server
+	apiServer       *grpc.Server
 	masterClient    *rpc.Client
 	masterHeartbeat *heartbeatWork
 
 	systemMetric *metric.SystemMetric
 	partitions   sync.Map
+	scrolls      *scrollManager
+	schemas      *schemaWatcher
 	adminEventCh chan proto.Message
 
 	stopping atomic.AtomicBool
@@ -66,6 +72,7 @@ func NewServer(conf *Config) *Server {
 		meta:         newServerMeta(conf.StorePath),
 		raftResolver: NewRaftResolver(),
 		systemMetric: metric.NewSystemMetric(conf.StorePath, conf.DiskQuota),
+		scrolls:      newScrollManager(),
 		adminEventCh: make(chan proto.Message, 64),
 	}
 	s.ctx, s.ctxCancel = context.WithCancel(context.Background())
@@ -73,6 +80,7 @@ func NewServer(conf *Config) *Server {
 	serverOpt := rpc.DefaultServerOption
 	serverOpt.ClusterID = conf.ClusterID
 	s.adminServer = rpc.NewGrpcServer(&serverOpt)
+	s.apiServer = rpc.NewGrpcServer(&serverOpt)
 
 	connMgrOpt := rpc.DefaultManagerOption
 	s.connMgr = rpc.NewConnectionMgr(s.ctx, &connMgrOpt)
@@ -155,6 +163,24 @@ func (s *Server) doStart(init bool) error {
 		s.raftConfig = rc
 	}
 
+	if init && s.AnalysisDictDir != "" {
+		if err := loadAnalysisDicts(s.AnalysisDictDir); err != nil {
+			return fmt.Errorf("load analysis dicts failed, error: %s", err)
+		}
+	}
+
+	// load the schemas of the spaces before the partitions are created
+	if init && s.TopoEndPoints != "" {
+		schemas, err := newSchemaWatcher(s)
+		if err != nil {
+			return fmt.Errorf("open topo server failed, error: %s", err)
+		}
+		if err = schemas.load(); err != nil {
+			return fmt.Errorf("load space schemas failed, error: %s", err)
+		}
+		s.schemas = schemas
+	}
+
 	// clear old partition
 	if len(initPartitions) == 0 {
 		s.reset()
@@ -178,8 +204,24 @@ func (s *Server) doStart(init bool) error {
 			}()
 			log.Info("Server admin grpc listen on: %s", fmt.Sprintf(":%d", s.AdminPort))
 		}
+		if ln, err := net.Listen("tcp", fmt.Sprintf(":%d", s.RPCPort)); err != nil {
+			return fmt.Errorf("Server failed to listen api port: %s", err)
+		} else {
+			pspb.RegisterApiGrpcServer(s.apiServer, s)
+			reflection.Register(s.apiServer)
+			go func() {
+				if err = s.apiServer.Serve(ln); err != nil {
+					log.Fatal("Server failed to start api grpc: %s", err)
+				}
+			}()
+			log.Info("Server api grpc listen on: %s", fmt.Sprintf(":%d", s.RPCPort))
+		}
 
 		routine.RunWorkDaemon("ADMIN-EVENTHANDLER", s.adminEventHandler, s.ctx.Done())
+		routine.RunWorkDaemon("SCROLL-REAPER", s.scrollReaper, s.ctx.Done())
+		if s.schemas != nil {
+			routine.RunWorkDaemon("SCHEMA-WATCHER", s.schemas.watch, s.ctx.Done())
+		}
 	}
 
 	// start heartbeat to master
@@ -204,8 +246,12 @@ func (s *Server) Close() error {
 	if s.adminServer != nil {
 		s.adminServer.GracefulStop()
 	}
+	if s.apiServer != nil {
+		s.apiServer.GracefulStop()
+	}
 
 	routine.Stop()
+	s.scrolls.closeAll()
 	s.closeAllRange()
 
 	if s.raftServer != nil {
@@ -336,3 +382,17 @@ func (s *Server) restart() {
 		panic(fmt.Errorf("restart error: %s", err))
 	}
 }
+
+// loadAnalysisDicts sets the paths of the word dicts in the directory, the kernel analyzers find their dicts by name.
+func loadAnalysisDicts(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if !f.IsDir() {
+			kconfig.SetWordDictPath(f.Name(), filepath.Join(dir, f.Name()))
+		}
+	}
+	return nil
+}
@@ -140,6 +140,7 @@ func (s *Server) doPartitionCreate(p metapb.Partition) {
 func (s *Server) doPartitionDelete(id metapb.PartitionID) {
 	if p, ok := s.partitions.Load(id); ok {
 		s.partitions.Delete(id)
+		s.scrolls.clearPartition(id)
 		p.(PartitionStore).Close()
 
 		for _, r := range p.(PartitionStore).GetMeta().Replicas {
@@ -196,6 +197,7 @@ func (s *Server) doAdminEvent(event proto.Message) {
 				return
 			}
 
+			s.scrolls.clearPartition(e.Partition.ID)
 			p.(PartitionStore).Close()
 			s.partitions.Delete(e.Partition.ID)
 		}
@@ -0,0 +1,338 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+var errServerStopping = errors.New("server is stopping")
+
+// Analyze api grpc service for analyzing the text by the schema of a partition
+func (s *Server) Analyze(ctx context.Context, request *pspb.AnalyzeRequest) (*pspb.AnalyzeResponse, error) {
+	response := &pspb.AnalyzeResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	store, err := s.getPartitionStore(request.PartitionID)
+	if err != nil {
+		setResponseError(&response.ResponseHeader, err)
+		return response, nil
+	}
+	req := &engine.AnalyzeRequest{
+		Text:      request.Text,
+		Analyzer:  request.Analyzer,
+		Field:     request.Field,
+		Tokenizer: request.Tokenizer,
+	}
+	for _, filter := range request.Filter {
+		req.Filters = append(req.Filters, json.RawMessage(filter))
+	}
+	for _, filter := range request.CharFilter {
+		req.CharFilters = append(req.CharFilters, json.RawMessage(filter))
+	}
+
+	result, err := store.Analyze(req)
+	if err != nil {
+		log.Error("analyze on partition[%d] error: %s", request.PartitionID, err)
+		setResponseError(&response.ResponseHeader, err)
+		return response, nil
+	}
+	response.Tokens = make([]pspb.AnalyzeToken, 0, len(result.Tokens))
+	for _, token := range result.Tokens {
+		response.Tokens = append(response.Tokens, pspb.AnalyzeToken{
+			Token:       token.Token,
+			StartOffset: int32(token.StartOffset),
+			EndOffset:   int32(token.EndOffset),
+			Type:        token.Type,
+			Position:    int32(token.Position),
+		})
+	}
+	return response, nil
+}
+
+// Get api grpc service for reading a document by id
+func (s *Server) Get(ctx context.Context, request *pspb.GetRequest) (*pspb.GetResponse, error) {
+	response := &pspb.GetResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	store, err := s.getPartitionStore(request.PartitionID)
+	if err == nil {
+		response.Doc, err = getDocument(store, request.ID, request.Source, request.StoredFields, request.Timeout)
+	}
+	if err != nil {
+		log.Error("get document on partition[%d] error: %s", request.PartitionID, err)
+		setResponseError(&response.ResponseHeader, err)
+	}
+	return response, nil
+}
+
+// MultiGet api grpc service for reading the documents of the ids, the missing documents are not found in the results
+func (s *Server) MultiGet(ctx context.Context, request *pspb.MultiGetRequest) (*pspb.MultiGetResponse, error) {
+	response := &pspb.MultiGetResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	store, err := s.getPartitionStore(request.PartitionID)
+	if err != nil {
+		setResponseError(&response.ResponseHeader, err)
+		return response, nil
+	}
+	response.Docs = make([]pspb.GetResult, 0, len(request.IDs))
+	for _, id := range request.IDs {
+		doc, err := getDocument(store, id, request.Source, request.StoredFields, request.Timeout)
+		if err != nil {
+			log.Error("multi get documents on partition[%d] error: %s", request.PartitionID, err)
+			response.Docs = nil
+			setResponseError(&response.ResponseHeader, err)
+			return response, nil
+		}
+		response.Docs = append(response.Docs, doc)
+	}
+	return response, nil
+}
+
+func getDocument(store PartitionStore, id string, source []byte, storedFields []string, timeout string) (pspb.GetResult, error) {
+	result := pspb.GetResult{ID: id}
+	req := &engine.GetRequest{StoredFields: storedFields}
+	if len(source) > 0 {
+		fetch, err := engine.ParseFetchSource(source)
+		if err != nil {
+			return result, err
+		}
+		req.Source = fetch
+	}
+
+	doc, found, err := store.Get(engine.DOC_ID(id), req, timeout)
+	if err != nil || !found {
+		return result, err
+	}
+	result.Found = true
+	result.Source = doc.Source
+	result.Version, result.SeqNo, result.PrimaryTerm = doc.Version, doc.SeqNo, doc.PrimaryTerm
+	if len(doc.Fields) > 0 {
+		if result.Fields, err = json.Marshal(doc.Fields); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// Bulk api grpc service for writing the documents of a partition in a raft proposal
+func (s *Server) Bulk(ctx context.Context, request *pspb.BulkRequest) (*pspb.BulkResponse, error) {
+	response := &pspb.BulkResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	store, err := s.getPartitionStore(request.PartitionID)
+	if err == nil {
+		response.Responses, err = store.Bulk(request.Requests, request.Timeout)
+	}
+	if err != nil {
+		log.Error("bulk on partition[%d] error: %s", request.PartitionID, err)
+		setResponseError(&response.ResponseHeader, err)
+	}
+	return response, nil
+}
+
+// DeleteByQuery api grpc service for deleting the documents of a partition matching the query by raft batches
+func (s *Server) DeleteByQuery(ctx context.Context, request *pspb.DeleteByQueryRequest) (*pspb.ByQueryResponse, error) {
+	store, err := s.getPartitionStore(request.PartitionID)
+	var response *pspb.ByQueryResponse
+	if err == nil {
+		response, err = store.DeleteByQuery(request, request.Timeout)
+	}
+	return byQueryResponse(request.PartitionID, request.ReqId, response, err), nil
+}
+
+// UpdateByQuery api grpc service for updating the documents of a partition matching the query by raft batches
+func (s *Server) UpdateByQuery(ctx context.Context, request *pspb.UpdateByQueryRequest) (*pspb.ByQueryResponse, error) {
+	store, err := s.getPartitionStore(request.PartitionID)
+	var response *pspb.ByQueryResponse
+	if err == nil {
+		response, err = store.UpdateByQuery(request, request.Timeout)
+	}
+	return byQueryResponse(request.PartitionID, request.ReqId, response, err), nil
+}
+
+func byQueryResponse(partitionID metapb.PartitionID, reqId string, response *pspb.ByQueryResponse, err error) *pspb.ByQueryResponse {
+	if err != nil {
+		log.Error("by query on partition[%d] error: %s", partitionID, err)
+		response = &pspb.ByQueryResponse{}
+		setResponseError(&response.ResponseHeader, err)
+	} else {
+		response.Code = metapb.RESP_CODE_OK
+	}
+	response.ReqId = reqId
+	return response
+}
+
+// Count api grpc service for the number of the documents matching the query
+func (s *Server) Count(ctx context.Context, request *pspb.CountRequest) (*pspb.CountResponse, error) {
+	response := &pspb.CountResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	store, err := s.getPartitionStore(request.PartitionID)
+	if err != nil {
+		setResponseError(&response.ResponseHeader, err)
+		return response, nil
+	}
+	req := &engine.SearchRequest{Query: request.Query}
+	if len(req.Query) == 0 {
+		req.Query = []byte(`{"match_all": {}}`)
+	}
+	result, err := store.Search(req, request.Timeout)
+	if err != nil {
+		log.Error("count on partition[%d] error: %s", request.PartitionID, err)
+		setResponseError(&response.ResponseHeader, err)
+		return response, nil
+	}
+	response.Count = result.Hits.Total
+	return response, nil
+}
+
+// Search api grpc service for the query or fetch phase of a search on a partition, the request and result are json
+func (s *Server) Search(ctx context.Context, request *pspb.SearchRequest) (*pspb.SearchResponse, error) {
+	response := &pspb.SearchResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	store, err := s.getPartitionStore(request.PartitionID)
+	if err != nil {
+		setResponseError(&response.ResponseHeader, err)
+		return response, nil
+	}
+	req := &engine.SearchRequest{}
+	if err = json.Unmarshal(request.Request, req); err != nil {
+		response.Code = metapb.RESP_CODE_SERVER_ERROR
+		response.Message = fmt.Sprintf("invalid search request: %v", err)
+		return response, nil
+	}
+
+	var result *engine.SearchResult
+	if request.Scroll != "" {
+		// the scroll keeps a snapshot of the partition, its pages are read by the Scroll api of this server
+		var keepAlive time.Duration
+		if keepAlive, err = time.ParseDuration(request.Scroll); err != nil {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = fmt.Sprintf("invalid scroll keep alive: %v", err)
+			return response, nil
+		}
+		result, err = s.OpenScroll(request.PartitionID, req, keepAlive)
+	} else {
+		result, err = store.Search(req, request.Timeout)
+	}
+	if err != nil {
+		log.Error("search on partition[%d] error: %s", request.PartitionID, err)
+		setResponseError(&response.ResponseHeader, err)
+		return response, nil
+	}
+	if response.Result, err = json.Marshal(result); err != nil {
+		setResponseError(&response.ResponseHeader, err)
+	}
+	return response, nil
+}
+
+// Scroll api grpc service for the next page of a scroll opened by Search, the result is json
+func (s *Server) Scroll(ctx context.Context, request *pspb.ScrollRequest) (*pspb.SearchResponse, error) {
+	response := &pspb.SearchResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	var keepAlive time.Duration
+	var err error
+	if request.Scroll != "" {
+		if keepAlive, err = time.ParseDuration(request.Scroll); err != nil {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = fmt.Sprintf("invalid scroll keep alive: %v", err)
+			return response, nil
+		}
+	}
+	result, err := s.NextScroll(request.ScrollID, keepAlive)
+	if err != nil {
+		log.Error("scroll %s error: %s", request.ScrollID, err)
+		setResponseError(&response.ResponseHeader, err)
+		return response, nil
+	}
+	if response.Result, err = json.Marshal(result); err != nil {
+		setResponseError(&response.ResponseHeader, err)
+	}
+	return response, nil
+}
+
+// ClearScroll api grpc service for releasing the scrolls of the server
+func (s *Server) ClearScroll(ctx context.Context, request *pspb.ClearScrollRequest) (*pspb.ClearScrollResponse, error) {
+	response := &pspb.ClearScrollResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+	response.NumFreed = uint32(s.ReleaseScrolls(request.ScrollIDs...))
+	return response, nil
+}
+
+func (s *Server) getPartitionStore(partitionID metapb.PartitionID) (PartitionStore, error) {
+	if s.stopping.Get() {
+		return nil, errServerStopping
+	}
+	p, ok := s.partitions.Load(partitionID)
+	if !ok {
+		return nil, &metapb.PartitionNotFound{PartitionID: partitionID}
+	}
+	return p.(PartitionStore), nil
+}
+
+// setResponseError sets the code of the error in the header, the routing errors are kept in the header for the clients.
+func setResponseError(header *metapb.ResponseHeader, err error) {
+	header.Message = err.Error()
+	switch e := err.(type) {
+	case *metapb.NotLeader:
+		header.Code = metapb.PS_RESP_CODE_NOT_LEADER
+		header.Error.NotLeader = e
+	case *metapb.NoLeader:
+		header.Code = metapb.PS_RESP_CODE_NO_LEADER
+		header.Error.NoLeader = e
+	case *metapb.PartitionNotFound:
+		header.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		header.Error.PartitionNotFound = e
+	case *metapb.TimeoutError:
+		header.Code = metapb.RESP_CODE_TIMEOUT
+	default:
+		if err == errServerStopping {
+			header.Code = metapb.RESP_CODE_SERVER_STOP
+		} else {
+			header.Code = metapb.RESP_CODE_SERVER_ERROR
+		}
+	}
+}
@@ -2,6 +2,7 @@ package raftstore
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/tiglabs/baudengine/engine"
@@ -57,7 +58,10 @@ func CreateStore(ctx context.Context, conf *StoreConfig) *Store {
 
 // Start start the store.
 func (s *Store) Start() {
-	engine, err := engine.Build(s.EngineName, s.EngineConf)
+	s.RLock()
+	engineConf := s.EngineConf
+	s.RUnlock()
+	engine, err := engine.Build(s.EngineName, engineConf)
 	if err != nil {
 		s.Lock()
 		s.Meta.Status = metapb.PA_INVALID
@@ -65,7 +69,16 @@ func (s *Store) Start() {
 		log.Error("start partition[%d] open store engine error: %s", s.Meta.ID, err)
 		return
 	}
+	s.Lock()
 	s.Engine = engine
+	updated := s.EngineConf
+	s.Unlock()
+	if updated.SchemaVersion > engineConf.SchemaVersion {
+		// the schema is updated while the engine is opening
+		if err := s.updateEngineSchema(engine, updated.Schema, updated.SchemaVersion); err != nil {
+			log.Error("start partition[%d] update schema error: %s", s.Meta.ID, err)
+		}
+	}
 	apply, err := s.Engine.GetApplyID()
 	if err != nil {
 		s.Lock()
@@ -134,6 +147,32 @@ func (s *Store) Close() error {
 	return nil
 }
 
+// UpdateSchema changes the schema of the engine without reopening it, a store not started yet opens the engine with the new schema.
+func (s *Store) UpdateSchema(schema string, version uint64) error {
+	s.Lock()
+	if version <= s.EngineConf.SchemaVersion {
+		s.Unlock()
+		return nil
+	}
+	s.EngineConf.Schema = schema
+	s.EngineConf.SchemaVersion = version
+	e := s.Engine
+	s.Unlock()
+
+	if e == nil {
+		return nil
+	}
+	return s.updateEngineSchema(e, schema, version)
+}
+
+func (s *Store) updateEngineSchema(e engine.Engine, schema string, version uint64) error {
+	updater, ok := e.(engine.SchemaUpdater)
+	if !ok {
+		return fmt.Errorf("engine %s can not update schema", s.EngineName)
+	}
+	return updater.UpdateSchema(schema, version)
+}
+
 // GetStats returns statistics for store
 func (s *Store) GetStats() *masterpb.PartitionInfo {
 	s.RLock()
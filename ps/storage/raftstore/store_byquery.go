@@ -0,0 +1,190 @@
+package raftstore
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/ps/storage"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+const defaultByQueryBatchSize = 1000
+
+// byQuery describes a delete or update by query, newCommand turns a matched document into a raft write command.
+type byQuery struct {
+	query       []byte
+	batchSize   int
+	maxDocs     int64
+	proceed     bool
+	fetchSource bool
+	newCommand  func(hit *engine.HitDoc) pspb.RequestUnion
+}
+
+// DeleteByQuery deletes the documents matching the query.
+// The matched ids are resolved on the leader from a snapshot, and deleted by raft replicated batches.
+func (s *Store) DeleteByQuery(request *pspb.DeleteByQueryRequest, timeout string) (*pspb.ByQueryResponse, error) {
+	op := &byQuery{
+		query:     request.Query,
+		batchSize: int(request.BatchSize),
+		maxDocs:   request.MaxDocs,
+		proceed:   request.ProceedOnConflicts,
+		newCommand: func(hit *engine.HitDoc) pspb.RequestUnion {
+			return pspb.RequestUnion{
+				OpType: pspb.OpType_DELETE,
+				Delete: &pspb.DeleteRequest{ID: metapb.Key(hit.Id), IfSeqNo: hit.SeqNo, IfPrimaryTerm: hit.PrimaryTerm},
+			}
+		},
+	}
+	return s.execByQuery(op, timeout)
+}
+
+// UpdateByQuery merges the doc of the request into the documents matching the query,
+// or reindexes them without a doc, so that they pick up the changes of the mapping.
+// The matched documents are resolved on the leader from a snapshot, and updated by raft replicated batches.
+func (s *Store) UpdateByQuery(request *pspb.UpdateByQueryRequest, timeout string) (*pspb.ByQueryResponse, error) {
+	op := &byQuery{
+		query:       request.Query,
+		batchSize:   int(request.BatchSize),
+		maxDocs:     request.MaxDocs,
+		proceed:     request.ProceedOnConflicts,
+		fetchSource: len(request.Doc) == 0,
+		newCommand: func(hit *engine.HitDoc) pspb.RequestUnion {
+			update := &pspb.UpdateRequest{ID: metapb.Key(hit.Id), IfSeqNo: hit.SeqNo, IfPrimaryTerm: hit.PrimaryTerm}
+			if len(request.Doc) > 0 {
+				// the doc is merged at apply time, an unchanged document is a noop
+				update.Data, update.Merge = metapb.Value(request.Doc), true
+			} else {
+				source, ok := hit.Source.(json.RawMessage)
+				if !ok {
+					source, _ = json.Marshal(hit.Source)
+				}
+				update.Data = metapb.Value(source)
+			}
+			return pspb.RequestUnion{OpType: pspb.OpType_UPDATE, Update: update}
+		},
+	}
+	return s.execByQuery(op, timeout)
+}
+
+func (s *Store) execByQuery(op *byQuery, timeout string) (*pspb.ByQueryResponse, error) {
+	if err := s.checkReadable(true); err != nil {
+		log.Error("by query error: [%s]", err)
+		return nil, err
+	}
+	return s.runByQuery(op, func(commands []pspb.RequestUnion) ([]pspb.ResponseUnion, error) {
+		return s.Bulk(commands, timeout)
+	})
+}
+
+// runByQuery resolves the documents matching the query from a snapshot, and writes their commands by bulk batch by batch.
+func (s *Store) runByQuery(op *byQuery, bulk func(commands []pspb.RequestUnion) ([]pspb.ResponseUnion, error)) (*pspb.ByQueryResponse, error) {
+	snap, err := s.Engine.NewSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Close()
+
+	batchSize := op.batchSize
+	if batchSize <= 0 {
+		batchSize = defaultByQueryBatchSize
+	}
+	req := engine.NewSearchQuery("", "")
+	req.SetQuery(op.query)
+	req.SetSort([]byte(`["_id"]`))
+	req.SetSource(&engine.FetchSource{Fetch: op.fetchSource})
+	// the writes are conditional on the versions in the snapshot, so that concurrent changes are not overwritten
+	req.SetSeqNoPrimaryTerm(true)
+
+	start := time.Now()
+	resp := new(pspb.ByQueryResponse)
+	defer func() {
+		resp.Took = int64(time.Since(start) / time.Millisecond)
+	}()
+
+	for first := true; ; first = false {
+		if op.maxDocs > 0 {
+			remain := op.maxDocs - resp.Deleted - resp.Updated - resp.VersionConflicts - resp.Noops - int64(len(resp.Failures))
+			if remain <= 0 {
+				return resp, nil
+			}
+			if remain < int64(batchSize) {
+				batchSize = int(remain)
+			}
+		}
+		req.SetSize(batchSize)
+
+		result, err := snap.Search(s.Ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if first {
+			resp.Total = int64(result.Hits.Total)
+			if op.maxDocs > 0 && resp.Total > op.maxDocs {
+				resp.Total = op.maxDocs
+			}
+		}
+		hits := result.Hits.Hits
+		if len(hits) == 0 {
+			return resp, nil
+		}
+
+		commands := make([]pspb.RequestUnion, len(hits))
+		for i := range hits {
+			commands[i] = op.newCommand(&hits[i])
+		}
+		responses, err := bulk(commands)
+		resp.Batches++
+		if err != nil {
+			// the batch is not known to be applied, abort and report it like a bulk failure
+			if err == storage.ErrorTimeout {
+				resp.TimedOut = true
+			}
+			resp.Failures = append(resp.Failures, pspb.Failure{Cause: err.Error()})
+			return resp, nil
+		}
+		if conflicts := fillByQueryResponse(resp, responses); conflicts > 0 && !op.proceed {
+			return resp, nil
+		}
+
+		after, err := json.Marshal(hits[len(hits)-1].Sort)
+		if err != nil {
+			return nil, err
+		}
+		req.SetSearchAfter(after)
+	}
+}
+
+// fillByQueryResponse counts the results of a batch, and returns the number of version conflicts.
+// A document matched in the snapshot but changed or missing when the batch is applied was changed concurrently.
+func fillByQueryResponse(resp *pspb.ByQueryResponse, responses []pspb.ResponseUnion) (conflicts int64) {
+	for _, r := range responses {
+		var result pspb.WriteResult
+		switch {
+		case r.Failure != nil:
+			resp.Failures = append(resp.Failures, *r.Failure)
+			continue
+		case r.Delete != nil:
+			result = r.Delete.Result
+		case r.Update != nil:
+			result = r.Update.Result
+		default:
+			continue
+		}
+
+		switch result {
+		case pspb.WriteResult_DELETED:
+			resp.Deleted++
+		case pspb.WriteResult_UPDATED:
+			resp.Updated++
+		case pspb.WriteResult_NOOP:
+			resp.Noops++
+		case pspb.WriteResult_NOT_FOUND, pspb.WriteResult_VERSION_CONFLICT:
+			resp.VersionConflicts++
+			conflicts++
+		}
+	}
+	return
+}
@@ -0,0 +1,181 @@
+package raftstore
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/ps/storage"
+)
+
+// raftBulk applies the commands of the by query batches as the raft commands of the next indexes,
+// before is called with the commands of every batch before they are applied
+func raftBulk(s *Store, index *uint64, before func(commands []pspb.RequestUnion)) func([]pspb.RequestUnion) ([]pspb.ResponseUnion, error) {
+	return func(commands []pspb.RequestUnion) ([]pspb.ResponseUnion, error) {
+		if before != nil {
+			before(commands)
+		}
+		*index++
+		return s.execRaftCommand(*index, 1, commands)
+	}
+}
+
+func byQueryStore(t *testing.T, docs int) (*Store, *uint64) {
+	s := memStore(t)
+	var cmds []pspb.RequestUnion
+	for i := 0; i < docs; i++ {
+		cmds = append(cmds, pspb.RequestUnion{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{
+			ID: []byte(fmt.Sprintf("doc%d", i)), Data: []byte(fmt.Sprintf(`{"n": %d}`, i))}})
+	}
+	if _, err := s.execRaftCommand(1, 1, cmds); err != nil {
+		t.Fatal(err)
+	}
+	index := uint64(1)
+	return s, &index
+}
+
+func byQueryOp(query string, batchSize int, proceed bool, newCommand func(hit *engine.HitDoc) pspb.RequestUnion) *byQuery {
+	return &byQuery{query: []byte(query), batchSize: batchSize, proceed: proceed, newCommand: newCommand}
+}
+
+func deleteCommand(hit *engine.HitDoc) pspb.RequestUnion {
+	return pspb.RequestUnion{OpType: pspb.OpType_DELETE, Delete: &pspb.DeleteRequest{ID: []byte(hit.Id), IfSeqNo: hit.SeqNo, IfPrimaryTerm: hit.PrimaryTerm}}
+}
+
+func mergeCommand(doc string) func(hit *engine.HitDoc) pspb.RequestUnion {
+	return func(hit *engine.HitDoc) pspb.RequestUnion {
+		return pspb.RequestUnion{OpType: pspb.OpType_UPDATE, Update: &pspb.UpdateRequest{ID: []byte(hit.Id), Data: []byte(doc), Merge: true,
+			IfSeqNo: hit.SeqNo, IfPrimaryTerm: hit.PrimaryTerm}}
+	}
+}
+
+func countDocs(t *testing.T, s *Store, query string) int {
+	req := engine.NewSearchQuery("", "")
+	req.SetQuery([]byte(query))
+	result, err := s.Engine.Search(s.Ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return int(result.Hits.Total)
+}
+
+func TestDeleteByQuery(t *testing.T) {
+	s, index := byQueryStore(t, 5)
+	defer s.Engine.Close()
+
+	resp, err := s.runByQuery(byQueryOp(`{"range": {"n": {"gte": 2}}}`, 2, false, deleteCommand), raftBulk(s, index, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 3 || resp.Deleted != 3 || resp.Batches != 2 || resp.VersionConflicts != 0 || len(resp.Failures) != 0 {
+		t.Fatalf("bad response %+v", resp)
+	}
+	if n := countDocs(t, s, `{"match_all": {}}`); n != 2 {
+		t.Fatalf("%d documents are left, want 2", n)
+	}
+
+	// max_docs limits the documents deleted
+	resp, err = s.runByQuery(&byQuery{query: []byte(`{"match_all": {}}`), maxDocs: 1, newCommand: deleteCommand}, raftBulk(s, index, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 1 || resp.Deleted != 1 || resp.Batches != 1 {
+		t.Fatalf("bad response of max docs %+v", resp)
+	}
+}
+
+func TestUpdateByQuery(t *testing.T) {
+	s, index := byQueryStore(t, 5)
+	defer s.Engine.Close()
+
+	op := byQueryOp(`{"range": {"n": {"lt": 3}}}`, 0, false, mergeCommand(`{"tag": "red"}`))
+	resp, err := s.runByQuery(op, raftBulk(s, index, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 3 || resp.Updated != 3 || resp.Batches != 1 {
+		t.Fatalf("bad response %+v", resp)
+	}
+	if n := countDocs(t, s, `{"match": {"tag": "red"}}`); n != 3 {
+		t.Fatalf("%d documents are updated, want 3", n)
+	}
+	doc, _ := s.Engine.GetDocument(s.Ctx, engine.DOC_ID("doc0"), nil)
+	if string(doc.Source) != `{"n":0,"tag":"red"}` || doc.Version != 2 {
+		t.Fatalf("the merged document is %s of version %d", doc.Source, doc.Version)
+	}
+
+	// the documents already merged are noops
+	if resp, err = s.runByQuery(op, raftBulk(s, index, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Noops != 3 || resp.Updated != 0 {
+		t.Fatalf("bad response of the unchanged documents %+v", resp)
+	}
+}
+
+func TestByQueryConflicts(t *testing.T) {
+	for _, proceed := range []bool{false, true} {
+		s, index := byQueryStore(t, 4)
+		first := true
+		// doc0 is changed after the snapshot and before the first batch is applied
+		change := func([]pspb.RequestUnion) {
+			if first {
+				first = false
+				*index++
+				s.execRaftCommand(*index, 1, []pspb.RequestUnion{{OpType: pspb.OpType_UPDATE,
+					Update: &pspb.UpdateRequest{ID: []byte("doc0"), Data: []byte(`{"n": 10}`)}}})
+			}
+		}
+		resp, err := s.runByQuery(byQueryOp(`{"match_all": {}}`, 2, proceed, deleteCommand), raftBulk(s, index, change))
+		if err != nil {
+			t.Fatal(err)
+		}
+		deleted, batches := int64(1), int64(1)
+		if proceed {
+			deleted, batches = 3, 2
+		}
+		if resp.VersionConflicts != 1 || resp.Deleted != deleted || resp.Batches != batches {
+			t.Fatalf("bad response of proceed %v: %+v", proceed, resp)
+		}
+		// the concurrent change is kept
+		if doc, found := s.Engine.GetDocument(s.Ctx, engine.DOC_ID("doc0"), nil); !found || string(doc.Source) != `{"n": 10}` {
+			t.Fatalf("the concurrent change is overwritten")
+		}
+		s.Engine.Close()
+	}
+}
+
+func TestByQueryFailures(t *testing.T) {
+	s, index := byQueryStore(t, 4)
+	defer s.Engine.Close()
+
+	// the failures of the documents are reported and the run goes on
+	failFirst := func(commands []pspb.RequestUnion) ([]pspb.ResponseUnion, error) {
+		responses, err := raftBulk(s, index, nil)(commands)
+		if err == nil {
+			responses[0] = pspb.ResponseUnion{OpType: commands[0].OpType, Failure: &pspb.Failure{ID: commands[0].Delete.ID, Cause: "failed"}}
+		}
+		return responses, err
+	}
+	resp, err := s.runByQuery(byQueryOp(`{"match_all": {}}`, 2, false, deleteCommand), failFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Failures) != 2 || resp.Deleted != 2 || resp.Batches != 2 || resp.Failures[0].Cause != "failed" {
+		t.Fatalf("bad response of the failed documents %+v", resp)
+	}
+
+	// a batch timed out is not known to be applied, the run is aborted
+	s, _ = byQueryStore(t, 4)
+	defer s.Engine.Close()
+	timeout := func([]pspb.RequestUnion) ([]pspb.ResponseUnion, error) {
+		return nil, storage.ErrorTimeout
+	}
+	if resp, err = s.runByQuery(byQueryOp(`{"match_all": {}}`, 1, true, deleteCommand), timeout); err != nil {
+		t.Fatal(err)
+	}
+	if !resp.TimedOut || len(resp.Failures) != 1 || resp.Batches != 1 {
+		t.Fatalf("bad response of the timed out batch %+v", resp)
+	}
+}
@@ -18,7 +18,7 @@ func (s *Store) Apply(command []byte, index uint64) (resp interface{}, err error
 
 	switch raftCmd.Type {
 	case raftpb.CmdType_WRITE:
-		resp, err = s.execRaftCommand(index, raftCmd.WriteCommands)
+		resp, err = s.execRaftCommand(index, raftCmd.Term, raftCmd.WriteCommands)
 
 	default:
 		s.Engine.SetApplyID(index)
@@ -73,14 +73,94 @@ ret:
 
 // Snapshot implements the raft interface.
 func (s *Store) Snapshot() (proto.Snapshot, error) {
-	return nil, nil
+	snap, err := s.Engine.NewSnapshot()
+	if err != nil {
+		log.Error("partition[%d] create engine snapshot error: %s", s.Meta.ID, err)
+		return nil, err
+	}
+	applyID, err := snap.GetApplyID()
+	if err != nil {
+		snap.Close()
+		log.Error("partition[%d] get snapshot apply index error: %s", s.Meta.ID, err)
+		return nil, err
+	}
+
+	log.Info("partition[%d] create raft snapshot at apply index %d", s.Meta.ID, applyID)
+	return newRaftSnapshot(snap, applyID), nil
 }
 
 // ApplySnapshot implements the raft interface.
 func (s *Store) ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator) error {
+	s.Lock()
+	status := s.Meta.Status
+	s.Meta.Status = metapb.PA_NOTREAD
+	s.Unlock()
+	log.Info("partition[%d] begin apply raft snapshot", s.Meta.ID)
+
+	snapIter := newSnapshotIterator(iter)
+	err := s.Engine.ApplySnapshot(s.Ctx, snapIter)
+	if err == nil {
+		err = snapIter.Error()
+	}
+	if err != nil {
+		s.Lock()
+		s.Meta.Status = metapb.PA_INVALID
+		s.Unlock()
+		log.Error("partition[%d] apply raft snapshot error: %s", s.Meta.ID, err)
+		return err
+	}
+
+	applyID, err := s.Engine.GetApplyID()
+	if err != nil {
+		s.Lock()
+		s.Meta.Status = metapb.PA_INVALID
+		s.Unlock()
+		log.Error("partition[%d] get apply index after snapshot error: %s", s.Meta.ID, err)
+		return err
+	}
+
+	s.Lock()
+	s.Meta.Status = status
+	s.applyPeers(peers)
+	s.Unlock()
+	log.Info("partition[%d] apply raft snapshot success, apply index is %d", s.Meta.ID, applyID)
 	return nil
 }
 
+// applyPeers replaces the replicas by the raft members of a snapshot, the member changes of the raft log
+// covered by the snapshot are not applied by ApplyMemberChange. The replicas known already are kept,
+// the peers carry no address, so the address of a new replica is resolved when its node is reported by the master.
+// The conf version is bumped once a change, like the member changes do. The caller holds the lock.
+func (s *Store) applyPeers(peers []proto.Peer) {
+	if peers == nil {
+		return
+	}
+	replicas := make([]metapb.Replica, 0, len(peers))
+	members := make(map[metapb.NodeID]bool, len(peers))
+	for _, peer := range peers {
+		nodeID := metapb.NodeID(peer.ID)
+		members[nodeID] = true
+		known := false
+		for _, r := range s.Meta.Replicas {
+			if r.NodeID == nodeID {
+				replicas, known = append(replicas, r), true
+				break
+			}
+		}
+		if !known {
+			replicas = append(replicas, metapb.Replica{ID: metapb.ReplicaID(peer.PeerID), NodeID: nodeID})
+			s.Meta.Epoch.ConfVersion++
+		}
+	}
+	for i := range s.Meta.Replicas {
+		if replica := &s.Meta.Replicas[i]; !members[replica.NodeID] {
+			s.EventListener.HandleRaftReplicaEvent(&RaftReplicaEvent{Delete: true, Replica: replica})
+			s.Meta.Epoch.ConfVersion++
+		}
+	}
+	s.Meta.Replicas = replicas
+}
+
 // HandleLeaderChange implements the raft interface.
 func (s *Store) HandleLeaderChange(leader uint64) {
 	s.Lock()
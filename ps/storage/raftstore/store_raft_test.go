@@ -0,0 +1,40 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/proto/pspb/raftpb"
+)
+
+func TestApplyPrimaryTerm(t *testing.T) {
+	s := memStore(t)
+	defer s.Engine.Close()
+	// the epoch of the partition is not the primary term
+	s.Meta.Epoch = metapb.PartitionEpoch{Version: 9}
+
+	apply := func(index, term uint64, data string) {
+		cmd := &raftpb.RaftCommand{Type: raftpb.CmdType_WRITE, Term: term, WriteCommands: []pspb.RequestUnion{{OpType: pspb.OpType_UPDATE,
+			Update: &pspb.UpdateRequest{ID: metapb.Key("doc"), Data: metapb.Value(data), Upsert: true}}}}
+		command, err := cmd.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = s.Apply(command, index); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, entry := range []struct{ index, term uint64 }{{5, 3}, {6, 4}} {
+		apply(entry.index, entry.term, `{"n": 1}`)
+		doc, found := s.Engine.GetDocument(s.Ctx, engine.DOC_ID("doc"), nil)
+		if !found {
+			t.Fatal("the document is not written")
+		}
+		if doc.SeqNo != entry.index || doc.PrimaryTerm != entry.term {
+			t.Fatalf("the document is written with seq no %d and primary term %d, want %d and %d",
+				doc.SeqNo, doc.PrimaryTerm, entry.index, entry.term)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package raftstore
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/tiglabs/baudengine/engine"
@@ -10,8 +11,8 @@ import (
 	"github.com/tiglabs/baudengine/util/log"
 )
 
-// Get get the document according to the specified id
-func (s *Store) Get(docID engine.DOC_ID, timeout string) (doc engine.DOCUMENT, found bool, err error) {
+// Get get the document according to the specified id, req selects the returned _source and stored fields
+func (s *Store) Get(docID engine.DOC_ID, req *engine.GetRequest, timeout string) (doc *engine.GetResult, found bool, err error) {
 	if err = s.checkReadable(true); err != nil {
 		log.Error("get document error: [%s]", err)
 		return
@@ -26,7 +27,7 @@ func (s *Store) Get(docID engine.DOC_ID, timeout string) (doc engine.DOCUMENT, f
 			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
 		}
 	}
-	doc, found = s.Engine.GetDocument(timeCtx, docID)
+	doc, found = s.Engine.GetDocument(timeCtx, docID, req)
 	select {
 	case <-timeCtx.Done():
 		err = timeCtx.Err()
@@ -73,3 +74,47 @@ ret:
 	s.RUnlock()
 	return
 }
+
+// Search search the documents of the partition
+func (s *Store) Search(req *engine.SearchRequest, timeout string) (result *engine.SearchResult, err error) {
+	if err = s.checkReadable(true); err != nil {
+		log.Error("search error: [%s]", err)
+		return
+	}
+
+	timeCtx := s.Ctx
+	if timeout != "" {
+		if timeout, err := time.ParseDuration(timeout); err == nil {
+			var cancel context.CancelFunc
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+			defer cancel()
+		}
+	}
+	if result, err = s.Engine.Search(timeCtx, req); err != nil {
+		if err == context.DeadlineExceeded {
+			err = storage.ErrorTimeout
+		}
+		log.Error("search error: [%s]", err)
+	}
+	return
+}
+
+// NewSearchSnapshot pins a point in time view of the partition for scroll searches
+func (s *Store) NewSearchSnapshot() (engine.Snapshot, error) {
+	if err := s.checkReadable(true); err != nil {
+		return nil, err
+	}
+	return s.Engine.NewSnapshot()
+}
+
+// Analyze returns the tokens of the text analyzed by the schema of the partition, the leader is not required
+func (s *Store) Analyze(req *engine.AnalyzeRequest) (*engine.AnalyzeResult, error) {
+	if err := s.checkReadable(false); err != nil {
+		return nil, err
+	}
+	analyzer, ok := s.Engine.(engine.Analyzer)
+	if !ok {
+		return nil, fmt.Errorf("engine %s can not analyze", s.EngineName)
+	}
+	return analyzer.Analyze(s.Ctx, req)
+}
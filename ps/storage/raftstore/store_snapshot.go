@@ -0,0 +1,117 @@
+package raftstore
+
+import (
+	"io"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/pspb/raftpb"
+	"github.com/tiglabs/raft/proto"
+)
+
+var (
+	_ proto.Snapshot  = &raftSnapshot{}
+	_ engine.Iterator = &snapshotIterator{}
+)
+
+// raftSnapshot streams the key/value pairs of an engine snapshot over the raft snapshot channel.
+type raftSnapshot struct {
+	snap       engine.Snapshot
+	iter       engine.Iterator
+	applyIndex uint64
+}
+
+func newRaftSnapshot(snap engine.Snapshot, applyIndex uint64) *raftSnapshot {
+	return &raftSnapshot{
+		snap:       snap,
+		iter:       snap.NewIterator(),
+		applyIndex: applyIndex,
+	}
+}
+
+// Next implements the raft SnapIterator interface, io.EOF means the snapshot has been sent completely.
+func (s *raftSnapshot) Next() ([]byte, error) {
+	if !s.iter.Valid() {
+		return nil, io.EOF
+	}
+
+	kvPair := &raftpb.SnapshotKVPair{
+		Key:   s.iter.Key(),
+		Value: s.iter.Value(),
+	}
+	data, err := kvPair.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	s.iter.Next()
+	return data, nil
+}
+
+func (s *raftSnapshot) ApplyIndex() uint64 {
+	return s.applyIndex
+}
+
+func (s *raftSnapshot) Close() {
+	s.iter.Close()
+	s.snap.Close()
+}
+
+// snapshotIterator adapts the raft SnapIterator to the engine Iterator used by Engine.ApplySnapshot.
+type snapshotIterator struct {
+	iter proto.SnapIterator
+	pair *raftpb.SnapshotKVPair
+	err  error
+}
+
+func newSnapshotIterator(iter proto.SnapIterator) *snapshotIterator {
+	it := &snapshotIterator{iter: iter}
+	it.Next()
+	return it
+}
+
+func (it *snapshotIterator) Next() {
+	it.pair = nil
+	if it.err != nil {
+		return
+	}
+
+	data, err := it.iter.Next()
+	if err != nil {
+		if err != io.EOF {
+			it.err = err
+		}
+		return
+	}
+	pair := new(raftpb.SnapshotKVPair)
+	if err = pair.Unmarshal(data); err != nil {
+		it.err = err
+		return
+	}
+	it.pair = pair
+}
+
+func (it *snapshotIterator) Valid() bool {
+	return it.pair != nil
+}
+
+func (it *snapshotIterator) Key() []byte {
+	if it.pair == nil {
+		return nil
+	}
+	return it.pair.Key
+}
+
+func (it *snapshotIterator) Value() []byte {
+	if it.pair == nil {
+		return nil
+	}
+	return it.pair.Value
+}
+
+// Error returns the error that stopped the iteration, if any.
+func (it *snapshotIterator) Error() error {
+	return it.err
+}
+
+func (it *snapshotIterator) Close() error {
+	return nil
+}
@@ -0,0 +1,121 @@
+package raftstore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/engine/bleve"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/raft/proto"
+)
+
+func memStore(t *testing.T) *Store {
+	e, err := bleve.New(engine.EngineConfig{Schema: `{"mappings": {"doc": {"properties": {"n": {"type": "long"}}}}}`, ExtraOptions: `{"store": "memory"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Store{}
+	s.Ctx, s.CtxCancel = context.WithCancel(context.Background())
+	s.Engine = e
+	return s
+}
+
+func TestSnapshotApply(t *testing.T) {
+	src := memStore(t)
+	defer src.Engine.Close()
+	dst := memStore(t)
+	defer dst.Engine.Close()
+
+	const applyID = 7
+	var cmds []pspb.RequestUnion
+	for i := 0; i < 150; i++ {
+		cmds = append(cmds, pspb.RequestUnion{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{
+			ID: []byte(fmt.Sprintf("doc%d", i)), Data: []byte(fmt.Sprintf(`{"n": %d}`, i))}})
+	}
+	resp, err := src.execRaftCommand(applyID, 1, cmds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range resp {
+		if r.Failure != nil {
+			t.Fatal(r.Failure.Cause)
+		}
+	}
+
+	snap, err := src.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snap.Close()
+	if snap.ApplyIndex() != applyID {
+		t.Fatalf("the snapshot is at %d, want %d", snap.ApplyIndex(), applyID)
+	}
+	if err = dst.ApplySnapshot(nil, snap.(*raftSnapshot)); err != nil {
+		t.Fatal(err)
+	}
+
+	if id, err := dst.Engine.GetApplyID(); err != nil || id != applyID {
+		t.Fatalf("the apply ID is %d (%v) after the snapshot, want %d", id, err, applyID)
+	}
+	for i := 0; i < 150; i++ {
+		id := fmt.Sprintf("doc%d", i)
+		doc, found := dst.Engine.GetDocument(dst.Ctx, engine.DOC_ID(id), nil)
+		if !found {
+			t.Fatalf("document %s is missing after the snapshot", id)
+		}
+		if want := fmt.Sprintf(`{"n": %d}`, i); string(doc.Source) != want {
+			t.Fatalf("document %s is %s, want %s", id, doc.Source, want)
+		}
+		if doc.Version != 1 || doc.SeqNo != applyID {
+			t.Fatalf("document %s has version %+v", id, doc.DocVersion)
+		}
+	}
+}
+
+type replicaEvents struct {
+	EventListener
+	deleted []metapb.NodeID
+}
+
+func (l *replicaEvents) HandleRaftReplicaEvent(event *RaftReplicaEvent) {
+	if event.Delete {
+		l.deleted = append(l.deleted, event.Replica.NodeID)
+	}
+}
+
+func TestSnapshotApplyPeers(t *testing.T) {
+	src := memStore(t)
+	defer src.Engine.Close()
+	dst := memStore(t)
+	defer dst.Engine.Close()
+	events := &replicaEvents{}
+	dst.EventListener = events
+	addrs := metapb.ReplicaAddrs{RpcAddr: "node1"}
+	dst.Meta.Replicas = []metapb.Replica{{ID: 1, NodeID: 1, ReplicaAddrs: addrs}, {ID: 2, NodeID: 2}}
+
+	snap, err := src.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snap.Close()
+	// the snapshot is taken after node 2 is removed and node 3 is added
+	peers := []proto.Peer{{Type: proto.PeerNormal, ID: 1, PeerID: 1}, {Type: proto.PeerNormal, ID: 3, PeerID: 3}}
+	if err = dst.ApplySnapshot(peers, snap.(*raftSnapshot)); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []metapb.Replica{{ID: 1, NodeID: 1, ReplicaAddrs: addrs}, {ID: 3, NodeID: 3}}
+	if !reflect.DeepEqual(dst.Meta.Replicas, expect) {
+		t.Fatalf("the replicas are %v after the snapshot, want %v", dst.Meta.Replicas, expect)
+	}
+	if !reflect.DeepEqual(events.deleted, []metapb.NodeID{2}) {
+		t.Fatalf("the deleted replicas are %v, want [2]", events.deleted)
+	}
+	if dst.Meta.Epoch.ConfVersion != 2 {
+		t.Fatalf("the conf version is %d, want 2", dst.Meta.Epoch.ConfVersion)
+	}
+}
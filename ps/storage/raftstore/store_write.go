@@ -1,8 +1,8 @@
 package raftstore
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"time"
 
@@ -41,6 +41,8 @@ func (s *Store) Bulk(requests []pspb.RequestUnion, timeout string) (responses []
 	raftCmd := raftpb.CreateRaftCommand()
 	raftCmd.Type = raftpb.CmdType_WRITE
 	raftCmd.WriteCommands = requests
+	// the term is stamped into the command, so that the replicas apply the writes with the same primary term
+	_, raftCmd.Term = s.RaftServer.LeaderTerm(s.Meta.ID)
 	if data, e := raftCmd.Marshal(); e != nil {
 		err = e
 		log.Error("marshal raftCommand error: [%s]", err)
@@ -106,8 +108,14 @@ func (s *Store) fillBulkResponse(requests []pspb.RequestUnion, result interface{
 	return nil, err
 }
 
-func (s *Store) execRaftCommand(index uint64, cmds []pspb.RequestUnion) ([]pspb.ResponseUnion, error) {
-	batch := s.Engine.NewWriteBatch()
+// execRaftCommand applies the write commands of the raft entry of index, term is the raft term stamped into the command by the leader.
+func (s *Store) execRaftCommand(index, term uint64, cmds []pspb.RequestUnion) ([]pspb.ResponseUnion, error) {
+	batch := &writeBatch{
+		Batch:       s.Engine.NewWriteBatch(),
+		seqNo:       index,
+		primaryTerm: term,
+		pending:     make(map[string]*pendingDoc),
+	}
 	resp := make([]pspb.ResponseUnion, len(cmds))
 
 	for i, cmd := range cmds {
@@ -154,48 +162,150 @@ func (s *Store) execRaftCommand(index uint64, cmds []pspb.RequestUnion) ([]pspb.
 	return resp, nil
 }
 
-func (s *Store) createInternal(request *pspb.CreateRequest, batch engine.Batch) (*pspb.CreateResponse, error) {
-	var data interface{}
-	if err := json.Unmarshal(request.Data, &data); err != nil {
-		return nil, err
+// pendingDoc is a document written by a raft batch which is not committed yet.
+type pendingDoc struct {
+	source  []byte
+	version engine.DocVersion
+}
+
+// writeBatch is the engine batch of a raft command. The writes are versioned with the raft index as seq_no
+// and the raft term of the command as primary term, pending keeps the documents written by the batch, nil means deleted.
+type writeBatch struct {
+	engine.Batch
+	seqNo       uint64
+	primaryTerm uint64
+	pending     map[string]*pendingDoc
+}
+
+// nextVersion returns the version of the next write of the document, current is nil if it does not exist.
+func (b *writeBatch) nextVersion(current *pendingDoc) engine.DocVersion {
+	version := engine.DocVersion{Version: 1, SeqNo: b.seqNo, PrimaryTerm: b.primaryTerm}
+	if current != nil {
+		version.Version = current.version.Version + 1
 	}
+	return version
+}
 
-	if err := batch.AddDocument(s.Ctx, engine.DOC_ID(request.ID), data); err != nil {
-		return nil, err
+// write indexes the source of the document along with its version.
+func (b *writeBatch) write(ctx context.Context, docID metapb.Key, source []byte, version engine.DocVersion) error {
+	err := b.AddDocument(ctx, engine.DOC_ID(docID), &engine.VersionedDocument{Source: source, DocVersion: version})
+	if err != nil {
+		return err
 	}
+	b.pending[string(docID)] = &pendingDoc{source: source, version: version}
+	return nil
+}
 
-	return &pspb.CreateResponse{ID: request.ID, Result: pspb.WriteResult_CREATED}, nil
+// getDocument returns the latest source and version of the document, including the writes of the pending batch.
+func (s *Store) getDocument(docID metapb.Key, batch *writeBatch) *pendingDoc {
+	if doc, ok := batch.pending[string(docID)]; ok {
+		return doc
+	}
+	doc, found := s.Engine.GetDocument(s.Ctx, engine.DOC_ID(docID), nil)
+	if !found {
+		return nil
+	}
+	return &pendingDoc{source: doc.Source, version: doc.DocVersion}
 }
 
-func (s *Store) updateInternal(request *pspb.UpdateRequest, batch engine.Batch) (*pspb.UpdateResponse, error) {
-	var data interface{}
-	if err := json.Unmarshal(request.Data, &data); err != nil {
-		return nil, err
+// matchVersion checks the preconditions on the current version of the document, zero means no condition.
+func matchVersion(current *pendingDoc, ifVersion, ifSeqNo, ifPrimaryTerm uint64) bool {
+	if ifVersion == 0 && ifSeqNo == 0 && ifPrimaryTerm == 0 {
+		return true
 	}
+	if current == nil {
+		return false
+	}
+	return (ifVersion == 0 || ifVersion == current.version.Version) &&
+		(ifSeqNo == 0 || ifSeqNo == current.version.SeqNo) &&
+		(ifPrimaryTerm == 0 || ifPrimaryTerm == current.version.PrimaryTerm)
+}
 
-	found, err := batch.UpdateDocument(s.Ctx, engine.DOC_ID(request.ID), data, request.Upsert)
-	if err != nil {
+// createInternal writes the document if it does not exist, an existing document is kept as a version conflict.
+func (s *Store) createInternal(request *pspb.CreateRequest, batch *writeBatch) (*pspb.CreateResponse, error) {
+	if current := s.getDocument(request.ID, batch); current != nil {
+		return &pspb.CreateResponse{ID: request.ID, Result: pspb.WriteResult_VERSION_CONFLICT,
+			Version: current.version.Version, SeqNo: current.version.SeqNo, PrimaryTerm: current.version.PrimaryTerm}, nil
+	}
+	version := batch.nextVersion(nil)
+	// the raw json is passed through, so that the engine keeps it as _source
+	if err := batch.write(s.Ctx, request.ID, request.Data, version); err != nil {
 		return nil, err
 	}
 
-	result := pspb.WriteResult_NOT_FOUND
-	if found {
-		result = pspb.WriteResult_UPDATED
-	} else if request.Upsert {
-		result = pspb.WriteResult_CREATED
-	}
-	return &pspb.UpdateResponse{ID: request.ID, Result: result}, nil
+	return &pspb.CreateResponse{ID: request.ID, Result: pspb.WriteResult_CREATED,
+		Version: version.Version, SeqNo: version.SeqNo, PrimaryTerm: version.PrimaryTerm}, nil
 }
 
-func (s *Store) deleteInternal(request *pspb.DeleteRequest, batch engine.Batch) (*pspb.DeleteResponse, error) {
-	n, err := batch.DeleteDocument(s.Ctx, engine.DOC_ID(request.ID))
-	if err != nil {
+func (s *Store) updateInternal(request *pspb.UpdateRequest, batch *writeBatch) (*pspb.UpdateResponse, error) {
+	resp := &pspb.UpdateResponse{ID: request.ID}
+	current := s.getDocument(request.ID, batch)
+	if current != nil {
+		resp.Version, resp.SeqNo, resp.PrimaryTerm = current.version.Version, current.version.SeqNo, current.version.PrimaryTerm
+	}
+	if !matchVersion(current, request.IfVersion, request.IfSeqNo, request.IfPrimaryTerm) {
+		resp.Result = pspb.WriteResult_VERSION_CONFLICT
+		return resp, nil
+	}
+	if current == nil && !request.Upsert {
+		resp.Result = pspb.WriteResult_NOT_FOUND
+		return resp, nil
+	}
+
+	source := []byte(request.Data)
+	if request.Merge {
+		// the partial document is merged into the stored source at apply time,
+		// so that concurrent updates of different fields do not overwrite each other
+		var origin []byte
+		if current != nil {
+			origin = current.source
+		}
+		merged, err := engine.MergePatch(origin, source)
+		if err != nil {
+			return nil, err
+		}
+		if current != nil {
+			// the source is normalized the same way as the merged document before they are compared
+			if normalized, err := engine.MergePatch(origin, []byte("{}")); err == nil && bytes.Equal(normalized, merged) {
+				resp.Result = pspb.WriteResult_NOOP
+				return resp, nil
+			}
+		}
+		source = merged
+	}
+
+	version := batch.nextVersion(current)
+	if err := batch.write(s.Ctx, request.ID, source, version); err != nil {
 		return nil, err
 	}
+	resp.Result = pspb.WriteResult_CREATED
+	if current != nil {
+		resp.Result = pspb.WriteResult_UPDATED
+	}
+	resp.Version, resp.SeqNo, resp.PrimaryTerm = version.Version, version.SeqNo, version.PrimaryTerm
+	return resp, nil
+}
 
-	result := pspb.WriteResult_NOT_FOUND
-	if n > 0 {
-		result = pspb.WriteResult_DELETED
+func (s *Store) deleteInternal(request *pspb.DeleteRequest, batch *writeBatch) (*pspb.DeleteResponse, error) {
+	resp := &pspb.DeleteResponse{ID: request.ID, Result: pspb.WriteResult_NOT_FOUND}
+	current := s.getDocument(request.ID, batch)
+	if !matchVersion(current, request.IfVersion, request.IfSeqNo, request.IfPrimaryTerm) {
+		resp.Result = pspb.WriteResult_VERSION_CONFLICT
+		if current != nil {
+			resp.Version, resp.SeqNo, resp.PrimaryTerm = current.version.Version, current.version.SeqNo, current.version.PrimaryTerm
+		}
+		return resp, nil
+	}
+	if current == nil {
+		return resp, nil
 	}
-	return &pspb.DeleteResponse{ID: request.ID, Result: result}, nil
+
+	if _, err := batch.DeleteDocument(s.Ctx, engine.DOC_ID(request.ID)); err != nil {
+		return nil, err
+	}
+	batch.pending[string(request.ID)] = nil
+	version := batch.nextVersion(current)
+	resp.Result = pspb.WriteResult_DELETED
+	resp.Version, resp.SeqNo, resp.PrimaryTerm = version.Version, version.SeqNo, version.PrimaryTerm
+	return resp, nil
 }
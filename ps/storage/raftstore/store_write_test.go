@@ -0,0 +1,37 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestCreateExisting(t *testing.T) {
+	s := memStore(t)
+	defer s.Engine.Close()
+
+	create := func(id, data string) pspb.RequestUnion {
+		return pspb.RequestUnion{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{ID: []byte(id), Data: []byte(data)}}
+	}
+	if _, err := s.execRaftCommand(1, 1, []pspb.RequestUnion{create("doc1", `{"n": 1}`)}); err != nil {
+		t.Fatal(err)
+	}
+	// the existing document and the document created by the same batch are kept
+	resp, err := s.execRaftCommand(2, 1, []pspb.RequestUnion{create("doc1", `{"n": 2}`), create("doc2", `{"n": 3}`), create("doc2", `{"n": 4}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := []pspb.WriteResult{pspb.WriteResult_VERSION_CONFLICT, pspb.WriteResult_CREATED, pspb.WriteResult_VERSION_CONFLICT}
+	for i, result := range results {
+		if resp[i].Create == nil || resp[i].Create.Result != result || resp[i].Create.Version != 1 {
+			t.Fatalf("the create %d is %v, want %s of version 1", i, resp[i], result)
+		}
+	}
+	for id, source := range map[string]string{"doc1": `{"n": 1}`, "doc2": `{"n": 3}`} {
+		doc, found := s.Engine.GetDocument(s.Ctx, engine.DOC_ID(id), nil)
+		if !found || string(doc.Source) != source {
+			t.Fatalf("the document %s is overwritten", id)
+		}
+	}
+}
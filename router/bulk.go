@@ -0,0 +1,322 @@
+package router
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/netutil"
+	"github.com/tiglabs/baudengine/util/uuid"
+)
+
+// bulkItem is an index, create, update or delete action of a bulk request
+type bulkItem struct {
+	op        string
+	meta      esDocMeta
+	source    []byte
+	space     *Space
+	partition *Partition
+	request   pspb.RequestUnion
+	result    *esWriteResult
+}
+
+// bulkResult is the reply of a bulk request, the items are in the order of the actions
+type bulkResult struct {
+	Took   int64                       `json:"took"`
+	Errors bool                        `json:"errors"`
+	Items  []map[string]*esWriteResult `json:"items"`
+}
+
+// handleBulk runs the ndjson actions on the space of the path, the _index of the actions is ignored
+func (router *Router) handleBulk(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, space, _ := router.getParams(params)
+	items, err := parseBulk(request, space.meta.Name)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	for _, item := range items {
+		item.meta.Index = space.meta.Name
+	}
+	result := router.bulk(items, request.URL.Query().Get("timeout"), func(string) *Space { return space })
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), result})
+}
+
+// parseBulk reads the actions of the ndjson body, index is the index of the actions without _index.
+// The body is limited to the bulkMaxSize of the config.
+func parseBulk(request *http.Request, index string) ([]*bulkItem, error) {
+	maxSize := int64(routerCfg.ModuleCfg.BulkMaxSize)
+	if maxSize > 0 && request.ContentLength > maxSize {
+		return nil, errBulkTooLarge(maxSize)
+	}
+	body := io.Reader(request.Body)
+	if maxSize > 0 {
+		body = io.LimitReader(request.Body, maxSize+1)
+	}
+	reader := bufio.NewReader(body)
+	var size int64
+	readLine := func() ([]byte, bool, error) {
+		for {
+			line, err := reader.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				return nil, false, err
+			}
+			if size += int64(len(line)); maxSize > 0 && size > maxSize {
+				return nil, false, errBulkTooLarge(maxSize)
+			}
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				return line, true, nil
+			}
+			if err == io.EOF {
+				return nil, false, nil
+			}
+		}
+	}
+
+	var items []*bulkItem
+	for {
+		line, ok, err := readLine()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		action := make(map[string]esDocMeta)
+		if err := json.Unmarshal(line, &action); err != nil || len(action) != 1 {
+			return nil, esBadRequest("malformed action/metadata line [%d]", len(items)+1)
+		}
+		for op, meta := range action {
+			item := &bulkItem{op: op, meta: meta}
+			switch op {
+			case "index", "create", "update":
+				if item.source, ok, err = readLine(); err != nil {
+					return nil, err
+				} else if !ok {
+					return nil, esBadRequest("the source of action [%s] is missing", op)
+				}
+			case "delete":
+			default:
+				return nil, esBadRequest("unknown action [%s]", op)
+			}
+			if item.meta.Index == "" {
+				item.meta.Index = index
+			}
+			items = append(items, item)
+		}
+	}
+	if items == nil {
+		return nil, esBadRequest("request body is required")
+	}
+	return items, nil
+}
+
+func errBulkTooLarge(maxSize int64) *esError {
+	return &esError{http.StatusRequestEntityTooLarge, "illegal_argument_exception", fmt.Sprintf("the bulk request is larger than %d bytes", maxSize)}
+}
+
+// bulk writes the items by their partitions, the items of a partition are sent in a Bulk request
+// and the partitions are written in parallel, the failures are kept in the results of the items.
+func (router *Router) bulk(items []*bulkItem, timeoutParam string, spaceOf func(index string) *Space) *bulkResult {
+	start := time.Now()
+	timeout, err := searchTimeout(timeoutParam, nil)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	spaces := make(map[string]*Space)
+	lookups := make(map[*Space][]*bulkItem)
+	for _, item := range items {
+		item.result = &esWriteResult{Index: item.meta.Index, Type: esDocType, Id: item.meta.Id}
+		item.try(func() {
+			space, ok := spaces[item.meta.Index]
+			if !ok {
+				if item.meta.Index == "" {
+					panic(esBadRequest("index is missing"))
+				}
+				space = spaceOf(item.meta.Index)
+				spaces[item.meta.Index] = space
+			}
+			item.space = space
+			if !item.route() {
+				lookups[space] = append(lookups[space], item)
+			}
+		})
+	}
+
+	// the documents updated or deleted by the ids which are not routed are looked up in all the partitions
+	for space, spaceItems := range lookups {
+		ids := make([]string, len(spaceItems))
+		for i, item := range spaceItems {
+			ids[i] = item.meta.Id
+		}
+		var docs map[string]*document
+		func() {
+			defer func() {
+				if p := recover(); p != nil {
+					for _, item := range spaceItems {
+						item.fail(p)
+					}
+				}
+			}()
+			docs = router.getDocuments(space, ids, "", noSource, nil)
+		}()
+		for _, item := range spaceItems {
+			if item.result.Error != nil {
+				continue
+			}
+			if doc, ok := docs[item.meta.Id]; ok {
+				item.partition = doc.partition
+			} else if item.op == "delete" {
+				item.result.Result, item.result.Status = "not_found", http.StatusNotFound
+			} else {
+				item.fail(esDocumentMissing(item.meta.Id))
+			}
+		}
+	}
+
+	var partitions []*Partition
+	batches := make(map[*Partition][]*bulkItem)
+	for _, item := range items {
+		if item.partition == nil {
+			continue
+		}
+		if _, ok := batches[item.partition]; !ok {
+			partitions = append(partitions, item.partition)
+		}
+		batches[item.partition] = append(batches[item.partition], item)
+	}
+	results, errs := doPartitions(ctx, partitions, func(i int, partition *Partition) interface{} {
+		requests := make([]pspb.RequestUnion, len(batches[partition]))
+		for j, item := range batches[partition] {
+			requests[j] = item.request
+		}
+		return partition.Bulk(ctx, requests)
+	})
+	for i, partition := range partitions {
+		for j, item := range batches[partition] {
+			if errs[i] != nil {
+				item.fail(errs[i])
+			} else {
+				item.setResponse(&results[i].([]pspb.ResponseUnion)[j])
+			}
+		}
+	}
+
+	result := &bulkResult{Took: int64(time.Since(start) / time.Millisecond), Items: make([]map[string]*esWriteResult, len(items))}
+	for i, item := range items {
+		result.Errors = result.Errors || item.result.Error != nil
+		result.Items[i] = map[string]*esWriteResult{item.op: item.result}
+	}
+	return result
+}
+
+// route sets the request of the item and its partition, it returns false if the partition is known by a lookup only
+func (item *bulkItem) route() bool {
+	space, id, routing := item.space, item.meta.Id, item.meta.Routing
+	switch {
+	case id == "" && (item.op == "update" || item.op == "delete"):
+		panic(esBadRequest("the id of action [%s] is missing", item.op))
+	case id == "":
+		slot := space.DocSlot(uuid.FlakeUUID(), item.source, routing)
+		item.result.Id = newDocId(slot)
+		item.request = pspb.RequestUnion{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{ID: []byte(item.result.Id), Data: item.source}}
+		item.partition = space.GetPartition(slot)
+		return true
+	case item.op == "create":
+		// the document is created only if the id does not exist
+		item.request = pspb.RequestUnion{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{ID: []byte(id), Data: item.source}}
+		item.partition = space.GetPartition(space.DocSlot(id, item.source, routing))
+		return true
+	case item.op == "index":
+		item.request = pspb.RequestUnion{OpType: pspb.OpType_UPDATE, Update: &pspb.UpdateRequest{ID: []byte(id), Data: item.source, Upsert: true}}
+		item.partition = space.GetPartition(space.DocSlot(id, item.source, routing))
+		return true
+	case item.op == "update":
+		update := struct {
+			Doc json.RawMessage `json:"doc"`
+		}{}
+		if err := json.Unmarshal(item.source, &update); err != nil || len(update.Doc) == 0 {
+			panic(esBadRequest("the doc of the update is missing"))
+		}
+		// the partial document is merged into the stored one
+		item.request = pspb.RequestUnion{OpType: pspb.OpType_UPDATE, Update: &pspb.UpdateRequest{ID: []byte(id), Data: update.Doc, Merge: true}}
+	default:
+		item.request = pspb.RequestUnion{OpType: pspb.OpType_DELETE, Delete: &pspb.DeleteRequest{ID: []byte(id)}}
+	}
+	slot, ok := space.IdSlot(id, routing)
+	if ok {
+		item.partition = space.GetPartition(slot)
+	}
+	return ok
+}
+
+// setResponse sets the result of the item by its response in the Bulk response of its partition
+func (item *bulkItem) setResponse(resp *pspb.ResponseUnion) {
+	if resp.Failure != nil {
+		item.fail(errors.New(resp.Failure.Cause))
+		return
+	}
+	var result pspb.WriteResult
+	var version engine.DocVersion
+	switch {
+	case resp.OpType != item.request.OpType:
+		item.fail(errors.New("bad response for " + item.request.OpType.String()))
+		return
+	case resp.Create != nil:
+		result, version = resp.Create.Result, engine.DocVersion{Version: resp.Create.Version, SeqNo: resp.Create.SeqNo, PrimaryTerm: resp.Create.PrimaryTerm}
+	case resp.Update != nil:
+		result, version = resp.Update.Result, engine.DocVersion{Version: resp.Update.Version, SeqNo: resp.Update.SeqNo, PrimaryTerm: resp.Update.PrimaryTerm}
+	case resp.Delete != nil:
+		result, version = resp.Delete.Result, engine.DocVersion{Version: resp.Delete.Version, SeqNo: resp.Delete.SeqNo, PrimaryTerm: resp.Delete.PrimaryTerm}
+	}
+
+	r := item.result
+	r.DocVersion, r.Shards = version, esWriteShards
+	switch result {
+	case pspb.WriteResult_CREATED:
+		r.Result, r.Status = "created", http.StatusCreated
+	case pspb.WriteResult_UPDATED:
+		r.Result, r.Status = "updated", http.StatusOK
+	case pspb.WriteResult_NOOP:
+		r.Result, r.Status = "noop", http.StatusOK
+	case pspb.WriteResult_DELETED:
+		r.Result, r.Status = "deleted", http.StatusOK
+	case pspb.WriteResult_NOT_FOUND:
+		if item.op != "delete" {
+			item.fail(esDocumentMissing(item.meta.Id))
+			return
+		}
+		r.Result, r.Status = "not_found", http.StatusNotFound
+	case pspb.WriteResult_VERSION_CONFLICT:
+		item.fail(esVersionConflict(item.meta.Id, item.op == "create", version.Version))
+	}
+}
+
+// try calls fn and keeps its panic as the failure of the item
+func (item *bulkItem) try(fn func()) {
+	defer func() {
+		if p := recover(); p != nil {
+			item.fail(p)
+		}
+	}()
+	fn()
+}
+
+func (item *bulkItem) fail(p interface{}) {
+	e := toEsError(p)
+	item.partition = nil
+	item.result.Result, item.result.Shards = "", nil
+	item.result.Status, item.result.Error = e.status, e.body()
+}
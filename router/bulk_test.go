@@ -0,0 +1,172 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestParseBulk(t *testing.T) {
+	routerCfg = &Config{}
+	routerCfg.ModuleCfg.BulkMaxSize = 200
+	defer func() { routerCfg.ModuleCfg.BulkMaxSize = 0 }()
+
+	tests := []struct {
+		name   string
+		body   string
+		ops    []string
+		status int
+	}{
+		{
+			name: "actions",
+			body: `{"index": {"_id": "1"}}
+{"a": 1}
+
+{"create": {"_index": "other", "_id": "2"}}
+{"a": 2}
+{"update": {"_id": "3"}}
+{"doc": {"a": 3}}
+{"delete": {"_id": "4"}}`,
+			ops: []string{"index", "create", "update", "delete"},
+		},
+		{name: "empty", body: "\n\n", status: http.StatusBadRequest},
+		{name: "missing source", body: `{"create": {"_id": "1"}}`, status: http.StatusBadRequest},
+		{name: "unknown action", body: "{\"upsert\": {}}\n{}", status: http.StatusBadRequest},
+		{name: "malformed action", body: `{"index": {}, "delete": {}}`, status: http.StatusBadRequest},
+		{name: "too large", body: `{"index": {}}` + "\n" + `{"a": "` + strings.Repeat("x", 200) + `"}`, status: http.StatusRequestEntityTooLarge},
+	}
+	for _, test := range tests {
+		items, err := parseBulk(httptest.NewRequest(http.MethodPost, "/_bulk", strings.NewReader(test.body)), "test")
+		if test.status != 0 {
+			if e, ok := err.(*esError); !ok || e.status != test.status {
+				t.Errorf("%s: got error %v, want status %d", test.name, err, test.status)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		var ops []string
+		for _, item := range items {
+			ops = append(ops, item.op)
+		}
+		if !reflect.DeepEqual(ops, test.ops) {
+			t.Fatalf("%s: got the actions %v, want %v", test.name, ops, test.ops)
+		}
+		if items[0].meta.Index != "test" || items[1].meta.Index != "other" || string(items[2].source) != `{"doc": {"a": 3}}` {
+			t.Fatalf("%s: bad items %+v %+v %+v", test.name, items[0], items[1], items[2])
+		}
+	}
+}
+
+// docServers are the servers of the documents of the ids in docs, the Bulk requests are recorded by the servers in requests
+func docServers(docs map[string]bool, requests map[string][]string) *fakeServers {
+	var lock sync.Mutex
+	return &fakeServers{bulk: func(addr string, reqs []pspb.RequestUnion) []pspb.ResponseUnion {
+		lock.Lock()
+		defer lock.Unlock()
+		resps := make([]pspb.ResponseUnion, len(reqs))
+		for i, req := range reqs {
+			resps[i].OpType = req.OpType
+			switch req.OpType {
+			case pspb.OpType_CREATE:
+				id := string(req.Create.ID)
+				requests[addr] = append(requests[addr], "create "+id)
+				resps[i].Create = &pspb.CreateResponse{ID: req.Create.ID, Result: pspb.WriteResult_CREATED, Version: 1}
+				if docs[id] {
+					resps[i].Create.Result = pspb.WriteResult_VERSION_CONFLICT
+				}
+				docs[id] = true
+			case pspb.OpType_UPDATE:
+				id := string(req.Update.ID)
+				requests[addr] = append(requests[addr], "update "+id)
+				resps[i].Update = &pspb.UpdateResponse{ID: req.Update.ID, Result: pspb.WriteResult_UPDATED, Version: 2}
+				if !docs[id] {
+					resps[i].Update.Result, resps[i].Update.Version = pspb.WriteResult_CREATED, 1
+				}
+				docs[id] = true
+			case pspb.OpType_DELETE:
+				requests[addr] = append(requests[addr], "delete "+string(req.Delete.ID))
+				resps[i].Delete = &pspb.DeleteResponse{ID: req.Delete.ID, Result: pspb.WriteResult_DELETED, Version: 3}
+				delete(docs, string(req.Delete.ID))
+			}
+		}
+		return resps
+	}}
+}
+
+func TestBulk(t *testing.T) {
+	docs := map[string]bool{"exists": true}
+	requests := make(map[string][]string)
+	servers := docServers(docs, requests)
+	space := testSpace2(servers)
+
+	body := `{"create": {"_id": "new"}}
+{"a": 1}
+{"create": {"_id": "exists"}}
+{"a": 2}
+{"index": {"_id": "exists"}}
+{"a": 3}
+{"create": {}}
+{"a": 4}
+{"update": {"_id": "exists"}}
+{"doc": {"a": 5}}
+{"delete": {"_id": "new"}}
+{"update": {}}
+{"doc": {}}
+`
+	items, err := parseBulk(httptest.NewRequest(http.MethodPost, "/_bulk", strings.NewReader(body)), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := (&Router{}).bulk(items, "", func(string) *Space { return space })
+
+	want := []struct {
+		op     string
+		status int
+		result string
+		err    string
+	}{
+		{"create", http.StatusCreated, "created", ""},
+		{"create", http.StatusConflict, "", "version_conflict_engine_exception"},
+		{"index", http.StatusOK, "updated", ""},
+		{"create", http.StatusCreated, "created", ""},
+		{"update", http.StatusOK, "updated", ""},
+		{"delete", http.StatusOK, "deleted", ""},
+		{"update", http.StatusBadRequest, "", "illegal_argument_exception"},
+	}
+	if !result.Errors || len(result.Items) != len(want) {
+		t.Fatalf("bad result %+v", result)
+	}
+	for i, w := range want {
+		r, ok := result.Items[i][w.op]
+		if !ok {
+			t.Fatalf("item %d is %v, want %s", i, result.Items[i], w.op)
+		}
+		if r.Status != w.status || r.Result != w.result || (w.err != "") != (r.Error != nil) || (r.Error != nil && r.Error["type"] != w.err) {
+			t.Errorf("item %d is %+v, want %+v", i, r, w)
+		}
+	}
+	if id := result.Items[3]["create"].Id; id == "" {
+		t.Fatalf("the id of the created document is not generated")
+	}
+
+	// the requests of a partition are in the order of the actions
+	ids := map[string]string{"new": "", "exists": "", result.Items[3]["create"].Id: ""}
+	for id := range ids {
+		ids[id] = space.GetPartition(space.DocSlot(id, nil, "")).getLeaderAddr()
+	}
+	wantRequests := make(map[string][]string)
+	for _, req := range []string{"create new", "create exists", "update exists", "create " + result.Items[3]["create"].Id, "update exists", "delete new"} {
+		addr := ids[req[strings.Index(req, " ")+1:]]
+		wantRequests[addr] = append(wantRequests[addr], req)
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Fatalf("the requests of the servers are %v, want %v", requests, wantRequests)
+	}
+}
@@ -0,0 +1,207 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+// byQueryTimeoutDef is the timeout of a delete or update by query without the timeout parameter,
+// the partitions write the matched documents batch by batch so it is longer than the timeout of a request
+const byQueryTimeoutDef = time.Minute
+
+// byQueryRequest is the body of the delete and update by query apis
+type byQueryRequest struct {
+	Query     json.RawMessage `json:"query"`
+	MaxDocs   int64           `json:"max_docs"`
+	Conflicts string          `json:"conflicts"`
+	// the partial document merged into the matched documents of an update
+	Doc    json.RawMessage `json:"doc"`
+	Script json.RawMessage `json:"script"`
+}
+
+// byQueryResult is the reply of the delete and update by query apis, the counts are the sums of the partitions
+type byQueryResult struct {
+	Took             int64                    `json:"took"`
+	TimedOut         bool                     `json:"timed_out"`
+	Total            int64                    `json:"total"`
+	Deleted          int64                    `json:"deleted"`
+	Updated          int64                    `json:"updated"`
+	Batches          int64                    `json:"batches"`
+	VersionConflicts int64                    `json:"version_conflicts"`
+	Noops            int64                    `json:"noops"`
+	Failures         []map[string]interface{} `json:"failures"`
+}
+
+// byQueryParams are the parameters of a delete or update by query common to the partitions
+type byQueryParams struct {
+	query     []byte
+	batchSize int32
+	maxDocs   int64
+	proceed   bool
+	doc       []byte
+}
+
+// parseByQuery reads the body and the url parameters of a delete or update by query, update tells whether a doc is accepted
+func parseByQuery(request *http.Request, body []byte, update bool) *byQueryParams {
+	req := &byQueryRequest{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, req); err != nil {
+			panic(esBadRequest("invalid by query request: %v", err))
+		}
+	}
+	params := &byQueryParams{query: req.Query, maxDocs: req.MaxDocs}
+	if len(params.query) == 0 {
+		if !update {
+			panic(esBadRequest("query is missing"))
+		}
+		params.query = matchAllQuery
+	}
+	switch {
+	case len(req.Script) > 0:
+		panic(esBadRequest("script is not supported, use doc to merge a partial document into the matched documents"))
+	case len(req.Doc) > 0 && !update:
+		panic(esBadRequest("doc is not allowed in a delete by query"))
+	case len(req.Doc) > 0:
+		var doc map[string]interface{}
+		if err := json.Unmarshal(req.Doc, &doc); err != nil {
+			panic(esBadRequest("the doc of the update must be an object"))
+		}
+		params.doc = req.Doc
+	}
+
+	query := request.URL.Query()
+	conflicts := req.Conflicts
+	if v := query.Get("conflicts"); v != "" {
+		conflicts = v
+	}
+	switch conflicts {
+	case "", "abort":
+	case "proceed":
+		params.proceed = true
+	default:
+		panic(esBadRequest("conflicts may only be \"proceed\" or \"abort\" but was [%s]", conflicts))
+	}
+	if v := query.Get("max_docs"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			panic(esBadRequest("invalid max_docs %s", v))
+		}
+		params.maxDocs = n
+	}
+	if params.maxDocs < 0 {
+		panic(esBadRequest("max_docs must not be negative"))
+	}
+	if v := query.Get("scroll_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n <= 0 {
+			panic(esBadRequest("invalid scroll_size %s", v))
+		}
+		params.batchSize = int32(n)
+	}
+	return params
+}
+
+// deleteByQuery deletes the documents of the space matching the query by all the partitions
+func (router *Router) deleteByQuery(space *Space, index string, params *byQueryParams, timeoutParam string) *byQueryResult {
+	return router.byQuery(space, index, params, timeoutParam, func(ctx context.Context, partition *Partition, maxDocs int64) *pspb.ByQueryResponse {
+		return partition.DeleteByQuery(ctx, pspb.DeleteByQueryRequest{Query: params.query, BatchSize: params.batchSize,
+			MaxDocs: maxDocs, ProceedOnConflicts: params.proceed})
+	})
+}
+
+// updateByQuery merges the doc into the documents of the space matching the query, or reindexes them without a doc
+func (router *Router) updateByQuery(space *Space, index string, params *byQueryParams, timeoutParam string) *byQueryResult {
+	return router.byQuery(space, index, params, timeoutParam, func(ctx context.Context, partition *Partition, maxDocs int64) *pspb.ByQueryResponse {
+		return partition.UpdateByQuery(ctx, pspb.UpdateByQueryRequest{Query: params.query, BatchSize: params.batchSize,
+			MaxDocs: maxDocs, ProceedOnConflicts: params.proceed, Doc: params.doc})
+	})
+}
+
+type byQueryCall func(ctx context.Context, partition *Partition, maxDocs int64) *pspb.ByQueryResponse
+
+// byQuery runs the by query call on the partitions of the space and sums up their responses.
+// The partitions are run in parallel, or one by one with the documents left of max_docs if it is given.
+// The failed partitions are reported in the failures, the error of the first partition is panicked if all of them fail.
+func (router *Router) byQuery(space *Space, index string, params *byQueryParams, timeoutParam string, call byQueryCall) *byQueryResult {
+	start := time.Now()
+	timeout := byQueryTimeoutDef
+	if timeoutParam != "" {
+		var err error
+		if timeout, err = searchTimeout(timeoutParam, nil); err != nil {
+			panic(esBadRequest("%v", err))
+		}
+	}
+	ctx, cancel := context.WithTimeout(space.parent.context, timeout)
+	defer cancel()
+
+	partitions := space.GetPartitions()
+	result := &byQueryResult{Failures: []map[string]interface{}{}}
+	var errs []error
+	if params.maxDocs > 0 {
+		errs = make([]error, len(partitions))
+		for i, partition := range partitions {
+			remain := params.maxDocs - result.Deleted - result.Updated - result.VersionConflicts - result.Noops - int64(len(result.Failures))
+			// the partitions left are not run after a version conflict aborts the run
+			if remain <= 0 || (result.VersionConflicts > 0 && !params.proceed) {
+				break
+			}
+			results, callErrs := doPartitions(ctx, []*Partition{partition}, func(_ int, partition *Partition) interface{} {
+				return call(ctx, partition, remain)
+			})
+			if errs[i] = callErrs[0]; errs[i] == nil {
+				result.add(index, results[0].(*pspb.ByQueryResponse))
+			}
+		}
+	} else {
+		var results []interface{}
+		results, errs = doPartitions(ctx, partitions, func(i int, partition *Partition) interface{} {
+			return call(ctx, partition, 0)
+		})
+		for i := range partitions {
+			if errs[i] == nil {
+				result.add(index, results[i].(*pspb.ByQueryResponse))
+			}
+		}
+	}
+
+	failed := 0
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed++
+		result.TimedOut = result.TimedOut || err == errRequestTimeout
+		e := toEsError(err)
+		result.Failures = append(result.Failures, map[string]interface{}{"index": index, "cause": e.body(), "status": e.status})
+	}
+	if failed > 0 && failed == len(partitions) {
+		panic(errs[0])
+	}
+	result.Took = int64(time.Since(start) / time.Millisecond)
+	return result
+}
+
+// add sums up the response of a partition
+func (r *byQueryResult) add(index string, resp *pspb.ByQueryResponse) {
+	r.TimedOut = r.TimedOut || resp.TimedOut
+	r.Total += resp.Total
+	r.Deleted += resp.Deleted
+	r.Updated += resp.Updated
+	r.Batches += resp.Batches
+	r.VersionConflicts += resp.VersionConflicts
+	r.Noops += resp.Noops
+	for _, failure := range resp.Failures {
+		r.Failures = append(r.Failures, map[string]interface{}{
+			"index":  index,
+			"type":   esDocType,
+			"id":     string(failure.ID),
+			"cause":  map[string]interface{}{"type": "exception", "reason": failure.Cause},
+			"status": http.StatusInternalServerError,
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package router
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+// testSpace2 is a space of the partitions of the lower and the upper half of the slots led by "a" and "b"
+func testSpace2(servers *fakeServers) *Space {
+	space := testSpace(servers, testRoute(1, "a"))
+	lower, upper := testRoute(1, "a"), testRoute(1, "b")
+	lower.Partition.EndSlot = 1<<31 - 1
+	upper.Partition.ID, upper.Partition.StartSlot = 2, 1<<31
+	space.partitions = nil
+	space.addRoutes([]masterpb.Route{lower, upper})
+	return space
+}
+
+func byQueryReply(deleted int64, failures ...string) func(int64) *pspb.ByQueryResponse {
+	return func(int64) *pspb.ByQueryResponse {
+		resp := &pspb.ByQueryResponse{Total: deleted + int64(len(failures)), Deleted: deleted, Batches: 1}
+		for _, id := range failures {
+			resp.Failures = append(resp.Failures, pspb.Failure{ID: metapb.Key(id), Cause: "failed"})
+		}
+		return resp
+	}
+}
+
+func byQueryError(int64) *pspb.ByQueryResponse {
+	return &pspb.ByQueryResponse{ResponseHeader: metapb.ResponseHeader{Code: metapb.RESP_CODE_SERVER_ERROR, Message: "bad query"}}
+}
+
+func TestByQuery(t *testing.T) {
+	router := &Router{}
+	params := &byQueryParams{query: matchAllQuery}
+
+	servers := &fakeServers{byQuery: map[string]func(int64) *pspb.ByQueryResponse{"a": byQueryReply(2, "1"), "b": byQueryReply(3)}}
+	result := router.deleteByQuery(testSpace2(servers), "test", params, "")
+	if result.Total != 6 || result.Deleted != 5 || result.Batches != 2 || len(result.Failures) != 1 || result.Failures[0]["id"] != "1" {
+		t.Fatalf("bad result of the partitions %+v", result)
+	}
+
+	// the failed partition is reported in the failures
+	servers = &fakeServers{byQuery: map[string]func(int64) *pspb.ByQueryResponse{"a": byQueryReply(2), "b": byQueryError}}
+	result = router.deleteByQuery(testSpace2(servers), "test", params, "")
+	if result.Deleted != 2 || len(result.Failures) != 1 || result.Failures[0]["status"] != 500 {
+		t.Fatalf("bad result of the failed partition %+v", result)
+	}
+
+	// the error is panicked if all the partitions fail
+	servers = &fakeServers{byQuery: map[string]func(int64) *pspb.ByQueryResponse{"a": byQueryError, "b": byQueryError}}
+	func() {
+		defer func() {
+			if e := toEsError(recover()); e.reason != "bad query" {
+				t.Fatalf("expect the error of the partitions, got %v", e)
+			}
+		}()
+		router.deleteByQuery(testSpace2(servers), "test", params, "")
+	}()
+}
+
+func TestByQueryMaxDocs(t *testing.T) {
+	router := &Router{}
+	var maxDocs []int64
+	reply := func(n int64) *pspb.ByQueryResponse {
+		maxDocs = append(maxDocs, n)
+		return &pspb.ByQueryResponse{Total: 2, Deleted: 2}
+	}
+	servers := &fakeServers{byQuery: map[string]func(int64) *pspb.ByQueryResponse{"a": reply, "b": reply}}
+
+	// the partitions are run one by one with the documents left
+	result := router.deleteByQuery(testSpace2(servers), "test", &byQueryParams{query: matchAllQuery, maxDocs: 3}, "")
+	if !reflect.DeepEqual(maxDocs, []int64{3, 1}) || result.Deleted != 4 {
+		t.Fatalf("the max docs of the partitions are %v, want [3 1]", maxDocs)
+	}
+	maxDocs = nil
+	router.deleteByQuery(testSpace2(servers), "test", &byQueryParams{query: matchAllQuery, maxDocs: 2}, "")
+	if !reflect.DeepEqual(servers.calls[2:], []string{"a"}) {
+		t.Fatalf("the partitions called after max docs are %v", servers.calls[2:])
+	}
+
+	// the partitions left are not run after a version conflict
+	servers = &fakeServers{byQuery: map[string]func(int64) *pspb.ByQueryResponse{
+		"a": func(int64) *pspb.ByQueryResponse {
+			return &pspb.ByQueryResponse{Total: 2, Deleted: 1, VersionConflicts: 1}
+		},
+		"b": reply,
+	}}
+	result = router.deleteByQuery(testSpace2(servers), "test", &byQueryParams{query: matchAllQuery, maxDocs: 10}, "")
+	if len(servers.calls) != 1 || result.VersionConflicts != 1 {
+		t.Fatalf("the partitions called after the conflict are %v", servers.calls)
+	}
+}
+
+func TestParseByQuery(t *testing.T) {
+	tests := []struct {
+		url    string
+		body   string
+		update bool
+		want   *byQueryParams
+		err    bool
+	}{
+		{url: "/i/_delete_by_query?conflicts=proceed&scroll_size=10", body: `{"query": {"term": {"a": 1}}}`,
+			want: &byQueryParams{query: []byte(`{"term": {"a": 1}}`), batchSize: 10, proceed: true}},
+		{url: "/i/_delete_by_query?max_docs=5", body: `{"query": {"term": {"a": 1}}, "conflicts": "proceed"}`,
+			want: &byQueryParams{query: []byte(`{"term": {"a": 1}}`), maxDocs: 5, proceed: true}},
+		{url: "/i/_update_by_query", body: `{"doc": {"a": 2}}`, update: true,
+			want: &byQueryParams{query: matchAllQuery, doc: []byte(`{"a": 2}`)}},
+		{url: "/i/_delete_by_query", body: ``, err: true},
+		{url: "/i/_delete_by_query", body: `{"query": {"match_all": {}}, "doc": {"a": 2}}`, err: true},
+		{url: "/i/_update_by_query", body: `{"script": {"source": "ctx._source.a++"}}`, update: true, err: true},
+		{url: "/i/_update_by_query", body: `{"doc": [1]}`, update: true, err: true},
+		{url: "/i/_update_by_query?conflicts=retry", update: true, err: true},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if p := recover(); (p != nil) != test.err {
+					t.Errorf("%s %s: got error %v, want error %v", test.url, test.body, p, test.err)
+				}
+			}()
+			params := parseByQuery(httptest.NewRequest("POST", test.url, nil), []byte(test.body), test.update)
+			if !reflect.DeepEqual(params, test.want) {
+				t.Errorf("%s %s: got %+v, want %+v", test.url, test.body, params, test.want)
+			}
+		}()
+	}
+}
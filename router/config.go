@@ -1,8 +1,10 @@
 package router
 
 import (
+	"fmt"
 	"github.com/BurntSushi/toml"
 	"github.com/tiglabs/baudengine/util/log"
+	"strings"
 	"time"
 )
 
@@ -19,6 +21,16 @@ masterAddr = "localhost:18817"
 logDir = "/export/log/ps"
 masterConnPoolSize = 10
 psConnPoolSize = 10
+# the max bytes of a _bulk request
+bulkMaxSize = 104857600
+# the max concurrent Bulk requests of a partition, 0 means no limit
+bulkConcurrency = 4
+
+[es]
+# the port of the elasticsearch compatible api, 0 disables it
+httpPort = 9200
+# the db of the indices without a db name
+defaultDb = "default"
 
 [log]
 log-path = "/tmp/baudengine/router/log"
@@ -37,6 +49,8 @@ type ModuleConfig struct {
 	MasterAddr         string
 	MasterConnPoolSize uint16
 	PsConnPoolSize     uint16
+	BulkMaxSize        uint64
+	BulkConcurrency    uint16
 }
 
 type LogConfig struct {
@@ -44,8 +58,17 @@ type LogConfig struct {
 	Level     string `toml:"level,omitempty" json:"level"`
 }
 
+// EsConfig is the elasticsearch compatible api, an index is the space of "db.space",
+// the space of the default db, or the "db.space" of the index in Indices.
+type EsConfig struct {
+	HttpPort  uint16            `toml:"httpPort,omitempty" json:"httpPort"`
+	DefaultDb string            `toml:"defaultDb,omitempty" json:"defaultDb"`
+	Indices   map[string]string `toml:"indices,omitempty" json:"indices"`
+}
+
 type Config struct {
 	ModuleCfg  ModuleConfig  `toml:"module,omitempty" json:"module"`
+	EsCfg      EsConfig      `toml:"es,omitempty" json:"es"`
 	LogCfg     LogConfig     `toml:"log,omitempty" json:"log"`
 }
 
@@ -70,5 +93,10 @@ func (config *Config) LoadFromFile(fileName string) error {
 }
 
 func (config *Config) validate() error {
+	for index, name := range config.EsCfg.Indices {
+		if strings.Count(name, ".") != 1 {
+			return fmt.Errorf("index %s must be mapped to db.space, not %s", index, name)
+		}
+	}
 	return nil
 }
@@ -3,10 +3,38 @@
 ## Document API
 the CRUD operations:
 create: PUT dbname/spacename
+index: PUT dbname/spacename/docid, creates or replaces the document of the id given by the client
+bulk: POST dbname/spacename/_bulk, ndjson of index, create, update and delete actions on the space,
+	the actions are sent in a Bulk request per partition and the partitions are written in parallel,
+	the results and failures of the items are in the order of the actions;
+	module.bulkMaxSize limits the body, module.bulkConcurrency the concurrent Bulk requests of a partition
 read: GET dbname/spacename/docid
 update: POST dbname/spacename/docid
 delete: DELETE dbname/spacename/docid
+the documents are read and written by the ApiGrpc service (Get, MultiGet, Bulk, Search, Count) of the partition servers
+analyze: GET/POST dbname/spacename/_analyze, analyzes text by an analyzer, a field or a tokenizer and filters of the space
+search: GET/POST dbname/spacename/_search, searches all the partitions of the space by query then fetch,
+	the hits are merged by score or sort values, the timeout (url or body, such as "1s") may return partial results
 Partial Update, Conditional Update
+routing: the documents are routed to the slots by the KeyPolicy of the space,
+	key_func is murmur3 (default), crc32 or identity (the order of the keys for range partitions),
+	key_field is a field, or the comma separated fields of a compound key, strings, numbers, booleans and arrays are keyed,
+	the documents without the key fields are routed by their ids, ?routing=value1,value2 overrides the key on
+	create, read, update and delete; the reads without routing go to all the partitions if the space has key fields
+
+## Elasticsearch compatible API
+served on es.httpPort, the index is the space of "db.space", the space of es.defaultDb, or the "db.space" of the index in [es.indices]:
+GET /                                  version of the api
+GET /_cat/indices[/pattern]            spaces of all the dbs, ?v for the header, ?format=json
+GET/HEAD /index/_doc/id                read, ?_source, ?_source_includes, ?_source_excludes, ?stored_fields
+POST /index/_doc                       create
+PUT/POST /index/_doc/id                create or replace the document
+DELETE /index/_doc/id                  delete
+?routing on the document apis, "routing" in the mget docs and the bulk actions
+GET/POST /index/_search, /index/_count
+GET/POST [/index]/_mget                {"docs": [{"_index", "_id"}]} or {"ids": []}
+POST/PUT [/index]/_bulk                ndjson of index, create, update and delete actions, batched as the bulk of the document api
+errors are replied as {"error": {"root_cause", "type", "reason"}, "status"} with the http status
 http body as JSON format to contains document
 
 implementation:
@@ -18,11 +46,15 @@ map dbname->dbInfo
 limited:
 dbname max 100 char
 spacename max 100 char
-docid 64bit
+docid string, generated on create from the slot of the document and a flake uuid (26 chars of url base64),
+	so that the reads by the generated ids go to their partitions without a broadcast
 
 update:
 1、retrieve single db+space+slots info from master when missing cache
 2、retrieve single db+space+slots info from master when ps returned error code
+3、NotLeader of the same epoch switches the leader of the cached route, NotLeader of another epoch, NoLeader,
+	PartitionNotFound and unreachable leaders evict the route; the request is retried with backoff
+	(20ms doubled up to 500ms) within its deadline on the route refreshed from the master
 
 */
 package router
@@ -0,0 +1,95 @@
+package router
+
+import (
+	"context"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/uuid"
+)
+
+// noSource is the _source of the requests which only need the ids
+var noSource = []byte("false")
+
+// document is a document found in a partition of the space
+type document struct {
+	partition *Partition
+	doc       *pspb.GetResult
+}
+
+// getDocuments reads the documents of the ids from the partitions which may have them, the routing parameter is optional.
+// source is the json of the _source of the request.
+func (router *Router) getDocuments(space *Space, ids []string, routing string, source []byte, storedFields []string) map[string]*document {
+	ctx, cancel := context.WithTimeout(space.parent.context, rpcTimeoutDef)
+	defer cancel()
+
+	routes := space.RouteIds(ids, routing)
+	partitions := make([]*Partition, 0, len(routes))
+	for partition := range routes {
+		partitions = append(partitions, partition)
+	}
+	results, errs := doPartitions(ctx, partitions, func(i int, partition *Partition) interface{} {
+		return partition.MultiGet(ctx, routes[partition], source, storedFields)
+	})
+	docs := make(map[string]*document, len(ids))
+	for i, err := range errs {
+		// a missing document may be in the failed partition
+		if err != nil {
+			panic(err)
+		}
+		result := results[i].([]pspb.GetResult)
+		for j := range result {
+			if result[j].Found {
+				docs[result[j].ID] = &document{partition: partitions[i], doc: &result[j]}
+			}
+		}
+	}
+	return docs
+}
+
+// createDocument creates the document with a new id, the documents without routing are spread by a random key
+func (router *Router) createDocument(space *Space, docBody []byte, routing string) *pspb.CreateResponse {
+	slot := space.DocSlot(uuid.FlakeUUID(), docBody, routing)
+	return space.GetPartition(slot).Create(newDocId(slot), docBody)
+}
+
+// createDocumentId creates the document of the id given by the client, an existing document is kept as a version conflict
+func (router *Router) createDocumentId(space *Space, id, routing string, docBody []byte) *pspb.CreateResponse {
+	return space.GetPartition(space.DocSlot(id, docBody, routing)).Create(id, docBody)
+}
+
+// indexDocument creates or replaces the document of the id given by the client, so that the same request is idempotent
+func (router *Router) indexDocument(space *Space, id, routing string, docBody []byte) *pspb.UpdateResponse {
+	return space.GetPartition(space.DocSlot(id, docBody, routing)).Index(id, docBody)
+}
+
+// updateDocument replaces the document, or merges docBody into it, it returns nil if the document is not found
+func (router *Router) updateDocument(space *Space, id, routing string, docBody []byte, merge bool) *pspb.UpdateResponse {
+	doc, ok := router.getDocuments(space, []string{id}, routing, noSource, nil)[id]
+	if !ok {
+		return nil
+	}
+	resp := doc.partition.Update(id, docBody, merge)
+	if resp.Result == pspb.WriteResult_NOT_FOUND {
+		return nil
+	}
+	return resp
+}
+
+// upsertDocument merges docBody into the document, or creates the document by docBody if it does not exist.
+// It is routed like indexDocument, so the document created is found by the id.
+func (router *Router) upsertDocument(space *Space, id, routing string, docBody []byte) *pspb.UpdateResponse {
+	return space.GetPartition(space.DocSlot(id, docBody, routing)).Upsert(id, docBody)
+}
+
+// deleteDocument deletes the document, it returns nil if the document is not found
+func (router *Router) deleteDocument(space *Space, id, routing string) *pspb.DeleteResponse {
+	doc, ok := router.getDocuments(space, []string{id}, routing, noSource, nil)[id]
+	if !ok {
+		return nil
+	}
+	resp := doc.partition.Delete(id)
+	if resp.Result != pspb.WriteResult_DELETED {
+		return nil
+	}
+	return resp
+}
@@ -7,6 +7,9 @@ var (
 	ErrInternalError 			= errors.New("internal error")
 	ErrSysBusy          		= errors.New("system busy")
 	ErrParamError				= errors.New("param error")
+	ErrDbNotExists				= errors.New("db not exists")
+	ErrSpaceNotExists			= errors.New("space not exists")
+	ErrDocNotExists				= errors.New("document not exists")
 )
 
 const (
@@ -17,8 +20,11 @@ const (
 )
 
 var Err2CodeMap = map[error]int32 {
-	ErrSuccess:       ERRCODE_SUCCESS,
-	ErrInternalError: ERRCODE_INTERNAL_ERROR,
-	ErrSysBusy:       ERRCODE_SYSBUSY,
-	ErrParamError:    ERRCODE_PARAM_ERROR,
+	ErrSuccess:        ERRCODE_SUCCESS,
+	ErrInternalError:  ERRCODE_INTERNAL_ERROR,
+	ErrSysBusy:        ERRCODE_SYSBUSY,
+	ErrParamError:     ERRCODE_PARAM_ERROR,
+	ErrDbNotExists:    ERRCODE_PARAM_ERROR,
+	ErrSpaceNotExists: ERRCODE_PARAM_ERROR,
+	ErrDocNotExists:   ERRCODE_PARAM_ERROR,
 }
@@ -0,0 +1,692 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+)
+
+const (
+	// the elasticsearch version of the api, the clients check it on connecting
+	esVersion = "6.8.0"
+	esDocType = "_doc"
+)
+
+// esError is an error of the elasticsearch api, replied with its http status
+type esError struct {
+	status  int
+	errType string
+	reason  string
+}
+
+func (e *esError) Error() string {
+	return e.reason
+}
+
+func (e *esError) body() map[string]interface{} {
+	cause := map[string]interface{}{"type": e.errType, "reason": e.reason}
+	return map[string]interface{}{
+		"root_cause": []interface{}{cause},
+		"type":       e.errType,
+		"reason":     e.reason,
+	}
+}
+
+func esBadRequest(format string, args ...interface{}) *esError {
+	return &esError{http.StatusBadRequest, "illegal_argument_exception", fmt.Sprintf(format, args...)}
+}
+
+func esIndexNotFound(index string) *esError {
+	return &esError{http.StatusNotFound, "index_not_found_exception", fmt.Sprintf("no such index [%s]", index)}
+}
+
+func esDocumentMissing(id string) *esError {
+	return &esError{http.StatusNotFound, "document_missing_exception", fmt.Sprintf("[%s][%s]: document missing", esDocType, id)}
+}
+
+// esVersionConflict is the error of a write conflicting with the current version of the document, exists tells it is a create of an existing document
+func esVersionConflict(id string, exists bool, version uint64) *esError {
+	reason := fmt.Sprintf("[%s][%s]: version conflict, current version [%d]", esDocType, id, version)
+	if exists {
+		reason = fmt.Sprintf("[%s][%s]: version conflict, document already exists (current version [%d])", esDocType, id, version)
+	}
+	return &esError{http.StatusConflict, "version_conflict_engine_exception", reason}
+}
+
+// toEsError converts the panics of the handlers to the errors of the elasticsearch api
+func toEsError(p interface{}) *esError {
+	switch t := p.(type) {
+	case *esError:
+		return t
+	case *HttpReply:
+		// the shared handlers reply invalid requests this way
+		if t.Code == ERRCODE_PARAM_ERROR {
+			return esBadRequest("%s", t.Msg)
+		}
+		return &esError{http.StatusInternalServerError, "exception", t.Msg}
+	case error:
+		switch {
+		case t == ErrDbNotExists || t == ErrSpaceNotExists:
+			return &esError{http.StatusNotFound, "index_not_found_exception", t.Error()}
+		case t == errRequestTimeout || t == context.DeadlineExceeded:
+			return &esError{http.StatusGatewayTimeout, "timeout_exception", t.Error()}
+		}
+		switch t.(type) {
+		case *metapb.TimeoutError:
+			return &esError{http.StatusGatewayTimeout, "timeout_exception", t.Error()}
+		case *metapb.NotLeader, *metapb.NoLeader, *metapb.PartitionNotFound:
+			// the routes are refreshed, the clients may retry
+			return &esError{http.StatusServiceUnavailable, "unavailable_shards_exception", t.Error()}
+		}
+		return &esError{http.StatusInternalServerError, "exception", t.Error()}
+	default:
+		return &esError{http.StatusInternalServerError, "exception", fmt.Sprint(p)}
+	}
+}
+
+// esDocMeta is a document in the mget docs and the bulk actions
+type esDocMeta struct {
+	Index   string `json:"_index"`
+	Id      string `json:"_id"`
+	Routing string `json:"routing,omitempty"`
+}
+
+// esGetResult is a document of the get and mget apis
+type esGetResult struct {
+	Index string `json:"_index"`
+	Type  string `json:"_type"`
+	Id    string `json:"_id"`
+	engine.DocVersion
+	Found  *bool                  `json:"found,omitempty"`
+	Source json.RawMessage        `json:"_source,omitempty"`
+	Fields json.RawMessage        `json:"fields,omitempty"`
+	Error  map[string]interface{} `json:"error,omitempty"`
+}
+
+// esWriteResult is the result of the index, create and delete apis and of the items of the bulk api
+type esWriteResult struct {
+	Index string `json:"_index"`
+	Type  string `json:"_type"`
+	Id    string `json:"_id,omitempty"`
+	engine.DocVersion
+	Result string                 `json:"result,omitempty"`
+	Shards *engine.Shards         `json:"_shards,omitempty"`
+	Status int                    `json:"status,omitempty"`
+	Error  map[string]interface{} `json:"error,omitempty"`
+}
+
+type esSearchResult struct {
+	ScrollID     string              `json:"_scroll_id,omitempty"`
+	Took         int64               `json:"took"`
+	TimedOut     bool                `json:"timed_out"`
+	Shards       engine.Shards       `json:"_shards"`
+	Hits         engine.Hits         `json:"hits"`
+	Aggregations engine.Aggregations `json:"aggregations,omitempty"`
+}
+
+var esWriteShards = &engine.Shards{Total: 1, Successful: 1}
+
+func (router *Router) startEsServer(addr string) {
+	router.esServer = &http.Server{Addr: addr, Handler: http.HandlerFunc(router.serveEs)}
+	go func() {
+		if err := router.esServer.ListenAndServe(); err != http.ErrServerClosed {
+			log.Error("elasticsearch api server on %s failed: %s", addr, err)
+		}
+	}()
+}
+
+// serveEs dispatches the elasticsearch api, the index names in the paths conflict with the endpoints in the http router
+func (router *Router) serveEs(writer http.ResponseWriter, request *http.Request) {
+	defer router.catchEsPanic(writer, request)
+
+	var parts []string
+	if p := strings.Trim(request.URL.Path, "/"); p != "" {
+		parts = strings.Split(p, "/")
+	}
+	method := request.Method
+	read := method == http.MethodGet || method == http.MethodPost
+	write := method == http.MethodPut || method == http.MethodPost
+
+	switch {
+	case len(parts) == 0 && (method == http.MethodGet || method == http.MethodHead):
+		router.esInfo(writer, request)
+	case len(parts) >= 2 && len(parts) <= 3 && parts[0] == "_cat" && parts[1] == "indices" && method == http.MethodGet:
+		pattern := "*"
+		if len(parts) == 3 {
+			pattern = parts[2]
+		}
+		router.esCatIndices(writer, request, pattern)
+	case len(parts) == 1 && parts[0] == "_bulk" && write:
+		router.esBulk(writer, request, "")
+	case len(parts) == 1 && parts[0] == "_mget" && read:
+		router.esMget(writer, request, "")
+	case len(parts) == 2 && parts[0] == "_search" && parts[1] == "scroll" && read:
+		router.esScroll(writer, request)
+	case len(parts) == 2 && parts[0] == "_search" && parts[1] == "scroll" && method == http.MethodDelete:
+		router.esClearScroll(writer, request)
+	case len(parts) == 0 || strings.HasPrefix(parts[0], "_"):
+		panic(esBadRequest("no handler found for uri [%s] and method [%s]", request.URL.Path, method))
+	case len(parts) == 2 && parts[1] == "_search" && read:
+		router.esSearch(writer, request, parts[0])
+	case len(parts) == 2 && parts[1] == "_count" && read:
+		router.esCount(writer, request, parts[0])
+	case len(parts) == 2 && parts[1] == "_bulk" && write:
+		router.esBulk(writer, request, parts[0])
+	case len(parts) == 2 && parts[1] == "_delete_by_query" && method == http.MethodPost:
+		router.esByQuery(writer, request, parts[0], false)
+	case len(parts) == 2 && parts[1] == "_update_by_query" && method == http.MethodPost:
+		router.esByQuery(writer, request, parts[0], true)
+	case len(parts) == 2 && parts[1] == "_mget" && read:
+		router.esMget(writer, request, parts[0])
+	case len(parts) == 2 && parts[1] == esDocType && method == http.MethodPost:
+		router.esCreate(writer, request, parts[0])
+	case len(parts) == 3 && parts[1] == esDocType && (method == http.MethodGet || method == http.MethodHead):
+		router.esGet(writer, request, parts[0], parts[2])
+	case len(parts) == 3 && parts[1] == esDocType && write:
+		router.esIndex(writer, request, parts[0], parts[2])
+	case len(parts) == 3 && parts[1] == esDocType && method == http.MethodDelete:
+		router.esDelete(writer, request, parts[0], parts[2])
+	case len(parts) == 3 && parts[1] == "_create" && write:
+		router.esCreateId(writer, request, parts[0], parts[2])
+	case len(parts) == 3 && parts[1] == "_update" && method == http.MethodPost:
+		router.esUpdate(writer, request, parts[0], parts[2])
+	default:
+		panic(esBadRequest("no handler found for uri [%s] and method [%s]", request.URL.Path, method))
+	}
+}
+
+func (router *Router) catchEsPanic(writer http.ResponseWriter, request *http.Request) {
+	if p := recover(); p != nil {
+		e := toEsError(p)
+		if e.status >= http.StatusInternalServerError {
+			log.Error("elasticsearch api %s %s failed: %s", request.Method, request.URL.Path, e.reason)
+		}
+		sendEsReply(writer, request, e.status, map[string]interface{}{"error": e.body(), "status": e.status})
+	}
+}
+
+func sendEsReply(writer http.ResponseWriter, request *http.Request, status int, body interface{}) {
+	reply, err := json.Marshal(body)
+	if err != nil {
+		log.Error("fail to marshal elasticsearch reply[%v]. err:[%v]", body, err)
+		status = http.StatusInternalServerError
+		reply, _ = json.Marshal(map[string]interface{}{"error": "json.Marshal() failed", "status": status})
+	}
+	writer.Header().Set("content-type", "application/json; charset=UTF-8")
+	writer.Header().Set("Content-Length", strconv.Itoa(len(reply)))
+	writer.WriteHeader(status)
+	if request.Method == http.MethodHead {
+		return
+	}
+	if _, err := writer.Write(reply); err != nil {
+		log.Error("fail to write elasticsearch reply len[%d]. err:[%v]", len(reply), err)
+	}
+}
+
+// esSpace returns the space of the index
+func (router *Router) esSpace(index string) *Space {
+	dbName, spaceName := esIndexSpace(index)
+	defer func() {
+		if p := recover(); p != nil {
+			if p == ErrDbNotExists || p == ErrSpaceNotExists {
+				panic(esIndexNotFound(index))
+			}
+			panic(p)
+		}
+	}()
+	return router.GetDB(dbName).GetSpace(spaceName)
+}
+
+// esIndexSpace returns the db and space of the index, see EsConfig
+func esIndexSpace(index string) (string, string) {
+	if name, ok := routerCfg.EsCfg.Indices[index]; ok {
+		index = name
+	}
+	if pos := strings.Index(index, "."); pos > 0 {
+		return index[:pos], index[pos+1:]
+	}
+	return routerCfg.EsCfg.DefaultDb, index
+}
+
+// esIndexName returns the index of the space, it is the reverse of esIndexSpace
+func esIndexName(dbName, spaceName string) string {
+	name := dbName + "." + spaceName
+	for index, mapped := range routerCfg.EsCfg.Indices {
+		if mapped == name {
+			return index
+		}
+	}
+	if dbName == routerCfg.EsCfg.DefaultDb && !strings.Contains(spaceName, ".") {
+		return spaceName
+	}
+	return name
+}
+
+// esFetch returns the _source json and the stored_fields of the url parameters
+func esFetch(request *http.Request) ([]byte, []string) {
+	query := request.URL.Query()
+	var fetch []byte
+	source := make(map[string]interface{})
+	switch value := query.Get("_source"); value {
+	case "":
+	case "true", "false":
+		fetch = []byte(value)
+	default:
+		source["includes"] = strings.Split(value, ",")
+	}
+	if value := query.Get("_source_includes"); value != "" {
+		source["includes"] = strings.Split(value, ",")
+	}
+	if value := query.Get("_source_excludes"); value != "" {
+		source["excludes"] = strings.Split(value, ",")
+	}
+	if len(source) > 0 {
+		fetch, _ = json.Marshal(source)
+	}
+	var storedFields []string
+	if value := query.Get("stored_fields"); value != "" {
+		storedFields = strings.Split(value, ",")
+	}
+	return fetch, storedFields
+}
+
+func esGetDocument(index, id string, doc *document) *esGetResult {
+	found := doc != nil
+	result := &esGetResult{Index: index, Type: esDocType, Id: id, Found: &found}
+	if found {
+		result.DocVersion = engine.DocVersion{Version: doc.doc.Version, SeqNo: doc.doc.SeqNo, PrimaryTerm: doc.doc.PrimaryTerm}
+		result.Source, result.Fields = doc.doc.Source, doc.doc.Fields
+	}
+	return result
+}
+
+func (router *Router) esInfo(writer http.ResponseWriter, request *http.Request) {
+	sendEsReply(writer, request, http.StatusOK, map[string]interface{}{
+		"name":         "router",
+		"cluster_name": routerCfg.ModuleCfg.ClusterId,
+		"version":      map[string]interface{}{"number": esVersion, "build_flavor": "baudengine"},
+		"tagline":      "You Know, for Search",
+	})
+}
+
+// esCatIndices lists the spaces of all the dbs as indices, in json if the format parameter is json
+func (router *Router) esCatIndices(writer http.ResponseWriter, request *http.Request, pattern string) {
+	var rows []map[string]string
+	for _, space := range router.masterClient.GetSpaces("") {
+		index := esIndexName(space.DbName, space.Name)
+		if ok, err := path.Match(pattern, index); err != nil {
+			panic(esBadRequest("invalid index pattern [%s]", pattern))
+		} else if !ok {
+			continue
+		}
+		status := strings.ToLower(strings.TrimPrefix(space.Status.String(), "SS_"))
+		if space.Status == metapb.SS_Running {
+			status = "open"
+		}
+		rows = append(rows, map[string]string{
+			"index":  index,
+			"uuid":   fmt.Sprintf("%d.%d", space.DB, space.ID),
+			"status": status,
+			"db":     space.DbName,
+			"space":  space.Name,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i]["index"] < rows[j]["index"]
+	})
+	if len(rows) == 0 && !strings.Contains(pattern, "*") {
+		panic(esIndexNotFound(pattern))
+	}
+
+	query := request.URL.Query()
+	if query.Get("format") == "json" {
+		if rows == nil {
+			rows = make([]map[string]string, 0)
+		}
+		sendEsReply(writer, request, http.StatusOK, rows)
+		return
+	}
+	columns := []string{"status", "index", "uuid", "db", "space"}
+	buf := new(bytes.Buffer)
+	table := tabwriter.NewWriter(buf, 0, 0, 1, ' ', 0)
+	if _, verbose := query["v"]; verbose {
+		fmt.Fprintln(table, strings.Join(columns, "\t"))
+	}
+	for _, row := range rows {
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			values = append(values, row[column])
+		}
+		fmt.Fprintln(table, strings.Join(values, "\t"))
+	}
+	table.Flush()
+	writer.Header().Set("content-type", "text/plain; charset=UTF-8")
+	writer.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	writer.WriteHeader(http.StatusOK)
+	writer.Write(buf.Bytes())
+}
+
+func (router *Router) esSearch(writer http.ResponseWriter, request *http.Request, index string) {
+	space := router.esSpace(index)
+	body := router.readDocBody(request)
+	query := request.URL.Query()
+	if query.Get("from") != "" || query.Get("size") != "" {
+		raw := make(map[string]json.RawMessage)
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &raw); err != nil {
+				panic(esBadRequest("invalid search request: %v", err))
+			}
+		}
+		set := make(map[string]interface{})
+		for _, name := range []string{"from", "size"} {
+			if value := query.Get(name); value != "" {
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					panic(esBadRequest("invalid %s [%s]", name, value))
+				}
+				set[name] = n
+			}
+		}
+		body = searchBody(raw, set)
+	}
+
+	var result *engine.SearchResult
+	if scroll := query.Get("scroll"); scroll != "" {
+		keepAlive, err := scrollKeepAlive(scroll)
+		if err != nil {
+			panic(esBadRequest("%v", err))
+		}
+		result = router.searchScroll(space, index, body, query.Get("timeout"), keepAlive)
+	} else {
+		result = router.search(space, body, query.Get("timeout"))
+	}
+	sendEsSearchResult(writer, request, index, result)
+}
+
+func sendEsSearchResult(writer http.ResponseWriter, request *http.Request, index string, result *engine.SearchResult) {
+	for i := range result.Hits.Hits {
+		result.Hits.Hits[i].Index, result.Hits.Hits[i].Type = index, esDocType
+	}
+	sendEsReply(writer, request, http.StatusOK, &esSearchResult{
+		ScrollID:     result.ScrollID,
+		Took:         result.Took,
+		TimedOut:     result.TimeOut,
+		Shards:       result.Shards,
+		Hits:         result.Hits,
+		Aggregations: result.Aggregations,
+	})
+}
+
+// esScroll returns the next page of {"scroll": "1m", "scroll_id": ""}, or of the url parameters
+func (router *Router) esScroll(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	req := struct {
+		Scroll   string `json:"scroll"`
+		ScrollId string `json:"scroll_id"`
+	}{Scroll: query.Get("scroll"), ScrollId: query.Get("scroll_id")}
+	if body := router.readDocBody(request); len(body) > 0 {
+		if err := json.Unmarshal(body, &req); err != nil {
+			panic(esBadRequest("invalid scroll request: %v", err))
+		}
+	}
+	if req.ScrollId == "" {
+		panic(esBadRequest("scroll_id is missing"))
+	}
+	keepAlive := time.Duration(0)
+	if req.Scroll != "" {
+		var err error
+		if keepAlive, err = scrollKeepAlive(req.Scroll); err != nil {
+			panic(esBadRequest("%v", err))
+		}
+	}
+	result, scroll := router.scroll(req.ScrollId, keepAlive, query.Get("timeout"))
+	sendEsSearchResult(writer, request, scroll.Index, result)
+}
+
+// esClearScroll releases the scrolls of {"scroll_id": ["", ""]}, or of the comma separated scroll_id parameter
+func (router *Router) esClearScroll(writer http.ResponseWriter, request *http.Request) {
+	var scrollIds []string
+	if value := request.URL.Query().Get("scroll_id"); value != "" {
+		scrollIds = strings.Split(value, ",")
+	}
+	if body := router.readDocBody(request); len(body) > 0 {
+		req := struct {
+			ScrollId json.RawMessage `json:"scroll_id"`
+		}{}
+		if err := json.Unmarshal(body, &req); err != nil {
+			panic(esBadRequest("invalid clear scroll request: %v", err))
+		}
+		var id string
+		var ids []string
+		switch {
+		case len(req.ScrollId) == 0:
+		case json.Unmarshal(req.ScrollId, &id) == nil:
+			scrollIds = append(scrollIds, id)
+		case json.Unmarshal(req.ScrollId, &ids) == nil:
+			scrollIds = append(scrollIds, ids...)
+		default:
+			panic(esBadRequest("invalid scroll_id %s", req.ScrollId))
+		}
+	}
+	if len(scrollIds) == 0 {
+		panic(esBadRequest("scroll_id is missing"))
+	}
+	freed := router.clearScroll(scrollIds)
+	status := http.StatusOK
+	if freed == 0 {
+		status = http.StatusNotFound
+	}
+	sendEsReply(writer, request, status, map[string]interface{}{"succeeded": true, "num_freed": freed})
+}
+
+func (router *Router) esCount(writer http.ResponseWriter, request *http.Request, index string) {
+	space := router.esSpace(index)
+	req := struct {
+		Query json.RawMessage `json:"query"`
+	}{}
+	if body := router.readDocBody(request); len(body) > 0 {
+		if err := json.Unmarshal(body, &req); err != nil {
+			panic(esBadRequest("invalid count request: %v", err))
+		}
+	}
+	count, shards, timedOut := router.count(space, req.Query, request.URL.Query().Get("timeout"))
+	sendEsReply(writer, request, http.StatusOK, map[string]interface{}{
+		"count":     count,
+		"timed_out": timedOut,
+		"_shards":   shards,
+	})
+}
+
+func (router *Router) esGet(writer http.ResponseWriter, request *http.Request, index, id string) {
+	space := router.esSpace(index)
+	source, storedFields := esFetch(request)
+	result := esGetDocument(index, id, router.getDocuments(space, []string{id}, request.URL.Query().Get("routing"), source, storedFields)[id])
+	status := http.StatusOK
+	if !*result.Found {
+		status = http.StatusNotFound
+	}
+	sendEsReply(writer, request, status, result)
+}
+
+func (router *Router) esCreate(writer http.ResponseWriter, request *http.Request, index string) {
+	space := router.esSpace(index)
+	sendEsCreated(writer, request, index, router.createDocument(space, router.readDocBody(request), request.URL.Query().Get("routing")))
+}
+
+// esCreateId creates the document of the id, it fails by a version conflict if the document exists
+func (router *Router) esCreateId(writer http.ResponseWriter, request *http.Request, index, id string) {
+	space := router.esSpace(index)
+	resp := router.createDocumentId(space, id, request.URL.Query().Get("routing"), router.readDocBody(request))
+	if resp.Result == pspb.WriteResult_VERSION_CONFLICT {
+		panic(esVersionConflict(id, true, resp.Version))
+	}
+	sendEsCreated(writer, request, index, resp)
+}
+
+func sendEsCreated(writer http.ResponseWriter, request *http.Request, index string, resp *pspb.CreateResponse) {
+	result := &esWriteResult{Index: index, Type: esDocType, Id: string(resp.ID), Result: "created", Shards: esWriteShards}
+	result.DocVersion = engine.DocVersion{Version: resp.Version, SeqNo: resp.SeqNo, PrimaryTerm: resp.PrimaryTerm}
+	sendEsReply(writer, request, http.StatusCreated, result)
+}
+
+// esIndex creates or replaces the document of the id, or only creates it by op_type=create
+func (router *Router) esIndex(writer http.ResponseWriter, request *http.Request, index, id string) {
+	switch opType := request.URL.Query().Get("op_type"); opType {
+	case "create":
+		router.esCreateId(writer, request, index, id)
+		return
+	case "", "index":
+	default:
+		panic(esBadRequest("opType must be 'create' or 'index', found: [%s]", opType))
+	}
+	space := router.esSpace(index)
+	resp := router.indexDocument(space, id, request.URL.Query().Get("routing"), router.readDocBody(request))
+	sendEsUpdated(writer, request, index, id, resp)
+}
+
+// esUpdate merges the doc of the body into the document of the id, or creates the document by the doc with doc_as_upsert
+func (router *Router) esUpdate(writer http.ResponseWriter, request *http.Request, index, id string) {
+	space := router.esSpace(index)
+	req := struct {
+		Doc         json.RawMessage `json:"doc"`
+		DocAsUpsert bool            `json:"doc_as_upsert"`
+		Script      json.RawMessage `json:"script"`
+	}{}
+	if err := json.Unmarshal(router.readDocBody(request), &req); err != nil {
+		panic(esBadRequest("invalid update request: %v", err))
+	}
+	switch {
+	case len(req.Script) > 0:
+		panic(esBadRequest("script is not supported, use doc to merge a partial document"))
+	case len(req.Doc) == 0:
+		panic(esBadRequest("the doc of the update is missing"))
+	}
+	routing := request.URL.Query().Get("routing")
+	var resp *pspb.UpdateResponse
+	if req.DocAsUpsert {
+		resp = router.upsertDocument(space, id, routing, req.Doc)
+	} else if resp = router.updateDocument(space, id, routing, req.Doc, true); resp == nil {
+		panic(esDocumentMissing(id))
+	}
+	sendEsUpdated(writer, request, index, id, resp)
+}
+
+func sendEsUpdated(writer http.ResponseWriter, request *http.Request, index, id string, resp *pspb.UpdateResponse) {
+	result := &esWriteResult{Index: index, Type: esDocType, Id: id, Result: "updated", Shards: esWriteShards}
+	result.DocVersion = engine.DocVersion{Version: resp.Version, SeqNo: resp.SeqNo, PrimaryTerm: resp.PrimaryTerm}
+	status := http.StatusOK
+	switch resp.Result {
+	case pspb.WriteResult_CREATED:
+		result.Result, status = "created", http.StatusCreated
+	case pspb.WriteResult_NOOP:
+		result.Result = "noop"
+	}
+	sendEsReply(writer, request, status, result)
+}
+
+func (router *Router) esDelete(writer http.ResponseWriter, request *http.Request, index, id string) {
+	space := router.esSpace(index)
+	result := &esWriteResult{Index: index, Type: esDocType, Id: id, Result: "deleted", Shards: esWriteShards}
+	status := http.StatusOK
+	if resp := router.deleteDocument(space, id, request.URL.Query().Get("routing")); resp != nil {
+		result.DocVersion = engine.DocVersion{Version: resp.Version, SeqNo: resp.SeqNo, PrimaryTerm: resp.PrimaryTerm}
+	} else {
+		result.Result, status = "not_found", http.StatusNotFound
+	}
+	sendEsReply(writer, request, status, result)
+}
+
+// esMget reads the docs of {"docs": [{"_index", "_id"}]} or the ids of {"ids": []} in the index of the path
+func (router *Router) esMget(writer http.ResponseWriter, request *http.Request, index string) {
+	req := struct {
+		Docs []esDocMeta `json:"docs"`
+		Ids  []string    `json:"ids"`
+	}{}
+	if err := json.Unmarshal(router.readDocBody(request), &req); err != nil {
+		panic(esBadRequest("invalid mget request: %v", err))
+	}
+	for _, id := range req.Ids {
+		req.Docs = append(req.Docs, esDocMeta{Id: id})
+	}
+	// the docs are read by their index and routing
+	type docGroup struct {
+		index   string
+		routing string
+	}
+	ids := make(map[docGroup][]string)
+	for i, doc := range req.Docs {
+		if doc.Index == "" {
+			if index == "" {
+				panic(esBadRequest("index is missing for doc %d", i))
+			}
+			req.Docs[i].Index = index
+		}
+		group := docGroup{req.Docs[i].Index, doc.Routing}
+		ids[group] = append(ids[group], doc.Id)
+	}
+
+	source, storedFields := esFetch(request)
+	docs := make(map[docGroup]map[string]*document, len(ids))
+	errs := make(map[docGroup]*esError)
+	for group, groupIds := range ids {
+		func() {
+			defer func() {
+				if p := recover(); p != nil {
+					errs[group] = toEsError(p)
+				}
+			}()
+			docs[group] = router.getDocuments(router.esSpace(group.index), groupIds, group.routing, source, storedFields)
+		}()
+	}
+
+	results := make([]*esGetResult, 0, len(req.Docs))
+	for _, doc := range req.Docs {
+		group := docGroup{doc.Index, doc.Routing}
+		if e, ok := errs[group]; ok {
+			results = append(results, &esGetResult{Index: doc.Index, Type: esDocType, Id: doc.Id, Error: e.body()})
+			continue
+		}
+		results = append(results, esGetDocument(doc.Index, doc.Id, docs[group][doc.Id]))
+	}
+	sendEsReply(writer, request, http.StatusOK, map[string]interface{}{"docs": results})
+}
+
+// esBulk runs the index, create, update and delete actions of the ndjson body batched by their partitions
+func (router *Router) esBulk(writer http.ResponseWriter, request *http.Request, index string) {
+	items, err := parseBulk(request, index)
+	if err != nil {
+		panic(err)
+	}
+	sendEsReply(writer, request, http.StatusOK, router.bulk(items, request.URL.Query().Get("timeout"), router.esSpace))
+}
+
+// esByQuery deletes or updates the documents of the index matching the query,
+// it replies 409 if the run is aborted by a version conflict like elasticsearch
+func (router *Router) esByQuery(writer http.ResponseWriter, request *http.Request, index string, update bool) {
+	space := router.esSpace(index)
+	params := parseByQuery(request, router.readDocBody(request), update)
+	var result *byQueryResult
+	if update {
+		result = router.updateByQuery(space, index, params, request.URL.Query().Get("timeout"))
+	} else {
+		result = router.deleteByQuery(space, index, params, request.URL.Query().Get("timeout"))
+	}
+	status := http.StatusOK
+	if result.VersionConflicts > 0 && !params.proceed {
+		status = http.StatusConflict
+	}
+	sendEsReply(writer, request, status, result)
+}
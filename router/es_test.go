@@ -0,0 +1,74 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+// testEsRouter is a router of the index "test" of the space of the servers
+func testEsRouter(servers *fakeServers) *Router {
+	space := testSpace2(servers)
+	routerCfg.EsCfg.DefaultDb = "db"
+	space.parent.spaceMap.Store("test", space)
+	router := &Router{}
+	router.dbMap.Store("db", space.parent)
+	return router
+}
+
+func TestEsWrite(t *testing.T) {
+	// the document "1" exists, the servers find it by the ids of any request
+	servers := docServers(map[string]bool{"1": true}, make(map[string][]string))
+	servers.replies = map[string]func() (*pspb.GetResponse, error){"a": okReply, "b": okReply}
+	router := testEsRouter(servers)
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		status int
+		// the result of the reply, or the type of the error of the reply
+		result string
+		err    string
+	}{
+		{method: "PUT", path: "/test/_doc/1?op_type=create", body: `{"a": 1}`, status: http.StatusConflict, err: "version_conflict_engine_exception"},
+		{method: "PUT", path: "/test/_doc/2?op_type=create", body: `{"a": 1}`, status: http.StatusCreated, result: "created"},
+		{method: "PUT", path: "/test/_doc/1?op_type=upsert", body: `{"a": 1}`, status: http.StatusBadRequest, err: "illegal_argument_exception"},
+		{method: "PUT", path: "/test/_create/1", body: `{"a": 1}`, status: http.StatusConflict, err: "version_conflict_engine_exception"},
+		{method: "POST", path: "/test/_create/3", body: `{"a": 1}`, status: http.StatusCreated, result: "created"},
+		{method: "PUT", path: "/test/_doc/1", body: `{"a": 1}`, status: http.StatusOK, result: "updated"},
+		{method: "PUT", path: "/test/_doc/4", body: `{"a": 1}`, status: http.StatusCreated, result: "created"},
+		{method: "POST", path: "/test/_doc", body: `{"a": 1}`, status: http.StatusCreated, result: "created"},
+		{method: "POST", path: "/test/_update/1", body: `{"doc": {"a": 2}}`, status: http.StatusOK, result: "updated"},
+		{method: "POST", path: "/test/_update/9", body: `{"doc": {"a": 2}}`, status: http.StatusNotFound, err: "document_missing_exception"},
+		{method: "POST", path: "/test/_update/9", body: `{"doc": {"a": 2}, "doc_as_upsert": true}`, status: http.StatusCreated, result: "created"},
+		{method: "POST", path: "/test/_update/1", body: `{"script": {"source": "ctx._source.a++"}}`, status: http.StatusBadRequest, err: "illegal_argument_exception"},
+		{method: "POST", path: "/test/_update/1", body: `{}`, status: http.StatusBadRequest, err: "illegal_argument_exception"},
+		{method: "PUT", path: "/test/_update/1", body: `{"doc": {}}`, status: http.StatusBadRequest, err: "illegal_argument_exception"},
+	}
+	for _, test := range tests {
+		recorder := httptest.NewRecorder()
+		router.serveEs(recorder, httptest.NewRequest(test.method, test.path, strings.NewReader(test.body)))
+		reply := struct {
+			Result string `json:"result"`
+			Status int    `json:"status"`
+			Error  struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error"`
+		}{}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &reply); err != nil {
+			t.Fatalf("%s %s: bad reply %s", test.method, test.path, recorder.Body)
+		}
+		if recorder.Code != test.status || reply.Result != test.result || reply.Error.Type != test.err {
+			t.Errorf("%s %s: got %d %s, want %d %s%s", test.method, test.path, recorder.Code, recorder.Body, test.status, test.result, test.err)
+		}
+		if test.err != "" && (reply.Status != test.status || reply.Error.Reason == "") {
+			t.Errorf("%s %s: bad error body %s", test.method, test.path, recorder.Body)
+		}
+	}
+}
@@ -12,7 +12,7 @@ import (
 )
 
 type MasterClient struct {
-	client     *rpc.Client
+	client     clientPool
 	masterAddr string
 	context    context.Context
 	cancelFunc context.CancelFunc
@@ -64,6 +64,16 @@ func (mc *MasterClient) GetSpace(id metapb.DBID, spaceName string) metapb.Space
 	return resp.Space
 }
 
+// GetSpaces returns the spaces of the db, or the spaces of all the dbs if the db name is empty
+func (mc *MasterClient) GetSpaces(dbName string) []metapb.Space {
+	request := &masterpb.GetSpacesRequest{DBName: dbName}
+	ctx, cancel := mc.getContext()
+	defer cancel()
+	resp, err := mc.getClient().GetSpaces(ctx, request)
+	mc.checkResponseOk(&resp.ResponseHeader, err)
+	return resp.Spaces
+}
+
 func (mc *MasterClient) getContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(mc.context, rpcTimeoutDef)
 }
@@ -82,8 +92,13 @@ func (mc *MasterClient) checkResponseOk(header *metapb.ResponseHeader, err error
 		panic(err)
 	}
 	if header.Code != metapb.RESP_CODE_OK {
-		if header.Code == metapb.MASTER_RESP_CODE_NOT_LEADER {
+		switch header.Code {
+		case metapb.MASTER_RESP_CODE_NOT_LEADER:
 			mc.masterAddr = header.Error.NotLeader.LeaderAddr
+		case metapb.MASTER_RESP_CODE_DB_NOTEXISTS:
+			panic(ErrDbNotExists)
+		case metapb.MASTER_RESP_CODE_SPACE_NOTEXISTS:
+			panic(ErrSpaceNotExists)
 		}
 		panic(errors.New(header.Message))
 	}
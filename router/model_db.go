@@ -9,13 +9,14 @@ import (
 type DB struct {
 	meta         metapb.DB
 	masterClient *MasterClient
+	psClient     clientPool
 	spaceMap     sync.Map
 	context      context.Context
 }
 
-func NewDB(masterClient *MasterClient, meta metapb.DB) *DB {
+func NewDB(masterClient *MasterClient, psClient clientPool, meta metapb.DB) *DB {
 	ctx, _ := context.WithCancel(context.Background())
-	return &DB{meta: meta, masterClient: masterClient, context: ctx}
+	return &DB{meta: meta, masterClient: masterClient, psClient: psClient, context: ctx}
 }
 
 func (db *DB) GetSpace(spaceName string) *Space {
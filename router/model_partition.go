@@ -2,34 +2,49 @@ package router
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"github.com/tiglabs/baudengine/common/keys"
+	"github.com/tiglabs/baudengine/engine"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/proto/pspb"
-	"github.com/tiglabs/baudengine/util/rpc"
-	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
 	"github.com/tiglabs/baudengine/util/log"
 )
 
+const (
+	retryBackoffMin = 20 * time.Millisecond
+	retryBackoffMax = 500 * time.Millisecond
+)
+
 type Partition struct {
-	meta          metapb.Partition
-	route         masterpb.Route
-	parent        *Space
-	psClient      *rpc.Client
-	leaderAddr    string
-	requestHeader pspb.ActionRequestHeader
+	meta       metapb.Partition
+	route      masterpb.Route
+	parent     *Space
+	leaderAddr string
+	lock       sync.RWMutex
+	// bulkLimit limits the concurrent Bulk requests of the partition, nil means no limit
+	bulkLimit  chan struct{}
+}
+
+// clientPool returns the grpc client of a server address, such as a *rpc.Client.
+// The partition servers are called by a pool shared by all the partitions, so the connections
+// are not dropped along with the routes evicted or replaced.
+type clientPool interface {
+	GetGrpcClient(addr string) (interface{}, error)
 }
 
+// psCall sends a request to the partition p by the client of its leader, it returns the header of the response
+type psCall func(p *Partition, client pspb.ApiGrpcClient) (*metapb.ResponseHeader, error)
+
 func NewPartition(parent *Space, route masterpb.Route) *Partition {
 	partition := &Partition{meta: route.Partition, parent: parent, route: route}
-	connMgrOpt := rpc.DefaultManagerOption
-	connMgr := rpc.NewConnectionMgr(parent.parent.context, &connMgrOpt)
-	clientOpt := rpc.DefaultClientOption
-	clientOpt.ClusterID = routerCfg.ModuleCfg.ClusterId
-	clientOpt.ConnectMgr = connMgr
-	clientOpt.CreateFunc = func(clientConn *grpc.ClientConn) interface{} { return pspb.NewApiGrpcClient(clientConn) }
-	partition.psClient = rpc.NewClient(1, &clientOpt)
+	if routerCfg.ModuleCfg.BulkConcurrency > 0 {
+		partition.bulkLimit = make(chan struct{}, routerCfg.ModuleCfg.BulkConcurrency)
+	}
 	for _, node := range route.Nodes {
 		if node.ID == route.Leader {
 			partition.leaderAddr = node.RpcAddr
@@ -42,76 +57,308 @@ func NewPartition(parent *Space, route masterpb.Route) *Partition {
 	return partition
 }
 
-func (partition *Partition) Create(docBody []byte) *metapb.DocID {
-	createReq := pspb.BulkItemRequest{
-		OpType: pspb.OpType_CREATE,
-		Index:  &pspb.IndexRequest{Source: docBody},
-	}
-	request := &pspb.BulkRequest{
-		ActionRequestHeader: partition.requestHeader,
-		Requests:            []pspb.BulkItemRequest{createReq},
-	}
+// Get reads the document of the id, source is the json of the _source of the request and the whole _source if empty
+func (partition *Partition) Get(id string, source []byte, storedFields []string) *pspb.GetResult {
 	ctx, cancel := partition.getContext()
 	defer cancel()
-	resp := partition.getSingleResponse(partition.getClient().BulkWrite(ctx, request)).Index
-	docId, err := keys.DecodeDocIDFromString(resp.Id)
-	if err != nil {
-		panic(err)
+	var resp *pspb.GetResponse
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := &pspb.GetRequest{PartitionID: p.meta.ID, ID: id, Source: source, StoredFields: storedFields}
+		setRequestTimeout(ctx, &request.RequestHeader)
+		if resp, err = client.Get(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+	return &resp.Doc
+}
+
+// MultiGet reads the documents of the ids in the order of the ids
+func (partition *Partition) MultiGet(ctx context.Context, ids []string, source []byte, storedFields []string) []pspb.GetResult {
+	var resp *pspb.MultiGetResponse
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := &pspb.MultiGetRequest{PartitionID: p.meta.ID, IDs: ids, Source: source, StoredFields: storedFields}
+		setRequestTimeout(ctx, &request.RequestHeader)
+		if resp, err = client.MultiGet(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+	return resp.Docs
+}
+
+// Bulk writes the documents in a raft proposal, the responses are in the order of the requests
+func (partition *Partition) Bulk(ctx context.Context, requests []pspb.RequestUnion) []pspb.ResponseUnion {
+	if partition.bulkLimit != nil {
+		select {
+		case partition.bulkLimit <- struct{}{}:
+			defer func() { <-partition.bulkLimit }()
+		case <-ctx.Done():
+			panic(errRequestTimeout)
+		}
 	}
-	return docId
+	var resp *pspb.BulkResponse
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := &pspb.BulkRequest{PartitionID: p.meta.ID, Requests: requests}
+		setRequestTimeout(ctx, &request.RequestHeader)
+		if resp, err = client.Bulk(ctx, request); err != nil {
+			log.Error("send bulk request failed: %s", err.Error())
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+	if len(resp.Responses) != len(requests) {
+		panic(errors.New("bad responses of the bulk request"))
+	}
+	return resp.Responses
 }
 
-func (partition *Partition) Read(docId *metapb.DocID) metapb.Value {
-	request := &pspb.GetRequest{ActionRequestHeader: partition.requestHeader, Id:*docId}
-	ctx, cancel := partition.getContext()
-	defer cancel()
-	resp, err := partition.getClient().Get(ctx, request)
-	if err != nil {
-		panic(err)
+func (partition *Partition) Create(id string, docBody []byte) *pspb.CreateResponse {
+	request := pspb.RequestUnion{
+		OpType: pspb.OpType_CREATE,
+		Create: &pspb.CreateRequest{ID: metapb.Key(id), Data: docBody},
+	}
+	return partition.getSingleResponse(request).Create
+}
+
+// Update replaces the document, or merges docBody into it as a merge patch
+func (partition *Partition) Update(id string, docBody []byte, merge bool) *pspb.UpdateResponse {
+	request := pspb.RequestUnion{
+		OpType: pspb.OpType_UPDATE,
+		Update: &pspb.UpdateRequest{ID: metapb.Key(id), Data: docBody, Merge: merge},
 	}
-	return resp.Fields
+	return partition.getSingleResponse(request).Update
 }
 
-func (partition *Partition) Update(docId *metapb.DocID, docBody []byte) {
-	createReq := pspb.BulkItemRequest{
+// Upsert merges docBody into the document as a merge patch, or creates the document if it does not exist
+func (partition *Partition) Upsert(id string, docBody []byte) *pspb.UpdateResponse {
+	request := pspb.RequestUnion{
 		OpType: pspb.OpType_UPDATE,
-		Update: &pspb.UpdateRequest{Id: *docId, Doc: docBody},
+		Update: &pspb.UpdateRequest{ID: metapb.Key(id), Data: docBody, Merge: true, Upsert: true},
 	}
-	request := &pspb.BulkRequest{
-		ActionRequestHeader: partition.requestHeader,
-		Requests:            []pspb.BulkItemRequest{createReq},
+	return partition.getSingleResponse(request).Update
+}
+
+// Index creates or replaces the document
+func (partition *Partition) Index(id string, docBody []byte) *pspb.UpdateResponse {
+	request := pspb.RequestUnion{
+		OpType: pspb.OpType_UPDATE,
+		Update: &pspb.UpdateRequest{ID: metapb.Key(id), Data: docBody, Upsert: true},
+	}
+	return partition.getSingleResponse(request).Update
+}
+
+func (partition *Partition) Delete(id string) *pspb.DeleteResponse {
+	request := pspb.RequestUnion{
+		OpType: pspb.OpType_DELETE,
+		Delete: &pspb.DeleteRequest{ID: metapb.Key(id)},
 	}
+	return partition.getSingleResponse(request).Delete
+}
+
+// DeleteByQuery deletes the documents matching the query of the request by raft batches on the leader
+func (partition *Partition) DeleteByQuery(ctx context.Context, req pspb.DeleteByQueryRequest) *pspb.ByQueryResponse {
+	var resp *pspb.ByQueryResponse
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := req
+		request.PartitionID = p.meta.ID
+		setRequestTimeout(ctx, &request.RequestHeader)
+		if resp, err = client.DeleteByQuery(ctx, &request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+	return resp
+}
+
+// UpdateByQuery updates the documents matching the query of the request by raft batches on the leader
+func (partition *Partition) UpdateByQuery(ctx context.Context, req pspb.UpdateByQueryRequest) *pspb.ByQueryResponse {
+	var resp *pspb.ByQueryResponse
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := req
+		request.PartitionID = p.meta.ID
+		setRequestTimeout(ctx, &request.RequestHeader)
+		if resp, err = client.UpdateByQuery(ctx, &request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+	return resp
+}
+
+// Count returns the number of the documents matching the query json
+func (partition *Partition) Count(ctx context.Context, query []byte) uint64 {
+	var resp *pspb.CountResponse
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := &pspb.CountRequest{PartitionID: p.meta.ID, Query: query}
+		setRequestTimeout(ctx, &request.RequestHeader)
+		if resp, err = client.Count(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+	return resp.Count
+}
+
+func (partition *Partition) Analyze(analyzeReq *engine.AnalyzeRequest) *engine.AnalyzeResult {
 	ctx, cancel := partition.getContext()
 	defer cancel()
-	resp := partition.getSingleResponse(partition.getClient().BulkWrite(ctx, request)).Index
-	docId, err := keys.DecodeDocIDFromString(resp.Id)
-	if err != nil {
+	var resp *pspb.AnalyzeResponse
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := &pspb.AnalyzeRequest{
+			PartitionID: p.meta.ID,
+			Text:        analyzeReq.Text,
+			Analyzer:    analyzeReq.Analyzer,
+			Field:       analyzeReq.Field,
+			Tokenizer:   analyzeReq.Tokenizer,
+		}
+		for _, filter := range analyzeReq.Filters {
+			request.Filter = append(request.Filter, filter)
+		}
+		for _, filter := range analyzeReq.CharFilters {
+			request.CharFilter = append(request.CharFilter, filter)
+		}
+		if resp, err = client.Analyze(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+
+	result := &engine.AnalyzeResult{Tokens: make([]engine.AnalyzeToken, 0, len(resp.Tokens))}
+	for _, token := range resp.Tokens {
+		result.Tokens = append(result.Tokens, engine.AnalyzeToken{
+			Token:       token.Token,
+			StartOffset: int(token.StartOffset),
+			EndOffset:   int(token.EndOffset),
+			Type:        token.Type,
+			Position:    int(token.Position),
+		})
+	}
+	return result
+}
+
+// Search runs the search request json on the partition, the deadline of the context is the timeout of the search
+func (partition *Partition) Search(ctx context.Context, searchReq []byte) *engine.SearchResult {
+	var resp *pspb.SearchResponse
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := &pspb.SearchRequest{PartitionID: p.meta.ID, Request: searchReq}
+		setRequestTimeout(ctx, &request.RequestHeader)
+		if resp, err = client.Search(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+
+	result := &engine.SearchResult{}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
 		panic(err)
 	}
+	return result
 }
 
-func (partition *Partition) Delete(docId *metapb.DocID) bool {
-	deleteReq := pspb.BulkItemRequest{
-		OpType: pspb.OpType_DELETE,
-		Delete: &pspb.DeleteRequest{Id: *docId},
+// OpenScroll runs the search request json on the partition with a scroll kept for keepAlive,
+// it returns the first page and the address of the server keeping the scroll
+func (partition *Partition) OpenScroll(ctx context.Context, searchReq []byte, keepAlive time.Duration) (*engine.SearchResult, string) {
+	var resp *pspb.SearchResponse
+	var addr string
+	partition.invoke(ctx, func(p *Partition, client pspb.ApiGrpcClient) (header *metapb.ResponseHeader, err error) {
+		request := &pspb.SearchRequest{PartitionID: p.meta.ID, Request: searchReq, Scroll: keepAlive.String()}
+		setRequestTimeout(ctx, &request.RequestHeader)
+		addr = p.getLeaderAddr()
+		if resp, err = client.Search(ctx, request); err != nil {
+			return nil, err
+		}
+		return &resp.ResponseHeader, nil
+	})
+
+	result := &engine.SearchResult{}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		panic(err)
+	}
+	return result, addr
+}
+
+// invoke sends the request by call until it succeeds, the other errors than the routing errors are panicked.
+// On a routing error the cached route is updated or evicted, and the request is resent with backoff
+// to the partition of the same slots refreshed from the master, until the deadline of ctx.
+func (partition *Partition) invoke(ctx context.Context, call psCall) {
+	p := partition
+	backoff := retryBackoffMin
+	for {
+		err := p.tryCall(call)
+		if err == nil {
+			return
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= backoff {
+			panic(err)
+		}
+		log.Warn("retry the request of partition[%d] in %s: %s", p.meta.ID, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			panic(err)
+		}
+		if backoff *= 2; backoff > retryBackoffMax {
+			backoff = retryBackoffMax
+		}
+		p = p.parent.GetPartition(p.meta.StartSlot)
 	}
-	request := &pspb.BulkRequest{
-		ActionRequestHeader: partition.requestHeader,
-		Requests:            []pspb.BulkItemRequest{deleteReq},
+}
+
+// tryCall sends the request once, it returns the routing error of the request and panics the others
+func (partition *Partition) tryCall(call psCall) error {
+	header, err := call(partition, partition.getClient())
+	if err != nil {
+		// the leader is unreachable, its route may be stale
+		if status.Code(err) == codes.Unavailable {
+			partition.parent.Evict(partition)
+			return err
+		}
+		panic(err)
 	}
-	ctx, cancel := partition.getContext()
-	defer cancel()
-	resp := partition.getSingleResponse(partition.getClient().BulkWrite(ctx, request)).Delete
-	if resp.Result != pspb.WriteResult_DELETED {
-		return false
+	if header.Code == metapb.RESP_CODE_OK {
+		return nil
+	}
+	log.Error("ps response failed(%d): %s", header.Code, header.Message)
+	switch {
+	case header.Code == metapb.PS_RESP_CODE_NOT_LEADER && header.Error.NotLeader != nil:
+		partition.updateLeader(header.Error.NotLeader)
+		return header.Error.NotLeader
+	case header.Code == metapb.PS_RESP_CODE_NO_LEADER && header.Error.NoLeader != nil:
+		partition.parent.Evict(partition)
+		return header.Error.NoLeader
+	case header.Code == metapb.PS_RESP_CODE_NO_PARTITION && header.Error.PartitionNotFound != nil:
+		partition.parent.Evict(partition)
+		return header.Error.PartitionNotFound
+	case header.Code == metapb.RESP_CODE_TIMEOUT:
+		panic(&metapb.TimeoutError{})
+	}
+	panic(errors.New(header.Message))
+}
+
+// updateLeader follows the leader of the NotLeader error of the same epoch as the route,
+// the route of another epoch or without the leader is evicted to be refreshed from the master.
+func (partition *Partition) updateLeader(notLeader *metapb.NotLeader) {
+	if notLeader.Epoch != partition.meta.Epoch || notLeader.LeaderAddr == "" {
+		partition.parent.Evict(partition)
+		return
 	}
-	return true
+	partition.lock.Lock()
+	partition.leaderAddr = notLeader.LeaderAddr
+	partition.lock.Unlock()
+}
+
+func (partition *Partition) getLeaderAddr() string {
+	partition.lock.RLock()
+	defer partition.lock.RUnlock()
+	return partition.leaderAddr
 }
 
 func (partition *Partition) getClient() pspb.ApiGrpcClient {
-	psClient, err := partition.psClient.GetGrpcClient(partition.leaderAddr)
+	leaderAddr := partition.getLeaderAddr()
+	psClient, err := partition.parent.parent.psClient.GetGrpcClient(leaderAddr)
 	if err != nil {
-		log.Warn("get ps client for %s failed", partition.leaderAddr)
+		log.Warn("get ps client for %s failed", leaderAddr)
 		panic(err)
 	}
 	return psClient.(pspb.ApiGrpcClient)
@@ -121,22 +368,23 @@ func (partition *Partition) getContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(partition.parent.parent.context, rpcTimeoutDef)
 }
 
-func (partition *Partition) getSingleResponse(resp *pspb.BulkResponse, err error) *pspb.BulkItemResponse {
-	if err != nil {
-		log.Error("send bulk request failed: %s", err.Error())
-		panic(err)
+// setRequestTimeout sets the timeout of the request by the deadline of ctx
+func setRequestTimeout(ctx context.Context, header *metapb.RequestHeader) {
+	if deadline, ok := ctx.Deadline(); ok {
+		header.Timeout = time.Until(deadline).String()
 	}
-	if resp.Code != metapb.RESP_CODE_OK {
-		if resp.Code == metapb.PS_RESP_CODE_NO_LEADER || resp.Code == metapb.PS_RESP_CODE_NO_PARTITION {
-			partition.parent.Delete(partition.meta)
-		} else if resp.Code == metapb.PS_RESP_CODE_NOT_LEADER {
-			partition.leaderAddr = resp.Error.NotLeader.LeaderAddr
-		}
-		log.Error("bulk response failed(%d): %s", resp.Code, resp.Message)
-		panic(errors.New(resp.Message))
+}
+
+// getSingleResponse writes a document, the failure of the write is panicked
+func (partition *Partition) getSingleResponse(request pspb.RequestUnion) *pspb.ResponseUnion {
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp := &partition.Bulk(ctx, []pspb.RequestUnion{request})[0]
+	if resp.Failure != nil {
+		panic(errors.New(resp.Failure.Cause))
 	}
-	if len(resp.Responses) != 1 || resp.Responses[0].OpType != pspb.OpType_CREATE {
-		panic(errors.New("bad response for OpType_CREATE"))
+	if resp.OpType != request.OpType {
+		panic(errors.New("bad response for " + request.OpType.String()))
 	}
-	return &resp.Responses[0]
+	return resp
 }
@@ -0,0 +1,217 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/masterpb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServers are the partition servers and the master of a test, the servers reply the Get requests by their addresses
+type fakeServers struct {
+	replies map[string]func() (*pspb.GetResponse, error)
+	// the replies of the by query requests by the addresses, maxDocs is the max_docs of the request
+	byQuery map[string]func(maxDocs int64) *pspb.ByQueryResponse
+	// searches reply the Search requests by the addresses, the servers without a reply fail the request
+	searches map[string]func(in *pspb.SearchRequest) *engine.SearchResult
+	// bulk replies the Bulk requests of the servers
+	bulk    func(addr string, requests []pspb.RequestUnion) []pspb.ResponseUnion
+	routes  []masterpb.Route
+	calls   []string
+	lookups int
+}
+
+func (f *fakeServers) GetGrpcClient(addr string) (interface{}, error) {
+	if addr == "master" {
+		return &fakeMasterClient{servers: f}, nil
+	}
+	return &fakePsClient{servers: f, addr: addr}, nil
+}
+
+type fakePsClient struct {
+	pspb.ApiGrpcClient
+	servers *fakeServers
+	addr    string
+}
+
+func (c *fakePsClient) Get(ctx context.Context, in *pspb.GetRequest, opts ...grpc.CallOption) (*pspb.GetResponse, error) {
+	c.servers.calls = append(c.servers.calls, c.addr)
+	reply, ok := c.servers.replies[c.addr]
+	if !ok {
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	}
+	return reply()
+}
+
+func (c *fakePsClient) MultiGet(ctx context.Context, in *pspb.MultiGetRequest, opts ...grpc.CallOption) (*pspb.MultiGetResponse, error) {
+	resp, err := c.Get(ctx, &pspb.GetRequest{PartitionID: in.PartitionID}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pspb.MultiGetResponse{ResponseHeader: resp.ResponseHeader, Docs: []pspb.GetResult{resp.Doc}}, nil
+}
+
+func (c *fakePsClient) Bulk(ctx context.Context, in *pspb.BulkRequest, opts ...grpc.CallOption) (*pspb.BulkResponse, error) {
+	c.servers.calls = append(c.servers.calls, c.addr)
+	return &pspb.BulkResponse{ResponseHeader: metapb.ResponseHeader{Code: metapb.RESP_CODE_OK}, Responses: c.servers.bulk(c.addr, in.Requests)}, nil
+}
+
+func (c *fakePsClient) DeleteByQuery(ctx context.Context, in *pspb.DeleteByQueryRequest, opts ...grpc.CallOption) (*pspb.ByQueryResponse, error) {
+	c.servers.calls = append(c.servers.calls, c.addr)
+	return c.servers.byQuery[c.addr](in.MaxDocs), nil
+}
+
+func (c *fakePsClient) Search(ctx context.Context, in *pspb.SearchRequest, opts ...grpc.CallOption) (*pspb.SearchResponse, error) {
+	c.servers.calls = append(c.servers.calls, c.addr)
+	reply, ok := c.servers.searches[c.addr]
+	if !ok {
+		return nil, status.Error(codes.Internal, "search failed")
+	}
+	result, err := json.Marshal(reply(in))
+	if err != nil {
+		return nil, err
+	}
+	return &pspb.SearchResponse{ResponseHeader: metapb.ResponseHeader{Code: metapb.RESP_CODE_OK}, Result: result}, nil
+}
+
+type fakeMasterClient struct {
+	masterpb.MasterRpcClient
+	servers *fakeServers
+}
+
+func (c *fakeMasterClient) GetRoute(ctx context.Context, in *masterpb.GetRouteRequest, opts ...grpc.CallOption) (*masterpb.GetRouteResponse, error) {
+	c.servers.lookups++
+	return &masterpb.GetRouteResponse{ResponseHeader: metapb.ResponseHeader{Code: metapb.RESP_CODE_OK}, Routes: c.servers.routes}, nil
+}
+
+func testRoute(epoch uint64, leader string) masterpb.Route {
+	route := masterpb.Route{Leader: 1, Nodes: []*metapb.Node{{ID: 1}}}
+	route.Partition = metapb.Partition{ID: 1, StartSlot: 0, EndSlot: ^metapb.SlotID(0), Epoch: metapb.PartitionEpoch{Version: epoch}}
+	route.Nodes[0].RpcAddr = leader
+	return route
+}
+
+func testSpace(servers *fakeServers, route masterpb.Route) *Space {
+	if routerCfg == nil {
+		routerCfg = &Config{}
+	}
+	master := &MasterClient{client: servers, masterAddr: "master"}
+	master.context, master.cancelFunc = context.WithCancel(context.Background())
+	db := &DB{masterClient: master, psClient: servers, context: context.Background()}
+	space := NewSpace(db, metapb.Space{})
+	space.addRoutes([]masterpb.Route{route})
+	return space
+}
+
+func okReply() (*pspb.GetResponse, error) {
+	return &pspb.GetResponse{ResponseHeader: metapb.ResponseHeader{Code: metapb.RESP_CODE_OK}, Doc: pspb.GetResult{ID: "1", Found: true}}, nil
+}
+
+func headerReply(code metapb.RespCode, err metapb.Error) func() (*pspb.GetResponse, error) {
+	return func() (*pspb.GetResponse, error) {
+		return &pspb.GetResponse{ResponseHeader: metapb.ResponseHeader{Code: code, Error: err}}, nil
+	}
+}
+
+func TestPartitionInvoke(t *testing.T) {
+	tests := []struct {
+		name    string
+		replies map[string]func() (*pspb.GetResponse, error)
+		// the route of the master after the route is evicted
+		refresh masterpb.Route
+		calls   []string
+		lookups int
+		evicted bool
+	}{
+		{
+			name: "not leader of the same epoch",
+			replies: map[string]func() (*pspb.GetResponse, error){
+				"a": headerReply(metapb.PS_RESP_CODE_NOT_LEADER, metapb.Error{NotLeader: &metapb.NotLeader{Epoch: metapb.PartitionEpoch{Version: 1}, LeaderAddr: "b"}}),
+				"b": okReply,
+			},
+			calls: []string{"a", "b"},
+		},
+		{
+			name: "not leader of another epoch",
+			replies: map[string]func() (*pspb.GetResponse, error){
+				"a": headerReply(metapb.PS_RESP_CODE_NOT_LEADER, metapb.Error{NotLeader: &metapb.NotLeader{Epoch: metapb.PartitionEpoch{Version: 2}, LeaderAddr: "b"}}),
+				"c": okReply,
+			},
+			refresh: testRoute(2, "c"),
+			calls:   []string{"a", "c"},
+			lookups: 1,
+			evicted: true,
+		},
+		{
+			name: "unavailable",
+			replies: map[string]func() (*pspb.GetResponse, error){
+				"b": okReply,
+			},
+			refresh: testRoute(1, "b"),
+			calls:   []string{"a", "b"},
+			lookups: 1,
+			evicted: true,
+		},
+		{
+			name: "no leader",
+			replies: map[string]func() (*pspb.GetResponse, error){
+				"a": headerReply(metapb.PS_RESP_CODE_NO_LEADER, metapb.Error{NoLeader: &metapb.NoLeader{}}),
+				"b": okReply,
+			},
+			refresh: testRoute(1, "b"),
+			calls:   []string{"a", "b"},
+			lookups: 1,
+			evicted: true,
+		},
+	}
+	for _, test := range tests {
+		servers := &fakeServers{replies: test.replies, routes: []masterpb.Route{test.refresh}}
+		space := testSpace(servers, testRoute(1, "a"))
+		partition := space.partitions[0]
+
+		doc := partition.Get("1", nil, nil)
+		if !doc.Found {
+			t.Errorf("%s: the document is not found", test.name)
+		}
+		if !reflect.DeepEqual(servers.calls, test.calls) {
+			t.Errorf("%s: the servers called are %v, want %v", test.name, servers.calls, test.calls)
+		}
+		if servers.lookups != test.lookups {
+			t.Errorf("%s: the route is looked up %d times, want %d", test.name, servers.lookups, test.lookups)
+		}
+		if evicted := space.partitions[0] != partition; evicted != test.evicted {
+			t.Errorf("%s: the route is evicted %v, want %v", test.name, evicted, test.evicted)
+		}
+	}
+}
+
+func TestPartitionInvokeDeadline(t *testing.T) {
+	servers := &fakeServers{}
+	space := testSpace(servers, testRoute(1, "a"))
+	partition := space.partitions[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), retryBackoffMin/2)
+	defer cancel()
+	func() {
+		defer func() {
+			if err, _ := recover().(error); status.Code(err) != codes.Unavailable {
+				t.Fatalf("expect the unavailable error, got %v", err)
+			}
+		}()
+		partition.MultiGet(ctx, []string{"1"}, nil, nil)
+	}()
+	if len(space.partitions) != 0 || servers.lookups != 0 {
+		t.Fatalf("the route of the unavailable leader is not evicted without a retry")
+	}
+	if ctx.Err() != nil {
+		t.Fatalf("the request is not failed before its deadline")
+	}
+}
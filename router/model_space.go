@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
+	"math"
 	"sort"
 	"sync"
 	"github.com/tiglabs/baudengine/util/log"
@@ -15,13 +16,19 @@ type Space struct {
 	parent     *DB
 	partitions []*Partition
 	lock       sync.RWMutex
+	keyPolicy  *keyPolicy
+	keyErr     error
 }
 
 func NewSpace(parent *DB, meta metapb.Space) *Space {
 	if str, err := json.Marshal(meta); err == nil {
 		log.Debug("NewSpace(): %s", string(str))
 	}
-	return &Space{meta: meta, parent: parent}
+	space := &Space{meta: meta, parent: parent}
+	if space.keyPolicy, space.keyErr = newKeyPolicy(meta.KeyPolicy); space.keyErr != nil {
+		log.Error("bad key policy of space %s: %s", meta.Name, space.keyErr)
+	}
+	return space
 }
 
 func (space *Space) GetPartition(slotId metapb.SlotID) *Partition {
@@ -37,6 +44,17 @@ func (space *Space) GetPartition(slotId metapb.SlotID) *Partition {
 	return partition
 }
 
+// GetPartitions returns the partitions covering all the slots of the space in the order of slots
+func (space *Space) GetPartitions() []*Partition {
+	var partitions []*Partition
+	for slot := uint64(0); slot <= math.MaxUint32; {
+		partition := space.GetPartition(metapb.SlotID(slot))
+		partitions = append(partitions, partition)
+		slot = uint64(partition.meta.EndSlot) + 1
+	}
+	return partitions
+}
+
 func (space *Space) getPartition(slotId metapb.SlotID) (*Partition, int) {
 	space.lock.RLock()
 	defer space.lock.RUnlock()
@@ -50,33 +68,112 @@ func (space *Space) getPartition(slotId metapb.SlotID) (*Partition, int) {
 	return space.partitions[pos], pos
 }
 
+// addRoutes caches the routes of the master, the cached routes overlapping the slots of a route are replaced by it
+// unless it is the same partition of the same epoch.
 func (space *Space) addRoutes(routes []masterpb.Route) {
 	space.lock.Lock()
 	defer space.lock.Unlock()
 
 	for _, route := range routes {
-		pos := sort.Search(len(space.partitions), func(i int) bool {
+		start := sort.Search(len(space.partitions), func(i int) bool {
 			return space.partitions[i].meta.EndSlot >= route.StartSlot
 		})
+		end := start
+		for end < len(space.partitions) && space.partitions[end].meta.StartSlot <= route.EndSlot {
+			end++
+		}
+		if end == start+1 && space.partitions[start].meta.ID == route.Partition.ID &&
+			space.partitions[start].meta.Epoch == route.Partition.Epoch {
+			continue
+		}
 		newPartition := NewPartition(space, route)
-		if pos >= len(space.partitions) {
-			space.partitions = append(space.partitions, newPartition)
-		} else if space.partitions[pos].meta.EndSlot >= route.StartSlot {
-			space.partitions = append(space.partitions[:pos], append([]*Partition{newPartition}, space.partitions[pos:]...)...)
+		space.partitions = append(space.partitions[:start], append([]*Partition{newPartition}, space.partitions[end:]...)...)
+	}
+}
+
+// DocSlot returns the slot of a document written by the routing parameter, the slot of a generated id,
+// the key fields of the document, or else the id
+func (space *Space) DocSlot(id string, docBody []byte, routing string) metapb.SlotID {
+	policy := space.getKeyPolicy()
+	if routing != "" {
+		return policy.slot(routingKey(routing))
+	}
+	if slot, ok := docIdSlot(id); ok {
+		return slot
+	}
+	if policy.hasKey() {
+		key, err := policy.docKey(docBody)
+		if err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+		if key != nil {
+			return policy.slot(key)
+		}
+	}
+	return policy.slot([]string{id})
+}
+
+// RouteIds returns the ids of the documents by the partitions which may have them: the partition of the routing parameter,
+// the partitions of the generated ids, the partitions of the ids if the space has no key fields, or else all the partitions.
+func (space *Space) RouteIds(ids []string, routing string) map[*Partition][]string {
+	policy := space.getKeyPolicy()
+	routes := make(map[*Partition][]string)
+	if routing != "" {
+		routes[space.GetPartition(policy.slot(routingKey(routing)))] = ids
+		return routes
+	}
+	var unrouted []string
+	for _, id := range ids {
+		slot, ok := space.IdSlot(id, "")
+		if !ok {
+			unrouted = append(unrouted, id)
+			continue
 		}
+		partition := space.GetPartition(slot)
+		routes[partition] = append(routes[partition], id)
+	}
+	if len(unrouted) > 0 {
+		for _, partition := range space.GetPartitions() {
+			routes[partition] = append(routes[partition], unrouted...)
+		}
+	}
+	return routes
+}
+
+// IdSlot returns the slot of the document of the id by the routing parameter, the slot of a generated id,
+// or the id if the space has no key fields, it returns false if the document may be in any slot.
+func (space *Space) IdSlot(id, routing string) (metapb.SlotID, bool) {
+	policy := space.getKeyPolicy()
+	if routing != "" {
+		return policy.slot(routingKey(routing)), true
+	}
+	if slot, ok := docIdSlot(id); ok {
+		return slot, true
 	}
+	if !policy.hasKey() {
+		return policy.slot([]string{id}), true
+	}
+	return 0, false
 }
 
-func (space *Space) GetKeyField() string {
-	return space.meta.KeyPolicy.KeyField
+func (space *Space) getKeyPolicy() *keyPolicy {
+	if space.keyErr != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, space.keyErr.Error(), nil})
+	}
+	return space.keyPolicy
 }
 
-func (space *Space) Delete(partition metapb.Partition) {
-	_, pos := space.getPartition(partition.StartSlot)
-	if pos >= 0 {
-		space.lock.Lock()
-		defer space.lock.Unlock()
+// Evict removes the cached route of the partition, it is refreshed from the master on the next request of its slots.
+// The route is kept if it has been replaced already.
+func (space *Space) Evict(partition *Partition) {
+	space.lock.Lock()
+	defer space.lock.Unlock()
 
-		space.partitions = append(space.partitions[:pos], space.partitions[pos + 1:]...)
+	for i, p := range space.partitions {
+		if p == partition {
+			log.Info("evict the route of partition[%d]", partition.meta.ID)
+			space.partitions = append(space.partitions[:i], space.partitions[i+1:]...)
+			return
+		}
 	}
 }
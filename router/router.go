@@ -1,23 +1,31 @@
 package router
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/spaolacci/murmur3"
-	"github.com/tiglabs/baudengine/common/keys"
+	"github.com/tiglabs/baudengine/engine"
 	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
 	"github.com/tiglabs/baudengine/util/log"
+	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"strconv"
 	"sync"
 	"github.com/tiglabs/baudengine/util/netutil"
-	"errors"
+	"github.com/tiglabs/baudengine/util/rpc"
+	"google.golang.org/grpc"
 )
 
 var routerCfg 	*Config
 
 type Router struct {
 	httpServer   *netutil.Server
+	esServer     *http.Server
 	masterClient *MasterClient
+	// psConnMgr and psClient are the connections of the partition servers shared by all the partitions
+	psConnMgr    *rpc.ConnectionMgr
+	psClient     *rpc.Client
 	dbMap        sync.Map
 	lock         sync.RWMutex
 }
@@ -35,6 +43,13 @@ func NewServer() *Router {
 func (router *Router) Start(cfg *Config) error {
 	routerCfg = cfg
 	router.masterClient = NewMasterClient(cfg.ModuleCfg.MasterAddr)
+	connMgrOpt := rpc.DefaultManagerOption
+	router.psConnMgr = rpc.NewConnectionMgr(context.Background(), &connMgrOpt)
+	clientOpt := rpc.DefaultClientOption
+	clientOpt.ClusterID = cfg.ModuleCfg.ClusterId
+	clientOpt.ConnectMgr = router.psConnMgr
+	clientOpt.CreateFunc = func(clientConn *grpc.ClientConn) interface{} { return pspb.NewApiGrpcClient(clientConn) }
+	router.psClient = rpc.NewClient(1, &clientOpt)
 
 	httpServerConfig := &netutil.ServerConfig{
 		Name: "router",
@@ -44,86 +59,132 @@ func (router *Router) Start(cfg *Config) error {
 	router.httpServer = netutil.NewServer(httpServerConfig)
 
 	router.httpServer.Handle(netutil.PUT, "/doc/:db/:space", router.handleCreate)
+	router.httpServer.Handle(netutil.PUT, "/doc/:db/:space/:docId", router.handleIndex)
 	router.httpServer.Handle(netutil.GET, "/doc/:db/:space/:docId", router.handleRead)
 	router.httpServer.Handle(netutil.POST,"/doc/:db/:space/:docId", router.handleUpdate)
 	router.httpServer.Handle(netutil.DELETE, "/doc/:db/:space/:docId", router.handleDelete)
 
+	if cfg.EsCfg.HttpPort > 0 {
+		router.startEsServer(cfg.ModuleCfg.Ip + ":" + strconv.Itoa(int(cfg.EsCfg.HttpPort)))
+	}
 	return router.httpServer.Run()
 }
 
 func (router *Router) Shutdown() {
+	if router.esServer != nil {
+		router.esServer.Close()
+	}
 	router.httpServer.Close()
+	router.psClient.Close()
+	router.psConnMgr.Close()
 }
 
 func (router *Router) handleCreate(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
 	defer router.catchPanic(writer)
 
-	db, space, _, _ := router.getParams(params, false)
-	docBody := router.readDocBody(request)
-	var partition *Partition
-	keyField := space.GetKeyField()
-	if keyField != "" {
-		docObj := make(map[string]interface{})
-		if err := json.Unmarshal(docBody, &docObj); err != nil {
-			panic(err)
-		}
-		if slotData, ok := docObj[keyField]; ok {
-			h32 := murmur3.New32()
-			switch data := slotData.(type) {
-			case string:
-				h32.Write([]byte(data))
-			default:
-				panic(errors.New("bad slot data type"))
-			}
-			partition = space.GetPartition(metapb.SlotID(h32.Sum32()))
-		}
-	}
-	if partition == nil {
-		h32 := murmur3.New32()
-		h32.Write(docBody)
-		partition = space.GetPartition(metapb.SlotID(h32.Sum32()))
-	}
-	docId := partition.Create(docBody)
+	db, space, _ := router.getParams(params)
+	resp := router.createDocument(space, router.readDocBody(request), request.URL.Query().Get("routing"))
 
 	respMap := map[string]interface{}{
 		"_db":    db.meta.ID,
 		"_space": space.meta.ID,
 		"_slot":  params.ByName("slot"),
-		"_docId": docId,
+		"_docId": string(resp.ID),
+	}
+
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
+// handleIndex creates or replaces the document of the id given by the client
+func (router *Router) handleIndex(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	if router.handleEndpoint(writer, request, params) {
+		return
+	}
+	defer router.catchPanic(writer)
+
+	db, space, docId := router.getParams(params)
+	resp := router.indexDocument(space, docId, request.URL.Query().Get("routing"), router.readDocBody(request))
+
+	respMap := map[string]interface{}{
+		"_db":      db.meta.ID,
+		"_space":   space.meta.ID,
+		"_docId":   docId,
+		"_created": resp.Result == pspb.WriteResult_CREATED,
 	}
 
 	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
 }
 
 func (router *Router) handleRead(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	if router.handleEndpoint(writer, request, params) {
+		return
+	}
 	defer router.catchPanic(writer)
 
-	_, _, partition, docId := router.getParams(params, true)
-	docBody := partition.Read(docId)
-	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), docBody})
+	_, space, docId := router.getParams(params)
+	doc, ok := router.getDocuments(space, []string{docId}, request.URL.Query().Get("routing"), nil, nil)[docId]
+	if !ok {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrDocNotExists.Error(), nil})
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), json.RawMessage(doc.doc.Source)})
 }
 
 func (router *Router) handleUpdate(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	if router.handleEndpoint(writer, request, params) {
+		return
+	}
 	defer router.catchPanic(writer)
 
-	_, _, partition, docId := router.getParams(params, true)
-	docBody := router.readDocBody(request)
-	partition.Update(docId, docBody)
+	_, space, docId := router.getParams(params)
+	if resp := router.updateDocument(space, docId, request.URL.Query().Get("routing"), router.readDocBody(request), false); resp == nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrDocNotExists.Error(), nil})
+	}
 	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), nil})
 }
 
 func (router *Router) handleDelete(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
 	defer router.catchPanic(writer)
 
-	_, _, partition, docId := router.getParams(params, true)
-	if ok := partition.Delete(docId); ok {
+	_, space, docId := router.getParams(params)
+	if resp := router.deleteDocument(space, docId, request.URL.Query().Get("routing")); resp != nil {
 		sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), nil})
 	} else {
 		sendReply(writer, &HttpReply{ERRCODE_INTERNAL_ERROR, "Cannot delete doc", nil})
 	}
 }
 
-func (router *Router) getParams(params netutil.UriParams, decodeDocId bool) (db *DB, space *Space, partition *Partition, docId *metapb.DocID) {
+// handleEndpoint handles the space endpoints such as _analyze, their paths conflict with the document paths in the http router
+func (router *Router) handleEndpoint(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) bool {
+	switch params.ByName("docId") {
+	case "_analyze":
+		router.handleAnalyze(writer, request, params)
+	case "_search":
+		router.handleSearch(writer, request, params)
+	case "_bulk":
+		router.handleBulk(writer, request, params)
+	default:
+		return false
+	}
+	return true
+}
+
+func (router *Router) handleAnalyze(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, space, _ := router.getParams(params)
+	analyzeReq := &engine.AnalyzeRequest{}
+	if err := json.Unmarshal(router.readDocBody(request), analyzeReq); err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	if err := analyzeReq.Validate(); err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	// every partition of the space has its schema, so any of them can analyze
+	partition := space.GetPartition(metapb.SlotID(rand.Uint32()))
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), partition.Analyze(analyzeReq)})
+}
+
+func (router *Router) getParams(params netutil.UriParams) (db *DB, space *Space, docId string) {
 	defer func() {
 		if p := recover(); p != nil {
 			if err, ok := p.(error); ok {
@@ -135,26 +196,22 @@ func (router *Router) getParams(params netutil.UriParams, decodeDocId bool) (db
 
 	db = router.GetDB(params.ByName("db"))
 	space = db.GetSpace(params.ByName("space"))
-	if decodeDocId {
-		id, err := keys.DecodeDocIDFromString(params.ByName("docId"))
-		if err != nil {
-			panic(err)
-		}
-		docId = id
-	}
+	docId = params.ByName("docId")
 	return
 }
 
 func (router *Router) readDocBody(request *http.Request) []byte {
-	var docBody = make([]byte, request.ContentLength)
-	request.Body.Read(docBody)
+	docBody, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		panic(err)
+	}
 	return docBody
 }
 
 func (router *Router) GetDB(dbName string) *DB {
 	db, ok := router.dbMap.Load(dbName)
 	if !ok {
-		db, ok = router.dbMap.LoadOrStore(dbName, NewDB(router.masterClient, router.masterClient.GetDB(dbName)))
+		db, ok = router.dbMap.LoadOrStore(dbName, NewDB(router.masterClient, router.psClient, router.masterClient.GetDB(dbName)))
 	}
 	return db.(*DB)
 }
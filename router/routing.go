@@ -0,0 +1,200 @@
+package router
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/spaolacci/murmur3"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/uuid"
+)
+
+// keyFunc maps the routing key of a document to its slot
+type keyFunc func(key []string) metapb.SlotID
+
+// keyFuncs are the functions of KeyPolicy.KeyFunc, murmur3 by default
+var keyFuncs = map[string]keyFunc{
+	"":         murmur3Slot,
+	"murmur3":  murmur3Slot,
+	"crc32":    crc32Slot,
+	"identity": identitySlot,
+}
+
+const (
+	// the values of a compound key are joined by it, so the routing parameter of a compound key is "value1,value2"
+	keySeparator = ","
+	// the generated ids are the 4 bytes of the slot and the 15 bytes of a flake uuid in unpadded url base64
+	docIdSize = 19
+)
+
+var docIdEncoding = base64.RawURLEncoding.Strict()
+
+func murmur3Slot(key []string) metapb.SlotID {
+	return metapb.SlotID(murmur3.Sum32([]byte(strings.Join(key, keySeparator))))
+}
+
+func crc32Slot(key []string) metapb.SlotID {
+	return metapb.SlotID(crc32.ChecksumIEEE([]byte(strings.Join(key, keySeparator))))
+}
+
+// identitySlot keeps the order of the keys for range partitions by the first value of the key:
+// a non-negative integer is the slot itself, the other values are the slot of their first 4 bytes in big endian.
+func identitySlot(key []string) metapb.SlotID {
+	if n, err := strconv.ParseUint(key[0], 10, 32); err == nil {
+		return metapb.SlotID(n)
+	}
+	var b [4]byte
+	copy(b[:], key[0])
+	return metapb.SlotID(binary.BigEndian.Uint32(b[:]))
+}
+
+// keyPolicy routes the documents of a space by the KeyPolicy of its meta.
+// KeyField is a field, or the comma separated fields of a compound key, the nested fields are joined by ".".
+type keyPolicy struct {
+	fields [][]string
+	slot   keyFunc
+}
+
+func newKeyPolicy(policy *metapb.KeyPolicy) (*keyPolicy, error) {
+	p := &keyPolicy{slot: murmur3Slot}
+	if policy == nil {
+		return p, nil
+	}
+	slot, ok := keyFuncs[strings.ToLower(policy.KeyFunc)]
+	if !ok {
+		return nil, fmt.Errorf("unknown key function %s", policy.KeyFunc)
+	}
+	p.slot = slot
+	for _, field := range strings.Split(policy.KeyField, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			p.fields = append(p.fields, strings.Split(field, "."))
+		}
+	}
+	return p, nil
+}
+
+// hasKey returns whether the documents are routed by their key fields, or else by their ids
+func (p *keyPolicy) hasKey() bool {
+	return len(p.fields) > 0
+}
+
+// docKey returns the key of the document by the key fields, nil if the document has none of them.
+// The numbers, booleans and the elements of the arrays are keyed by their text, so they can be given by the routing parameter.
+func (p *keyPolicy) docKey(docBody []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(docBody))
+	decoder.UseNumber()
+	var doc map[string]interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var key []string
+	missing := 0
+	for _, path := range p.fields {
+		value, ok := fieldValue(doc, path)
+		if !ok {
+			missing++
+			continue
+		}
+		values, err := keyValues(value, true)
+		if err != nil {
+			return nil, fmt.Errorf("key field %s: %v", strings.Join(path, "."), err)
+		}
+		key = append(key, values...)
+	}
+	switch {
+	case missing == len(p.fields):
+		return nil, nil
+	case missing > 0 || len(key) == 0:
+		return nil, fmt.Errorf("the document misses some of the key fields")
+	}
+	return key, nil
+}
+
+func fieldValue(doc map[string]interface{}, path []string) (interface{}, bool) {
+	var value interface{} = doc
+	for _, name := range path {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = obj[name]; !ok {
+			return nil, false
+		}
+	}
+	return value, value != nil
+}
+
+func keyValues(value interface{}, array bool) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case json.Number:
+		return []string{numberKey(v)}, nil
+	case bool:
+		return []string{strconv.FormatBool(v)}, nil
+	case []interface{}:
+		if !array {
+			return nil, fmt.Errorf("nested array")
+		}
+		var values []string
+		for _, e := range v {
+			ev, err := keyValues(e, false)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, ev...)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("bad key type %T", value)
+	}
+}
+
+// numberKey is the text of a number, the same numbers have the same text, such as 1 and 1.0
+func numberKey(n json.Number) string {
+	if i, err := strconv.ParseInt(n.String(), 10, 64); err == nil {
+		return strconv.FormatInt(i, 10)
+	}
+	if u, err := strconv.ParseUint(n.String(), 10, 64); err == nil {
+		return strconv.FormatUint(u, 10)
+	}
+	if f, err := n.Float64(); err == nil {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return n.String()
+}
+
+// routingKey is the key of the routing parameter of a request
+func routingKey(routing string) []string {
+	return strings.Split(routing, keySeparator)
+}
+
+// newDocId generates the id of a new document of the slot, the slot is kept in the id so that it is read by the id without a broadcast
+func newDocId(slot metapb.SlotID) string {
+	flake, err := base64.URLEncoding.DecodeString(uuid.FlakeUUID())
+	if err != nil {
+		panic(err)
+	}
+	id := make([]byte, 4, docIdSize)
+	binary.BigEndian.PutUint32(id, uint32(slot))
+	return docIdEncoding.EncodeToString(append(id, flake...))
+}
+
+// docIdSlot returns the slot of a generated id, the ids given by the clients in the same form are routed by it as well
+func docIdSlot(id string) (metapb.SlotID, bool) {
+	if len(id) != docIdEncoding.EncodedLen(docIdSize) {
+		return 0, false
+	}
+	data, err := docIdEncoding.DecodeString(id)
+	if err != nil || len(data) != docIdSize {
+		return 0, false
+	}
+	return metapb.SlotID(binary.BigEndian.Uint32(data)), true
+}
@@ -0,0 +1,250 @@
+package router
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+// scrollContext is a scroll of all the partitions of a space, it is encoded as the _scroll_id of the replies.
+// Every partition keeps a snapshot for its scroll on the server it is opened, so the pages of a partition
+// are read from the same server even if the leader is changed.
+type scrollContext struct {
+	Index  string          `json:"index"`
+	Sort   json.RawMessage `json:"sort,omitempty"`
+	Shards []scrollShard   `json:"shards"`
+	// Failed is the number of the partitions failed to open their scrolls, they are failed shards of every page
+	Failed int `json:"failed,omitempty"`
+}
+
+type scrollShard struct {
+	Addr     string `json:"addr"`
+	ScrollID string `json:"id"`
+}
+
+func (c *scrollContext) encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeScrollId(scrollId string) (*scrollContext, error) {
+	data, err := base64.RawURLEncoding.DecodeString(scrollId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scroll id %s", scrollId)
+	}
+	c := &scrollContext{}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("invalid scroll id %s", scrollId)
+	}
+	return c, nil
+}
+
+// scrollKeepAlive returns the duration of the scroll parameter, such as "1m"
+func scrollKeepAlive(scroll string) (time.Duration, error) {
+	d, err := time.ParseDuration(scroll)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid scroll keep alive %s", scroll)
+	}
+	return d, nil
+}
+
+// searchScroll opens a scroll of the search request json on the space, the scrolls of the partitions are kept for keepAlive.
+// Every page has the next hits of every partition, so it has up to size hits of each partition.
+func (router *Router) searchScroll(space *Space, index string, body []byte, timeoutParam string, keepAlive time.Duration) *engine.SearchResult {
+	start := time.Now()
+	raw := make(map[string]json.RawMessage)
+	searchReq := engine.NewSearchQuery("", "")
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &raw); err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+		if err := json.Unmarshal(body, searchReq); err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+	}
+	if searchReq.From != 0 {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, "from is not allowed in a scroll", nil})
+	}
+	if searchReq.Size < 0 {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, "size must not be negative", nil})
+	}
+	sortKeys, err := engine.ParseSortKeys(searchReq.Sort)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	timeout, err := searchTimeout(timeoutParam, raw)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	if len(raw["query"]) == 0 {
+		raw["query"] = matchAllQuery
+	}
+	ctx, cancel := context.WithTimeout(space.parent.context, timeout)
+	defer cancel()
+
+	request := searchBody(raw, nil, "timeout", "from")
+	partitions := space.GetPartitions()
+	addrs := make([]string, len(partitions))
+	results, errs := doPartitions(ctx, partitions, func(i int, partition *Partition) interface{} {
+		var result *engine.SearchResult
+		result, addrs[i] = partition.OpenScroll(ctx, request, keepAlive)
+		return result
+	})
+	scroll := &scrollContext{Index: index, Sort: json.RawMessage(searchReq.Sort)}
+	shards := make([]*shardSearch, len(partitions))
+	for i := range partitions {
+		shards[i] = &shardSearch{err: errs[i]}
+		if errs[i] == nil {
+			shards[i].result = results[i].(*engine.SearchResult)
+			scroll.Shards = append(scroll.Shards, scrollShard{Addr: addrs[i], ScrollID: shards[i].result.ScrollID})
+		} else {
+			scroll.Failed++
+		}
+	}
+	// a space without partitions has an empty scroll
+	if len(partitions) > 0 && len(scroll.Shards) == 0 {
+		panic(errs[0])
+	}
+	result := mergeScrollPages(shards, sortKeys)
+	result.ScrollID = scroll.encode()
+	result.Took = int64(time.Since(start) / time.Millisecond)
+	return result
+}
+
+// scroll returns the next page of the scroll and extends its keep alive
+func (router *Router) scroll(scrollId string, keepAlive time.Duration, timeoutParam string) (*engine.SearchResult, *scrollContext) {
+	start := time.Now()
+	scroll, err := decodeScrollId(scrollId)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	sortKeys, err := engine.ParseSortKeys(scroll.Sort)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	timeout, err := searchTimeout(timeoutParam, nil)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	results, errs := doParallel(ctx, len(scroll.Shards), func(i int) interface{} {
+		return router.scrollPage(ctx, scroll.Shards[i], keepAlive)
+	})
+	shards := make([]*shardSearch, len(scroll.Shards))
+	failed := 0
+	for i := range shards {
+		shards[i] = &shardSearch{err: errs[i]}
+		if errs[i] == nil {
+			shards[i].result = results[i].(*engine.SearchResult)
+		} else {
+			failed++
+		}
+	}
+	if failed > 0 && failed == len(shards) {
+		panic(errs[0])
+	}
+	result := mergeScrollPages(shards, sortKeys)
+	result.Shards.Total += scroll.Failed
+	result.Shards.Failed += scroll.Failed
+	result.ScrollID = scrollId
+	result.Took = int64(time.Since(start) / time.Millisecond)
+	return result, scroll
+}
+
+// clearScroll releases the scrolls of the partitions, it returns the number of the scrolls of the partitions released
+func (router *Router) clearScroll(scrollIds []string) int {
+	byAddr := make(map[string][]string)
+	var addrs []string
+	for _, scrollId := range scrollIds {
+		scroll, err := decodeScrollId(scrollId)
+		if err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+		for _, shard := range scroll.Shards {
+			if _, ok := byAddr[shard.Addr]; !ok {
+				addrs = append(addrs, shard.Addr)
+			}
+			byAddr[shard.Addr] = append(byAddr[shard.Addr], shard.ScrollID)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeoutDef)
+	defer cancel()
+	results, _ := doParallel(ctx, len(addrs), func(i int) interface{} {
+		client := router.psApiClient(addrs[i])
+		resp, err := client.ClearScroll(ctx, &pspb.ClearScrollRequest{ScrollIDs: byAddr[addrs[i]]})
+		if err != nil {
+			panic(err)
+		}
+		checkPsResponse(&resp.ResponseHeader)
+		return int(resp.NumFreed)
+	})
+	// the scrolls of the failed servers are released by their keep alive
+	freed := 0
+	for _, n := range results {
+		if n != nil {
+			freed += n.(int)
+		}
+	}
+	return freed
+}
+
+// scrollPage reads the next page of the scroll of a partition on the server it is opened
+func (router *Router) scrollPage(ctx context.Context, shard scrollShard, keepAlive time.Duration) *engine.SearchResult {
+	request := &pspb.ScrollRequest{ScrollID: shard.ScrollID, Scroll: keepAlive.String()}
+	setRequestTimeout(ctx, &request.RequestHeader)
+	resp, err := router.psApiClient(shard.Addr).Scroll(ctx, request)
+	if err != nil {
+		panic(err)
+	}
+	checkPsResponse(&resp.ResponseHeader)
+	result := &engine.SearchResult{}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func (router *Router) psApiClient(addr string) pspb.ApiGrpcClient {
+	client, err := router.psClient.GetGrpcClient(addr)
+	if err != nil {
+		panic(err)
+	}
+	return client.(pspb.ApiGrpcClient)
+}
+
+// checkPsResponse panics the error of a request sent to a server instead of a partition, it is not retried
+func checkPsResponse(header *metapb.ResponseHeader) {
+	switch header.Code {
+	case metapb.RESP_CODE_OK:
+	case metapb.RESP_CODE_TIMEOUT:
+		panic(&metapb.TimeoutError{})
+	default:
+		panic(errors.New(header.Message))
+	}
+}
+
+// mergeScrollPages merges the pages of the partitions, all the hits of the pages are kept
+func mergeScrollPages(shards []*shardSearch, sortKeys engine.SortKeys) *engine.SearchResult {
+	result, hits := mergeShards(shards)
+	sort.SliceStable(hits, func(i, j int) bool {
+		return sortKeys.Compare(hits[i].hit, hits[j].hit) < 0
+	})
+	result.Hits.Hits = make([]engine.HitDoc, len(hits))
+	for i, hit := range hits {
+		result.Hits.Hits[i] = *hit.hit
+	}
+	return result
+}
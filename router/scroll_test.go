@@ -0,0 +1,39 @@
+package router
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestSearchScrollFailedShards(t *testing.T) {
+	// the partition on "b" fails to open its scroll
+	servers := &fakeServers{searches: map[string]func(in *pspb.SearchRequest) *engine.SearchResult{
+		"a": func(in *pspb.SearchRequest) *engine.SearchResult {
+			result := &engine.SearchResult{ScrollID: "scroll-a"}
+			result.Hits.Total = 1
+			result.Hits.Hits = []engine.HitDoc{{Id: "1"}}
+			return result
+		},
+	}}
+	space := testSpace2(servers)
+	router := &Router{}
+
+	result := router.searchScroll(space, "test", nil, "", time.Minute)
+	if result.Shards.Total != 2 || result.Shards.Successful != 1 || result.Shards.Failed != 1 {
+		t.Fatalf("invalid shards %+v of the first page", result.Shards)
+	}
+	if len(result.Hits.Hits) != 1 {
+		t.Fatalf("invalid hits %v of the first page", result.Hits.Hits)
+	}
+	scroll, err := decodeScrollId(result.ScrollID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the failed partition is kept in the scroll, so that the next pages report it
+	if len(scroll.Shards) != 1 || scroll.Shards[0].ScrollID != "scroll-a" || scroll.Failed != 1 {
+		t.Fatalf("invalid scroll %+v", scroll)
+	}
+}
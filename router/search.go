@@ -0,0 +1,318 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/tiglabs/baudengine/engine"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/netutil"
+)
+
+var errRequestTimeout = errors.New("request timeout")
+
+var matchAllQuery = json.RawMessage(`{"match_all": {}}`)
+
+// shardSearch is the search of a partition in the query or the fetch phase
+type shardSearch struct {
+	partition *Partition
+	request   []byte
+	result    *engine.SearchResult
+	err       error
+}
+
+type shardHit struct {
+	shard int
+	hit   *engine.HitDoc
+}
+
+// handleSearch searches all the partitions of the space by query then fetch:
+// the query phase collects the sort values of the top from+size hits of every partition,
+// the fetch phase reads the documents of the merged page from the partitions they belong to.
+func (router *Router) handleSearch(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, space, _ := router.getParams(params)
+	result := router.search(space, router.readDocBody(request), request.URL.Query().Get("timeout"))
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), result})
+}
+
+// search runs the search request json on the space, the timeout parameter of the url overrides the one in the body
+func (router *Router) search(space *Space, body []byte, timeoutParam string) *engine.SearchResult {
+	start := time.Now()
+	raw := make(map[string]json.RawMessage)
+	searchReq := engine.NewSearchQuery("", "")
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &raw); err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+		if err := json.Unmarshal(body, searchReq); err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+		}
+	}
+	if searchReq.From < 0 || searchReq.Size < 0 {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, "from and size must not be negative", nil})
+	}
+	sortKeys, err := engine.ParseSortKeys(searchReq.Sort)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	timeout, err := searchTimeout(timeoutParam, raw)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	if len(raw["query"]) == 0 {
+		raw["query"] = matchAllQuery
+	}
+
+	ctx, cancel := context.WithTimeout(space.parent.context, timeout)
+	defer cancel()
+
+	// query phase, the sources are not needed to merge the hits
+	partitions := space.GetPartitions()
+	queryBody := searchBody(raw, map[string]interface{}{"from": 0, "size": searchReq.From + searchReq.Size, "_source": false},
+		"timeout", "highlight", "stored_fields", "version", "seq_no_primary_term")
+	shards := make([]*shardSearch, len(partitions))
+	for i, partition := range partitions {
+		shards[i] = &shardSearch{partition: partition, request: queryBody}
+	}
+	searchShards(ctx, shards)
+
+	result, hits := mergeShards(shards)
+	// the hits of the same order keep the order of the partitions
+	sort.SliceStable(hits, func(i, j int) bool {
+		return sortKeys.Compare(hits[i].hit, hits[j].hit) < 0
+	})
+	if searchReq.From >= len(hits) {
+		hits = nil
+	} else {
+		hits = hits[searchReq.From:]
+		if len(hits) > searchReq.Size {
+			hits = hits[:searchReq.Size]
+		}
+	}
+
+	result.Hits.Hits = fetchHits(ctx, raw, shards, hits, result)
+	result.Took = int64(time.Since(start) / time.Millisecond)
+	return result
+}
+
+// mergeShards merges the results of the query phase, the search is timed out if any of the partitions is timed out.
+// The hits are in the order of the partitions, the aggregations of the partitions are summed up and reduced.
+func mergeShards(shards []*shardSearch) (result *engine.SearchResult, hits []shardHit) {
+	result = &engine.SearchResult{Shards: engine.Shards{Total: len(shards)}}
+	for i, shard := range shards {
+		if shard.err != nil {
+			result.Shards.Failed++
+			result.TimeOut = result.TimeOut || shard.err == errRequestTimeout
+			continue
+		}
+		result.Shards.Successful++
+		r := shard.result
+		result.TimeOut = result.TimeOut || r.TimeOut
+		result.Hits.Total += r.Hits.Total
+		if r.Hits.MaxScore > result.Hits.MaxScore {
+			result.Hits.MaxScore = r.Hits.MaxScore
+		}
+		result.Aggregations = result.Aggregations.Merge(r.Aggregations)
+		for j := range r.Hits.Hits {
+			hits = append(hits, shardHit{shard: i, hit: &r.Hits.Hits[j]})
+		}
+	}
+	result.Aggregations.Reduce()
+	return result, hits
+}
+
+// count returns the number of the documents of the space matching the query json, the failed partitions are not counted
+func (router *Router) count(space *Space, query []byte, timeoutParam string) (uint64, engine.Shards, bool) {
+	timeout, err := searchTimeout(timeoutParam, nil)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, err.Error(), nil})
+	}
+	ctx, cancel := context.WithTimeout(space.parent.context, timeout)
+	defer cancel()
+
+	partitions := space.GetPartitions()
+	results, errs := doPartitions(ctx, partitions, func(i int, partition *Partition) interface{} {
+		return partition.Count(ctx, query)
+	})
+	var total uint64
+	shards, timedOut := engine.Shards{Total: len(partitions)}, false
+	for i, err := range errs {
+		if err != nil {
+			shards.Failed++
+			timedOut = timedOut || err == errRequestTimeout
+			continue
+		}
+		shards.Successful++
+		total += results[i].(uint64)
+	}
+	return total, shards, timedOut
+}
+
+// fetchHits reads the documents of the hits in the order of the hits, the hits of the failed partitions are dropped.
+func fetchHits(ctx context.Context, raw map[string]json.RawMessage, shards []*shardSearch, hits []shardHit, result *engine.SearchResult) []engine.HitDoc {
+	ids := make(map[int][]string)
+	for _, hit := range hits {
+		ids[hit.shard] = append(ids[hit.shard], hit.hit.Id)
+	}
+	fetches := make([]*shardSearch, 0, len(ids))
+	fetchIndex := make(map[int]*shardSearch, len(ids))
+	for shard, shardIds := range ids {
+		query, _ := json.Marshal(map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must":   []json.RawMessage{raw["query"]},
+				"filter": []interface{}{map[string]interface{}{"ids": map[string]interface{}{"values": shardIds}}},
+			},
+		})
+		fetch := &shardSearch{
+			partition: shards[shard].partition,
+			request: searchBody(raw, map[string]interface{}{"from": 0, "size": len(shardIds), "query": json.RawMessage(query)},
+				"timeout", "aggs", "aggregations", "sort", "search_after"),
+		}
+		fetches = append(fetches, fetch)
+		fetchIndex[shard] = fetch
+	}
+	searchShards(ctx, fetches)
+
+	docs := make(map[int]map[string]*engine.HitDoc, len(fetchIndex))
+	for shard, fetch := range fetchIndex {
+		if fetch.err != nil {
+			result.Shards.Successful--
+			result.Shards.Failed++
+			result.TimeOut = result.TimeOut || fetch.err == errRequestTimeout
+			continue
+		}
+		docs[shard] = make(map[string]*engine.HitDoc, len(fetch.result.Hits.Hits))
+		for i := range fetch.result.Hits.Hits {
+			doc := &fetch.result.Hits.Hits[i]
+			docs[shard][doc.Id] = doc
+		}
+	}
+
+	fetched := make([]engine.HitDoc, 0, len(hits))
+	for _, hit := range hits {
+		// the document may be deleted after the query phase
+		doc, ok := docs[hit.shard][hit.hit.Id]
+		if !ok {
+			continue
+		}
+		doc.Score, doc.Sort = hit.hit.Score, hit.hit.Sort
+		fetched = append(fetched, *doc)
+	}
+	return fetched
+}
+
+// searchShards searches the partitions of the shards concurrently
+func searchShards(ctx context.Context, shards []*shardSearch) {
+	partitions := make([]*Partition, len(shards))
+	for i, shard := range shards {
+		partitions[i] = shard.partition
+	}
+	results, errs := doPartitions(ctx, partitions, func(i int, partition *Partition) interface{} {
+		return partition.Search(ctx, shards[i].request)
+	})
+	for i, shard := range shards {
+		if shard.err = errs[i]; shard.err == nil {
+			shard.result = results[i].(*engine.SearchResult)
+		}
+	}
+}
+
+// doPartitions calls fn on the partitions concurrently and returns the results in the order of the partitions,
+// the calls not done before the deadline fail by timeout.
+func doPartitions(ctx context.Context, partitions []*Partition, fn func(i int, partition *Partition) interface{}) ([]interface{}, []error) {
+	return doParallel(ctx, len(partitions), func(i int) interface{} {
+		defer func() {
+			if p := recover(); p != nil {
+				log.Error("request on partition[%d] failed: %v", partitions[i].meta.ID, p)
+				panic(p)
+			}
+		}()
+		return fn(i, partitions[i])
+	})
+}
+
+// doParallel calls fn for 0 to n-1 concurrently and returns the results in the order of the calls,
+// the panics of fn are the errors of the calls and the calls not done before the deadline fail by timeout.
+func doParallel(ctx context.Context, n int, fn func(i int) interface{}) ([]interface{}, []error) {
+	type done struct {
+		index  int
+		result interface{}
+		err    error
+	}
+	ch := make(chan done, n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			defer func() {
+				if p := recover(); p != nil {
+					err, ok := p.(error)
+					if !ok {
+						err = fmt.Errorf("%v", p)
+					}
+					ch <- done{index: i, err: err}
+				}
+			}()
+			ch <- done{index: i, result: fn(i)}
+		}(i)
+	}
+
+	results := make([]interface{}, n)
+	errs := make([]error, n)
+	for i := range errs {
+		errs[i] = errRequestTimeout
+	}
+	for k := 0; k < n; k++ {
+		select {
+		case d := <-ch:
+			results[d.index], errs[d.index] = d.result, d.err
+			if d.err != nil && ctx.Err() == context.DeadlineExceeded {
+				errs[d.index] = errRequestTimeout
+			}
+		case <-ctx.Done():
+			return results, errs
+		}
+	}
+	return results, errs
+}
+
+// searchBody returns the request json of the raw body with the fields set or removed.
+func searchBody(raw map[string]json.RawMessage, set map[string]interface{}, remove ...string) []byte {
+	body := make(map[string]interface{}, len(raw)+len(set))
+	for k, v := range raw {
+		body[k] = v
+	}
+	for _, k := range remove {
+		delete(body, k)
+	}
+	for k, v := range set {
+		body[k] = v
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// searchTimeout returns the timeout parameter of the url or the body, such as "500ms" or "1s"
+func searchTimeout(timeout string, raw map[string]json.RawMessage) (time.Duration, error) {
+	if data, ok := raw["timeout"]; ok && timeout == "" {
+		if err := json.Unmarshal(data, &timeout); err != nil {
+			return 0, fmt.Errorf("invalid timeout %s", data)
+		}
+	}
+	if timeout == "" {
+		return rpcTimeoutDef, nil
+	}
+	d, err := time.ParseDuration(timeout)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid timeout %s", timeout)
+	}
+	return d, nil
+}
@@ -0,0 +1,48 @@
+package router
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tiglabs/baudengine/engine"
+)
+
+func TestMergeShardsTimeOut(t *testing.T) {
+	shard := func(timedOut bool, ids ...string) *shardSearch {
+		r := &engine.SearchResult{TimeOut: timedOut}
+		for _, id := range ids {
+			r.Hits.Hits = append(r.Hits.Hits, engine.HitDoc{Id: id})
+		}
+		r.Hits.Total = uint64(len(ids))
+		return &shardSearch{result: r}
+	}
+
+	tests := []struct {
+		name     string
+		shards   []*shardSearch
+		timedOut bool
+	}{
+		{"none", []*shardSearch{shard(false, "a"), shard(false, "b", "c")}, false},
+		{"shard", []*shardSearch{shard(false, "a"), shard(true, "b")}, true},
+		{"request", []*shardSearch{shard(false, "a"), {err: errRequestTimeout}}, true},
+		{"failure", []*shardSearch{shard(false, "a"), {err: errors.New("failure")}}, false},
+	}
+	for _, test := range tests {
+		result, hits := mergeShards(test.shards)
+		if result.TimeOut != test.timedOut {
+			t.Errorf("%s: timed_out is %v, want %v", test.name, result.TimeOut, test.timedOut)
+		}
+		var total uint64
+		for _, shard := range test.shards {
+			if shard.err == nil {
+				total += shard.result.Hits.Total
+			}
+		}
+		if result.Hits.Total != total || uint64(len(hits)) != total {
+			t.Errorf("%s: %d hits of total %d, want %d", test.name, len(hits), result.Hits.Total, total)
+		}
+		if result.Shards.Successful+result.Shards.Failed != len(test.shards) {
+			t.Errorf("%s: bad shards %+v", test.name, result.Shards)
+		}
+	}
+}
@@ -93,7 +93,7 @@ func (s *TopoServer) AddSpace(ctx context.Context, space *metapb.Space,
 		log.Error("Fail to marshal meta data for space[%v]. err[%v]", space, err)
 		return nil, nil, err
 	}
-	txn.Create(path.Join(spacesPath, fmt.Sprintf("%d-%d", space.DB, space.ID), SpaceTopoFile), contents)
+	txn.Put(path.Join(spacesPath, fmt.Sprintf("%d-%d", space.DB, space.ID), SpaceTopoFile), contents, nil)
 
 	for _, partition := range partitions {
 		contents, err := proto.Marshal(partition)
@@ -101,7 +101,7 @@ func (s *TopoServer) AddSpace(ctx context.Context, space *metapb.Space,
 			log.Error("Fail to marshal meta data for partition[%v]. err[%v]", partitions, err)
 			return nil, nil, err
 		}
-		txn.Create(path.Join(partitionsPath, fmt.Sprint(partition.ID), PartitionTopoFile), contents)
+		txn.Put(path.Join(partitionsPath, fmt.Sprint(partition.ID), PartitionTopoFile), contents, nil)
 	}
 
 	opResults, err := txn.Commit()
@@ -92,3 +92,17 @@ func Int64ToFloat64(i int64) float64 {
 	}
 	return math.Float64frombits(uint64(i))
 }
+
+// ConfigInt returns the integer option of the config, the numbers decoded from json are float64.
+func ConfigInt(config map[string]interface{}, key string) (int64, bool) {
+	switch v := config[key].(type) {
+	case int:
+		return int64(v), true
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
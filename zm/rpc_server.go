@@ -298,6 +298,27 @@ func (rpcSrv *RpcServer) GetSpace(ctx context.Context, req *masterpb.GetSpaceReq
 	return resp, nil
 }
 
+func (rpcSrv *RpcServer) GetSpaces(ctx context.Context, req *masterpb.GetSpacesRequest) (*masterpb.GetSpacesResponse, error) {
+	resp := new(masterpb.GetSpacesResponse)
+
+	dbs := rpcSrv.cluster.DbCache.GetAllDBs()
+	if req.DBName != "" {
+		db := rpcSrv.cluster.DbCache.FindDbByName(req.DBName)
+		if db == nil {
+			resp.ResponseHeader = *makeRpcRespHeader(ErrDbNotExists)
+			return resp, nil
+		}
+		dbs = []*DB{db}
+	}
+	for _, db := range dbs {
+		for _, space := range db.SpaceCache.GetAllSpaces() {
+			resp.Spaces = append(resp.Spaces, *space.Space)
+		}
+	}
+	resp.ResponseHeader = *makeRpcRespHeader(ErrSuc)
+	return resp, nil
+}
+
 func (rpcSrv *RpcServer) PSRegister(ctx context.Context,
 	req *masterpb.PSRegisterRequest) (*masterpb.PSRegisterResponse, error) {
 	resp := new(masterpb.PSRegisterResponse)